@@ -35,6 +35,8 @@ type ClientService interface {
 
 	GetConfig(params *GetConfigParams) (*GetConfigOK, error)
 
+	GetControllers(params *GetControllersParams) (*GetControllersOK, error)
+
 	GetDebuginfo(params *GetDebuginfoParams) (*GetDebuginfoOK, error)
 
 	GetHealthz(params *GetHealthzParams) (*GetHealthzOK, error)
@@ -45,8 +47,12 @@ type ClientService interface {
 
 	GetMapNameEvents(params *GetMapNameEventsParams, writer io.Writer) (*GetMapNameEventsOK, error)
 
+	GetProxyXds(params *GetProxyXdsParams) (*GetProxyXdsOK, error)
+
 	PatchConfig(params *PatchConfigParams) (*PatchConfigOK, error)
 
+	PostControllersNameTrigger(params *PostControllersNameTriggerParams) (*PostControllersNameTriggerOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -120,6 +126,44 @@ func (a *Client) GetConfig(params *GetConfigParams) (*GetConfigOK, error) {
 	panic(msg)
 }
 
+/*
+GetControllers gets status of all controllers
+
+Returns the status of all controllers registered with the controller
+manager, including last success, last failure and the number of
+consecutive failures. The list can be filtered by controller name.
+*/
+func (a *Client) GetControllers(params *GetControllersParams) (*GetControllersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControllersParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetControllers",
+		Method:             "GET",
+		PathPattern:        "/controllers",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControllersReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControllersOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetControllers: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetDebuginfo retrieves information about the agent and evironment for debugging
 */
@@ -295,6 +339,45 @@ func (a *Client) GetMapNameEvents(params *GetMapNameEventsParams, writer io.Writ
 	panic(msg)
 }
 
+/*
+	GetProxyXds retrieves the current x d s configuration pushed to the envoy proxy
+
+	Dumps the listeners, routes, clusters, endpoints, secrets and network
+
+policies currently published by the agent over the xDS API. Secret
+contents are redacted.
+*/
+func (a *Client) GetProxyXds(params *GetProxyXdsParams) (*GetProxyXdsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetProxyXdsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetProxyXds",
+		Method:             "GET",
+		PathPattern:        "/proxy/xds",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetProxyXdsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetProxyXdsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetProxyXds: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 	PatchConfig modifies daemon configuration
 
@@ -334,6 +417,43 @@ func (a *Client) PatchConfig(params *PatchConfigParams) (*PatchConfigOK, error)
 	panic(msg)
 }
 
+/*
+PostControllersNameTrigger triggers an immediate run of a controller
+
+Schedules an immediate run of the controller with the given name,
+independent of its configured run interval.
+*/
+func (a *Client) PostControllersNameTrigger(params *PostControllersNameTriggerParams) (*PostControllersNameTriggerOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostControllersNameTriggerParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostControllersNameTrigger",
+		Method:             "POST",
+		PathPattern:        "/controllers/{name}/trigger",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostControllersNameTriggerReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostControllersNameTriggerOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PostControllersNameTrigger: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
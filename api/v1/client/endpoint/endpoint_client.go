@@ -32,6 +32,8 @@ type Client struct {
 type ClientService interface {
 	DeleteEndpointID(params *DeleteEndpointIDParams) (*DeleteEndpointIDOK, *DeleteEndpointIDErrors, error)
 
+	GetAttribution(params *GetAttributionParams) (*GetAttributionOK, error)
+
 	GetEndpoint(params *GetEndpointParams) (*GetEndpointOK, error)
 
 	GetEndpointID(params *GetEndpointIDParams) (*GetEndpointIDOK, error)
@@ -44,6 +46,8 @@ type ClientService interface {
 
 	GetEndpointIDLog(params *GetEndpointIDLogParams) (*GetEndpointIDLogOK, error)
 
+	GetEndpointIDStats(params *GetEndpointIDStatsParams) (*GetEndpointIDStatsOK, error)
+
 	PatchEndpointID(params *PatchEndpointIDParams) (*PatchEndpointIDOK, error)
 
 	PatchEndpointIDConfig(params *PatchEndpointIDConfigParams) (*PatchEndpointIDConfigOK, error)
@@ -101,6 +105,48 @@ func (a *Client) DeleteEndpointID(params *DeleteEndpointIDParams) (*DeleteEndpoi
 	panic(msg)
 }
 
+/*
+	GetAttribution attributes a socket or flow to the owning endpoint and identities
+
+	Resolves the source address of a socket or flow observed by an
+
+external tool to the local endpoint owning it and the security
+identities of both sides, reusing the agent's attribution state
+instead of re-deriving pod ownership. When a socket cookie and the
+observed destination are provided, the original service frontend is
+resolved from the socket-based load-balancing reverse NAT map.
+*/
+func (a *Client) GetAttribution(params *GetAttributionParams) (*GetAttributionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAttributionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetAttribution",
+		Method:             "GET",
+		PathPattern:        "/attribution",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAttributionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAttributionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetAttribution: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetEndpoint retrieves a list of endpoints that have metadata matching the provided parameters
 
@@ -311,6 +357,44 @@ func (a *Client) GetEndpointIDLog(params *GetEndpointIDLogParams) (*GetEndpointI
 	panic(msg)
 }
 
+/*
+GetEndpointIDStats retrieves the datapath traffic statistics of this endpoint
+
+Returns the receive and transmit byte, packet and drop counters
+accumulated by the datapath for this endpoint, allowing per-pod
+traffic accounting without scraping cAdvisor.
+*/
+func (a *Client) GetEndpointIDStats(params *GetEndpointIDStatsParams) (*GetEndpointIDStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetEndpointIDStatsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetEndpointIDStats",
+		Method:             "GET",
+		PathPattern:        "/endpoint/{id}/stats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetEndpointIDStatsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetEndpointIDStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetEndpointIDStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PatchEndpointID modifies existing endpoint
 
@@ -64,6 +64,11 @@ for the delete fqdn cache operation typically these are written to a http.Reques
 */
 type DeleteFqdnCacheParams struct {
 
+	/*EndpointID
+	  String describing an endpoint with the format ``[prefix:]id``
+
+	*/
+	EndpointID *string
 	/*Matchpattern
 	  A toFQDNs compatible matchPattern expression
 
@@ -108,6 +113,17 @@ func (o *DeleteFqdnCacheParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithEndpointID adds the endpointID to the delete fqdn cache params
+func (o *DeleteFqdnCacheParams) WithEndpointID(endpointID *string) *DeleteFqdnCacheParams {
+	o.SetEndpointID(endpointID)
+	return o
+}
+
+// SetEndpointID adds the endpointId to the delete fqdn cache params
+func (o *DeleteFqdnCacheParams) SetEndpointID(endpointID *string) {
+	o.EndpointID = endpointID
+}
+
 // WithMatchpattern adds the matchpattern to the delete fqdn cache params
 func (o *DeleteFqdnCacheParams) WithMatchpattern(matchpattern *string) *DeleteFqdnCacheParams {
 	o.SetMatchpattern(matchpattern)
@@ -127,6 +143,22 @@ func (o *DeleteFqdnCacheParams) WriteToRequest(r runtime.ClientRequest, reg strf
 	}
 	var res []error
 
+	if o.EndpointID != nil {
+
+		// query param endpoint-id
+		var qrEndpointID string
+		if o.EndpointID != nil {
+			qrEndpointID = *o.EndpointID
+		}
+		qEndpointID := qrEndpointID
+		if qEndpointID != "" {
+			if err := r.SetQueryParam("endpoint-id", qEndpointID); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Matchpattern != nil {
 
 		// query param matchpattern
@@ -32,14 +32,22 @@ type Client struct {
 type ClientService interface {
 	DeleteFqdnCache(params *DeleteFqdnCacheParams) (*DeleteFqdnCacheOK, error)
 
+	DeleteFqdnZombies(params *DeleteFqdnZombiesParams) (*DeleteFqdnZombiesOK, error)
+
 	DeletePolicy(params *DeletePolicyParams) (*DeletePolicyOK, error)
 
 	GetFqdnCache(params *GetFqdnCacheParams) (*GetFqdnCacheOK, error)
 
 	GetFqdnCacheID(params *GetFqdnCacheIDParams) (*GetFqdnCacheIDOK, error)
 
+	GetFqdnExplainName(params *GetFqdnExplainNameParams) (*GetFqdnExplainNameOK, error)
+
 	GetFqdnNames(params *GetFqdnNamesParams) (*GetFqdnNamesOK, error)
 
+	GetFqdnProxyRules(params *GetFqdnProxyRulesParams) (*GetFqdnProxyRulesOK, error)
+
+	GetFqdnZombies(params *GetFqdnZombiesParams) (*GetFqdnZombiesOK, error)
+
 	GetIP(params *GetIPParams) (*GetIPOK, error)
 
 	GetIdentity(params *GetIdentityParams) (*GetIdentityOK, error)
@@ -98,6 +106,44 @@ func (a *Client) DeleteFqdnCache(params *DeleteFqdnCacheParams) (*DeleteFqdnCach
 	panic(msg)
 }
 
+/*
+	DeleteFqdnZombies triggers a garbage collection run of the d n s zombie mappings
+
+	Forces an immediate run of the DNS garbage collector, releasing
+
+zombie entries whose connections are no longer alive.
+*/
+func (a *Client) DeleteFqdnZombies(params *DeleteFqdnZombiesParams) (*DeleteFqdnZombiesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteFqdnZombiesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteFqdnZombies",
+		Method:             "DELETE",
+		PathPattern:        "/fqdn/zombies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteFqdnZombiesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteFqdnZombiesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteFqdnZombies: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 DeletePolicy deletes a policy sub tree
 */
@@ -208,6 +254,46 @@ func (a *Client) GetFqdnCacheID(params *GetFqdnCacheIDParams) (*GetFqdnCacheIDOK
 	panic(msg)
 }
 
+/*
+	GetFqdnExplainName explains how a DNS name interacts with f q d n policy
+
+	Reports which ToFQDNs selectors match the given DNS name, the policy
+
+rules containing them, the endpoints selected by those rules, the
+identities programmed for the selectors and the cached DNS lookups for
+the name.
+*/
+func (a *Client) GetFqdnExplainName(params *GetFqdnExplainNameParams) (*GetFqdnExplainNameOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFqdnExplainNameParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetFqdnExplainName",
+		Method:             "GET",
+		PathPattern:        "/fqdn/explain/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetFqdnExplainNameReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFqdnExplainNameOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetFqdnExplainName: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 	GetFqdnNames lists internal DNS selector representations
 
@@ -246,6 +332,84 @@ func (a *Client) GetFqdnNames(params *GetFqdnNamesParams) (*GetFqdnNamesOK, erro
 	panic(msg)
 }
 
+/*
+	GetFqdnProxyRules dumps l7 d n s rules configured in the d n s proxy
+
+	Retrieves the compiled regex rules of the DNS proxy per endpoint and
+
+destination port, together with hit/miss counters and cumulative
+compile time of the regex compilation cache.
+*/
+func (a *Client) GetFqdnProxyRules(params *GetFqdnProxyRulesParams) (*GetFqdnProxyRulesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFqdnProxyRulesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetFqdnProxyRules",
+		Method:             "GET",
+		PathPattern:        "/fqdn/proxy/rules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetFqdnProxyRulesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFqdnProxyRulesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetFqdnProxyRules: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	GetFqdnZombies lists d n s zombie IP name mappings retained for live connections
+
+	Retrieves the list of IPs that have expired or been evicted from the
+
+per-endpoint DNS caches but are retained because a connection may
+still be using them, together with the reason each entry is kept.
+*/
+func (a *Client) GetFqdnZombies(params *GetFqdnZombiesParams) (*GetFqdnZombiesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFqdnZombiesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetFqdnZombies",
+		Method:             "GET",
+		PathPattern:        "/fqdn/zombies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetFqdnZombiesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFqdnZombiesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetFqdnZombies: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 	GetIP lists information about known IP addresses
 
@@ -37,6 +37,9 @@ type DNSLookup struct {
 	// Format: date-time
 	LookupTime strfmt.DateTime `json:"lookup-time,omitempty"`
 
+	// The DNS response code. Non-zero for cached negative responses, e.g. NXDOMAIN or SERVFAIL.
+	Rcode int64 `json:"rcode,omitempty"`
+
 	// The reason this FQDN IP association exists. Either a DNS lookup or an ongoing connection to an IP that was created by a DNS lookup.
 	Source string `json:"source,omitempty"`
 
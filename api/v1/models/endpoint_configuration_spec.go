@@ -9,9 +9,12 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // EndpointConfigurationSpec An endpoint's configuration
@@ -24,6 +27,12 @@ type EndpointConfigurationSpec struct {
 
 	// Changeable configuration
 	Options ConfigurationMap `json:"options,omitempty"`
+
+	// Level of the regeneration triggered by this update. When unset, the
+	// level is derived from the other fields.
+	//
+	// Enum: [policy-only datapath-rebuild]
+	RegenerationLevel string `json:"regeneration-level,omitempty"`
 }
 
 // Validate validates this endpoint configuration spec
@@ -38,6 +47,10 @@ func (m *EndpointConfigurationSpec) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRegenerationLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -78,6 +91,49 @@ func (m *EndpointConfigurationSpec) validateOptions(formats strfmt.Registry) err
 	return nil
 }
 
+var endpointConfigurationSpecTypeRegenerationLevelPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["policy-only","datapath-rebuild"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		endpointConfigurationSpecTypeRegenerationLevelPropEnum = append(endpointConfigurationSpecTypeRegenerationLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// EndpointConfigurationSpecRegenerationLevelPolicyOnly captures enum value "policy-only"
+	EndpointConfigurationSpecRegenerationLevelPolicyOnly string = "policy-only"
+
+	// EndpointConfigurationSpecRegenerationLevelDatapathRebuild captures enum value "datapath-rebuild"
+	EndpointConfigurationSpecRegenerationLevelDatapathRebuild string = "datapath-rebuild"
+)
+
+// prop value enum
+func (m *EndpointConfigurationSpec) validateRegenerationLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, endpointConfigurationSpecTypeRegenerationLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EndpointConfigurationSpec) validateRegenerationLevel(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RegenerationLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRegenerationLevelEnum("regeneration-level", "body", m.RegenerationLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *EndpointConfigurationSpec) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -54,6 +54,9 @@ type EndpointPolicy struct {
 
 	// The agent-local policy revision
 	PolicyRevision int64 `json:"policy-revision,omitempty"`
+
+	// Statistics of the policy map entries computed from this policy
+	Statistics *PolicyMapStatistics `json:"statistics,omitempty"`
 }
 
 // Validate validates this endpoint policy
@@ -72,6 +75,10 @@ func (m *EndpointPolicy) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStatistics(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -130,6 +137,24 @@ func (m *EndpointPolicy) validatePolicyEnabled(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointPolicy) validateStatistics(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Statistics) { // not required
+		return nil
+	}
+
+	if m.Statistics != nil {
+		if err := m.Statistics.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("statistics")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *EndpointPolicy) MarshalBinary() ([]byte, error) {
 	if m == nil {
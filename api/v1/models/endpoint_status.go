@@ -22,6 +22,9 @@ type EndpointStatus struct {
 	// Status of internal controllers attached to this endpoint
 	Controllers ControllerStatuses `json:"controllers,omitempty"`
 
+	// Aggregated DNS resolution statistics as observed by the DNS proxy
+	DNSStats *EndpointDNSStats `json:"dns-stats,omitempty"`
+
 	// Unique identifiers for this endpoint from outside cilium
 	ExternalIdentifiers *EndpointIdentifiers `json:"external-identifiers,omitempty"`
 
@@ -49,6 +52,9 @@ type EndpointStatus struct {
 	// The configuration in effect on this endpoint
 	Realized *EndpointConfigurationSpec `json:"realized,omitempty"`
 
+	// Statistics of the last completed regeneration of this endpoint
+	Regeneration *EndpointRegenerationStatistics `json:"regeneration,omitempty"`
+
 	// Current state of endpoint
 	// Required: true
 	State EndpointState `json:"state"`
@@ -62,6 +68,10 @@ func (m *EndpointStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDNSStats(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateExternalIdentifiers(formats); err != nil {
 		res = append(res, err)
 	}
@@ -98,6 +108,10 @@ func (m *EndpointStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRegeneration(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -124,6 +138,24 @@ func (m *EndpointStatus) validateControllers(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointStatus) validateDNSStats(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DNSStats) { // not required
+		return nil
+	}
+
+	if m.DNSStats != nil {
+		if err := m.DNSStats.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dns-stats")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *EndpointStatus) validateExternalIdentifiers(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ExternalIdentifiers) { // not required
@@ -282,6 +314,24 @@ func (m *EndpointStatus) validateRealized(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointStatus) validateRegeneration(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Regeneration) { // not required
+		return nil
+	}
+
+	if m.Regeneration != nil {
+		if err := m.Regeneration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("regeneration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *EndpointStatus) validateState(formats strfmt.Registry) error {
 
 	if err := m.State.Validate(formats); err != nil {
@@ -306,6 +306,11 @@ func (in *EndpointPolicy) DeepCopyInto(out *EndpointPolicy) {
 		*out = new(L4Policy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(PolicyMapStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -906,6 +911,48 @@ func (in *NodeElement) DeepCopy() *NodeElement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyMapDirectionStatistics) DeepCopyInto(out *PolicyMapDirectionStatistics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyMapDirectionStatistics.
+func (in *PolicyMapDirectionStatistics) DeepCopy() *PolicyMapDirectionStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyMapDirectionStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyMapStatistics) DeepCopyInto(out *PolicyMapStatistics) {
+	*out = *in
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(PolicyMapDirectionStatistics)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(PolicyMapDirectionStatistics)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyMapStatistics.
+func (in *PolicyMapStatistics) DeepCopy() *PolicyMapStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyMapStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyRule) DeepCopyInto(out *PolicyRule) {
 	*out = *in
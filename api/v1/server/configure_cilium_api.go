@@ -296,7 +296,10 @@ func configureServer(s *http.Server, scheme, addr string) {
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	return &ciliumMetrics.APIOperationHelper{
+		Next:      handler,
+		Histogram: ciliumMetrics.APIOperationInteractions,
+	}
 }
 
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
@@ -35,6 +35,59 @@ func init() {
   },
   "basePath": "/v1",
   "paths": {
+    "/attribution": {
+      "get": {
+        "description": "Resolves the source address of a socket or flow observed by an\nexternal tool to the local endpoint owning it and the security\nidentities of both sides, reusing the agent's attribution state\ninstead of re-deriving pod ownership. When a socket cookie and the\nobserved destination are provided, the original service frontend is\nresolved from the socket-based load-balancing reverse NAT map.\n",
+        "tags": [
+          "endpoint"
+        ],
+        "summary": "Attribute a socket or flow to the owning endpoint and identities",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Source IP address of the socket or flow",
+            "name": "source-ip",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Destination IP address of the socket or flow",
+            "name": "destination-ip",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Destination port of the socket or flow",
+            "name": "destination-port",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Socket cookie as reported by bpf_get_socket_cookie",
+            "name": "cookie",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/Attribution"
+            }
+          },
+          "400": {
+            "description": "Invalid request (error parsing parameters)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "No local endpoint owns the provided source IP"
+          }
+        }
+      }
+    },
     "/cluster/nodes": {
       "get": {
         "tags": [
@@ -111,6 +164,57 @@ func init() {
         }
       }
     },
+    "/controllers": {
+      "get": {
+        "description": "Returns the status of all controllers registered with the controller\nmanager, including last success, last failure and the number of\nconsecutive failures. The list can be filtered by controller name.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Get status of all controllers",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Filter controllers by name. Only controllers whose name contains\nthe given string are returned.\n",
+            "name": "name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/ControllerStatuses"
+            }
+          }
+        }
+      }
+    },
+    "/controllers/{name}/trigger": {
+      "post": {
+        "description": "Schedules an immediate run of the controller with the given name,\nindependent of its configured run interval.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Trigger an immediate run of a controller",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the controller",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Controller not found"
+          }
+        }
+      }
+    },
     "/debuginfo": {
       "get": {
         "tags": [
@@ -516,9 +620,41 @@ func init() {
         }
       }
     },
+    "/endpoint/{id}/stats": {
+      "get": {
+        "description": "Returns the receive and transmit byte, packet and drop counters\naccumulated by the datapath for this endpoint, allowing per-pod\ntraffic accounting without scraping cAdvisor.\n",
+        "tags": [
+          "endpoint"
+        ],
+        "summary": "Retrieves the datapath traffic statistics of this endpoint.",
+        "parameters": [
+          {
+            "$ref": "#/parameters/endpoint-id"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/EndpointNetworkStats"
+            }
+          },
+          "400": {
+            "description": "Invalid identity provided",
+            "x-go-name": "Invalid"
+          },
+          "404": {
+            "description": "Endpoint not found"
+          },
+          "429": {
+            "description": "Rate-limiting too many requests in the given time frame"
+          }
+        }
+      }
+    },
     "/fqdn/cache": {
       "get": {
-        "description": "Retrieves the list of DNS lookups intercepted from endpoints,\noptionally filtered by DNS name, CIDR IP range or source.\n",
+        "description": "Retrieves the list of DNS lookups intercepted from endpoints,\noptionally filtered by DNS name, CIDR IP range, source, endpoint\nlabels or source security identity. Results can be paginated with\nthe limit and continue parameters; truncated responses carry an\nX-Continue header with the token for the next page.\n",
         "tags": [
           "policy"
         ],
@@ -532,6 +668,33 @@ func init() {
           },
           {
             "$ref": "#/parameters/source"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "List of endpoint labels. Only lookups from endpoints carrying all\nof the given labels are returned.\n",
+            "name": "labels",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Numeric security identity of the endpoints the lookups were\nintercepted from.\n",
+            "name": "source-identity",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Maximum number of lookups to return. As responses are split on\nendpoint boundaries, a page may exceed the limit by the lookups\nof its last endpoint. 0 or absent returns everything.\n",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Continue token from the X-Continue header of a previous response",
+            "name": "continue",
+            "in": "query"
           }
         ],
         "responses": {
@@ -542,6 +705,12 @@ func init() {
               "items": {
                 "$ref": "#/definitions/DNSLookup"
               }
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "Token to pass as the continue parameter to retrieve the\nnext page of a truncated result. Absent on the last page.\n"
+              }
             }
           },
           "400": {
@@ -556,7 +725,7 @@ func init() {
         }
       },
       "delete": {
-        "description": "Deletes matching DNS lookups from the cache, optionally restricted by\nDNS name. The removed IP data will no longer be used in generated\npolicies.\n",
+        "description": "Deletes matching DNS lookups from the cache, optionally restricted by\nDNS name or source endpoint. The removed IP data will no longer be\nused in generated policies.\n",
         "tags": [
           "policy"
         ],
@@ -564,6 +733,12 @@ func init() {
         "parameters": [
           {
             "$ref": "#/parameters/matchpattern"
+          },
+          {
+            "type": "string",
+            "description": "String describing an endpoint with the format ` + "`" + `` + "`" + `[prefix:]id` + "`" + `` + "`" + `",
+            "name": "endpoint-id",
+            "in": "query"
           }
         ],
         "responses": {
@@ -622,6 +797,41 @@ func init() {
         }
       }
     },
+    "/fqdn/explain/{name}": {
+      "get": {
+        "description": "Reports which ToFQDNs selectors match the given DNS name, the policy\nrules containing them, the endpoints selected by those rules, the\nidentities programmed for the selectors and the cached DNS lookups\nfor the name.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Explains how a DNS name interacts with FQDN policy",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "A DNS name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/FQDNExplainReport"
+            }
+          },
+          "400": {
+            "description": "Invalid request (error parsing parameters)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "No selectors match the provided name"
+          }
+        }
+      }
+    },
     "/fqdn/names": {
       "get": {
         "description": "Retrieves the list of DNS-related fields (names to poll, selectors and\ntheir corresponding regexes).\n",
@@ -645,6 +855,55 @@ func init() {
         }
       }
     },
+    "/fqdn/proxy/rules": {
+      "get": {
+        "description": "Retrieves the compiled regex rules of the DNS proxy per endpoint and\ndestination port, together with hit/miss counters and cumulative\ncompile time of the regex compilation cache.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Dump L7 DNS rules configured in the DNS proxy",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/DNSProxyRulesReport"
+            }
+          }
+        }
+      }
+    },
+    "/fqdn/zombies": {
+      "get": {
+        "description": "Retrieves the list of IPs that have expired or been evicted from the\nper-endpoint DNS caches but are retained because a connection may\nstill be using them, together with the reason each entry is kept.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "List DNS zombie IP -\u003e name mappings retained for live connections",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/DNSZombieMapping"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Forces an immediate run of the DNS garbage collector, releasing\nzombie entries whose connections are no longer alive.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Trigger a garbage collection run of the DNS zombie mappings",
+        "responses": {
+          "200": {
+            "description": "Success"
+          }
+        }
+      }
+    },
     "/healthz": {
       "get": {
         "description": "Returns health and status information of the Cilium daemon and related\ncomponents such as the local container runtime, connected datastore,\nKubernetes integration and Hubble.\n",
@@ -1261,6 +1520,33 @@ func init() {
         }
       }
     },
+    "/proxy/xds": {
+      "get": {
+        "description": "Dumps the listeners, routes, clusters, endpoints, secrets and network\npolicies currently published by the agent over the xDS API. Secret\ncontents are redacted.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Retrieve the current xDS configuration pushed to the Envoy proxy",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/XDSResourceGroup"
+              }
+            }
+          },
+          "500": {
+            "description": "xDS resource dump failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
     "/recorder": {
       "get": {
         "tags": [
@@ -1662,6 +1948,36 @@ func init() {
         }
       }
     },
+    "Attribution": {
+      "description": "Attribution of a socket or flow to the owning local endpoint and the security identities of both sides",
+      "type": "object",
+      "properties": {
+        "destination-identity": {
+          "description": "Security identity of the destination address",
+          "$ref": "#/definitions/Identity"
+        },
+        "endpoint-id": {
+          "description": "ID of the local endpoint owning the source address",
+          "type": "integer"
+        },
+        "namespace": {
+          "description": "K8s namespace of the owning pod",
+          "type": "string"
+        },
+        "pod-name": {
+          "description": "K8s pod name the endpoint belongs to",
+          "type": "string"
+        },
+        "service-frontend": {
+          "description": "Original service frontend address before socket-based load-balancing rewrote the destination, resolved from the socket cookie",
+          "type": "string"
+        },
+        "source-identity": {
+          "description": "Security identity of the source address",
+          "$ref": "#/definitions/Identity"
+        }
+      }
+    },
     "BandwidthManager": {
       "description": "Status of bandwidth manager\n\n+k8s:deepcopy-gen=true",
       "type": "object",
@@ -1941,6 +2257,10 @@ func init() {
           "type": "string",
           "format": "date-time"
         },
+        "rcode": {
+          "description": "The DNS response code. Non-zero for cached negative responses, e.g. NXDOMAIN or SERVFAIL.",
+          "type": "integer"
+        },
         "source": {
           "description": "The reason this FQDN IP association exists. Either a DNS lookup or an ongoing connection to an IP that was created by a DNS lookup.",
           "type": "string"
@@ -1951,19 +2271,105 @@ func init() {
         }
       }
     },
-    "DaemonConfiguration": {
-      "description": "Response to a daemon configuration request.\n",
+    "DNSProxyRule": {
+      "description": "A compiled L7 DNS rule of the DNS proxy",
       "type": "object",
       "properties": {
-        "spec": {
-          "description": "Changeable configuration",
-          "$ref": "#/definitions/DaemonConfigurationSpec"
+        "destPort": {
+          "description": "Destination port the rule applies to",
+          "type": "integer"
         },
-        "status": {
-          "description": "Current daemon configuration related status.Contains the addressing\ninformation, k8s, node monitor and immutable and mutable\nconfiguration settings.\n",
-          "$ref": "#/definitions/DaemonConfigurationStatus"
-        }
-      }
+        "endpointID": {
+          "description": "ID of the endpoint the rule applies to",
+          "type": "integer"
+        },
+        "regexString": {
+          "description": "Regular expression the matchNames and matchPatterns of the rule are compiled to",
+          "type": "string"
+        },
+        "selector": {
+          "description": "The L3 selector of the rule",
+          "type": "string"
+        }
+      }
+    },
+    "DNSProxyRulesReport": {
+      "description": "L7 DNS rules configured in the DNS proxy together with statistics of the regex compilation cache",
+      "type": "object",
+      "properties": {
+        "cacheCompileTime": {
+          "description": "Cumulative wall time spent compiling regexes on cache misses",
+          "type": "string"
+        },
+        "cacheEntries": {
+          "description": "Current number of entries in the regex compilation cache",
+          "type": "integer"
+        },
+        "cacheHits": {
+          "description": "Number of rule compilations served from the regex compilation cache",
+          "type": "integer"
+        },
+        "cacheMisses": {
+          "description": "Number of rule compilations that missed the regex compilation cache",
+          "type": "integer"
+        },
+        "rules": {
+          "description": "Compiled DNS rules by endpoint and destination port",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DNSProxyRule"
+          }
+        }
+      }
+    },
+    "DNSZombieMapping": {
+      "description": "An IP that is pending deletion from the DNS caches but may still be in use by a connection",
+      "type": "object",
+      "properties": {
+        "alive-at": {
+          "description": "The last time the connection tracking garbage collector marked this IP as in use",
+          "type": "string",
+          "format": "date-time"
+        },
+        "delete-pending-at": {
+          "description": "The time at which this IP was most recently scheduled for deletion",
+          "type": "string",
+          "format": "date-time"
+        },
+        "endpoint-id": {
+          "description": "The endpoint whose DNS cache this entry was evicted from",
+          "type": "integer"
+        },
+        "ip": {
+          "description": "The IP that is pending deletion",
+          "type": "string"
+        },
+        "names": {
+          "description": "DNS names that resolved to this IP",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "reason": {
+          "description": "The reason this entry is still retained",
+          "type": "string"
+        }
+      }
+    },
+    "DaemonConfiguration": {
+      "description": "Response to a daemon configuration request.\n",
+      "type": "object",
+      "properties": {
+        "spec": {
+          "description": "Changeable configuration",
+          "$ref": "#/definitions/DaemonConfigurationSpec"
+        },
+        "status": {
+          "description": "Current daemon configuration related status.Contains the addressing\ninformation, k8s, node monitor and immutable and mutable\nconfiguration settings.\n",
+          "$ref": "#/definitions/DaemonConfigurationStatus"
+        }
+      }
     },
     "DaemonConfigurationSpec": {
       "description": "The controllable configuration of the daemon.",
@@ -2232,6 +2638,10 @@ func init() {
           "description": "MAC address",
           "type": "string"
         },
+        "network-namespace": {
+          "description": "Path to the network namespace of the endpoint",
+          "type": "string"
+        },
         "pid": {
           "description": "Process ID of the workload belonging to this endpoint",
           "type": "integer"
@@ -2261,6 +2671,14 @@ func init() {
         "options": {
           "description": "Changeable configuration",
           "$ref": "#/definitions/ConfigurationMap"
+        },
+        "regeneration-level": {
+          "description": "Level of the regeneration triggered by this update. When unset, the level is derived from the other fields.",
+          "type": "string",
+          "enum": [
+            "policy-only",
+            "datapath-rebuild"
+          ]
         }
       }
     },
@@ -2282,6 +2700,36 @@ func init() {
         }
       }
     },
+    "EndpointDNSStats": {
+      "description": "Aggregated DNS resolution statistics of an endpoint as observed by the DNS proxy",
+      "type": "object",
+      "properties": {
+        "average-latency-ms": {
+          "description": "Average upstream resolution latency in milliseconds",
+          "type": "number",
+          "format": "double"
+        },
+        "queries-proxied": {
+          "description": "Total number of queries proxied to the upstream DNS server",
+          "type": "integer"
+        },
+        "refused-by-policy": {
+          "description": "Total number of queries refused by policy",
+          "type": "integer"
+        },
+        "top-names": {
+          "description": "Most queried DNS names with their query counts",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        },
+        "upstream-failures": {
+          "description": "Total number of queries which failed upstream, including timeouts",
+          "type": "integer"
+        }
+      }
+    },
     "EndpointDatapathConfiguration": {
       "description": "Datapath configuration to be used for the endpoint",
       "type": "object",
@@ -2378,6 +2826,36 @@ func init() {
         }
       }
     },
+    "EndpointNetworkStats": {
+      "description": "Datapath traffic counters of an endpoint",
+      "type": "object",
+      "properties": {
+        "rx-bytes": {
+          "description": "Bytes received by the endpoint",
+          "type": "integer"
+        },
+        "rx-drops": {
+          "description": "Packets dropped on the way to the endpoint",
+          "type": "integer"
+        },
+        "rx-packets": {
+          "description": "Packets received by the endpoint",
+          "type": "integer"
+        },
+        "tx-bytes": {
+          "description": "Bytes transmitted by the endpoint",
+          "type": "integer"
+        },
+        "tx-drops": {
+          "description": "Packets from the endpoint dropped by the datapath",
+          "type": "integer"
+        },
+        "tx-packets": {
+          "description": "Packets transmitted by the endpoint",
+          "type": "integer"
+        }
+      }
+    },
     "EndpointNetworking": {
       "description": "Unique identifiers for this endpoint from outside cilium",
       "type": "object",
@@ -2463,6 +2941,10 @@ func init() {
         "policy-revision": {
           "description": "The agent-local policy revision",
           "type": "integer"
+        },
+        "statistics": {
+          "description": "Statistics of the policy map entries computed from this policy",
+          "$ref": "#/definitions/PolicyMapStatistics"
         }
       }
     },
@@ -2504,6 +2986,24 @@ func init() {
         }
       }
     },
+    "EndpointRegenerationStatistics": {
+      "description": "Statistics of a completed endpoint regeneration",
+      "type": "object",
+      "properties": {
+        "phaseDurations": {
+          "description": "Duration of each regeneration phase in seconds, keyed by phase name",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "reason": {
+          "description": "Reason which triggered the regeneration",
+          "type": "string"
+        }
+      }
+    },
     "EndpointState": {
       "description": "State of endpoint",
       "type": "string",
@@ -2530,6 +3030,10 @@ func init() {
           "description": "Status of internal controllers attached to this endpoint",
           "$ref": "#/definitions/ControllerStatuses"
         },
+        "dns-stats": {
+          "description": "Aggregated DNS resolution statistics as observed by the DNS proxy",
+          "$ref": "#/definitions/EndpointDNSStats"
+        },
         "external-identifiers": {
           "description": "Unique identifiers for this endpoint from outside cilium",
           "$ref": "#/definitions/EndpointIdentifiers"
@@ -2566,6 +3070,10 @@ func init() {
           "description": "The configuration in effect on this endpoint",
           "$ref": "#/definitions/EndpointConfigurationSpec"
         },
+        "regeneration": {
+          "description": "Statistics of the last completed regeneration of this endpoint",
+          "$ref": "#/definitions/EndpointRegenerationStatistics"
+        },
         "state": {
           "description": "Current state of endpoint",
           "$ref": "#/definitions/EndpointState"
@@ -2607,6 +3115,65 @@ func init() {
     "Error": {
       "type": "string"
     },
+    "FQDNExplainReport": {
+      "description": "Interaction of a DNS name with ToFQDNs policy, the DNS cache and programmed identities",
+      "type": "object",
+      "properties": {
+        "lookups": {
+          "description": "Cached DNS lookups for the name",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DNSLookup"
+          }
+        },
+        "name": {
+          "description": "The DNS name the report was generated for",
+          "type": "string"
+        },
+        "selectors": {
+          "description": "ToFQDNs selectors matching the name",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FQDNExplainSelector"
+          }
+        }
+      }
+    },
+    "FQDNExplainSelector": {
+      "description": "A ToFQDNs selector matching a DNS name, with the policy rules containing it and the state programmed for it",
+      "type": "object",
+      "properties": {
+        "endpoints": {
+          "description": "IDs of local endpoints selected by the policy rules containing the selector",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "identities": {
+          "description": "Identities programmed for the selector",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "policies": {
+          "description": "Labels of the policy rules containing the selector",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "regexString": {
+          "description": "Regular expression the selector is compiled to",
+          "type": "string"
+        },
+        "selector": {
+          "description": "The ToFQDNs selector",
+          "type": "string"
+        }
+      }
+    },
     "FrontendAddress": {
       "description": "Layer 4 address. The protocol is currently ignored, all services will\nbehave as if protocol any is specified. To restrict to a particular\nprotocol, use policy.\n",
       "type": "object",
@@ -3519,6 +4086,46 @@ func init() {
         }
       }
     },
+    "PolicyMapDirectionStatistics": {
+      "description": "Statistics of the policy map entries of one traffic direction\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "allow-entries": {
+          "description": "Number of entries allowing traffic",
+          "type": "integer"
+        },
+        "deny-entries": {
+          "description": "Number of entries denying traffic",
+          "type": "integer"
+        },
+        "l7-redirect-entries": {
+          "description": "Number of entries redirecting traffic to an L7 proxy",
+          "type": "integer"
+        },
+        "wildcard-entries": {
+          "description": "Number of entries matching all identities",
+          "type": "integer"
+        }
+      }
+    },
+    "PolicyMapStatistics": {
+      "description": "Per-direction statistics of the policy map entries of an endpoint\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "egress": {
+          "description": "Statistics of the egress policy map entries",
+          "$ref": "#/definitions/PolicyMapDirectionStatistics"
+        },
+        "ingress": {
+          "description": "Statistics of the ingress policy map entries",
+          "$ref": "#/definitions/PolicyMapDirectionStatistics"
+        },
+        "revision": {
+          "description": "The policy revision the statistics were computed from",
+          "type": "integer"
+        }
+      }
+    },
     "PolicyRule": {
       "description": "A policy rule including the rule labels it derives from\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -4251,6 +4858,26 @@ func init() {
           }
         }
       }
+    },
+    "XDSResourceGroup": {
+      "description": "Resources of a single xDS type published to the Envoy proxy",
+      "properties": {
+        "resources": {
+          "description": "JSON representation of the published resources",
+          "type": "array",
+          "items": {
+            "type": "object"
+          }
+        },
+        "type-url": {
+          "description": "xDS type URL of the resources in this group",
+          "type": "string"
+        },
+        "version": {
+          "description": "Version of the xDS cache these resources were read at",
+          "type": "integer"
+        }
+      }
     }
   },
   "parameters": {
@@ -4441,6 +5068,59 @@ func init() {
   },
   "basePath": "/v1",
   "paths": {
+    "/attribution": {
+      "get": {
+        "description": "Resolves the source address of a socket or flow observed by an\nexternal tool to the local endpoint owning it and the security\nidentities of both sides, reusing the agent's attribution state\ninstead of re-deriving pod ownership. When a socket cookie and the\nobserved destination are provided, the original service frontend is\nresolved from the socket-based load-balancing reverse NAT map.\n",
+        "tags": [
+          "endpoint"
+        ],
+        "summary": "Attribute a socket or flow to the owning endpoint and identities",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Source IP address of the socket or flow",
+            "name": "source-ip",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Destination IP address of the socket or flow",
+            "name": "destination-ip",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Destination port of the socket or flow",
+            "name": "destination-port",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Socket cookie as reported by bpf_get_socket_cookie",
+            "name": "cookie",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/Attribution"
+            }
+          },
+          "400": {
+            "description": "Invalid request (error parsing parameters)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "No local endpoint owns the provided source IP"
+          }
+        }
+      }
+    },
     "/cluster/nodes": {
       "get": {
         "tags": [
@@ -4517,6 +5197,57 @@ func init() {
         }
       }
     },
+    "/controllers": {
+      "get": {
+        "description": "Returns the status of all controllers registered with the controller\nmanager, including last success, last failure and the number of\nconsecutive failures. The list can be filtered by controller name.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Get status of all controllers",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Filter controllers by name. Only controllers whose name contains\nthe given string are returned.\n",
+            "name": "name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/ControllerStatuses"
+            }
+          }
+        }
+      }
+    },
+    "/controllers/{name}/trigger": {
+      "post": {
+        "description": "Schedules an immediate run of the controller with the given name,\nindependent of its configured run interval.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Trigger an immediate run of a controller",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the controller",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Controller not found"
+          }
+        }
+      }
+    },
     "/debuginfo": {
       "get": {
         "tags": [
@@ -4977,9 +5708,45 @@ func init() {
         }
       }
     },
+    "/endpoint/{id}/stats": {
+      "get": {
+        "description": "Returns the receive and transmit byte, packet and drop counters\naccumulated by the datapath for this endpoint, allowing per-pod\ntraffic accounting without scraping cAdvisor.\n",
+        "tags": [
+          "endpoint"
+        ],
+        "summary": "Retrieves the datapath traffic statistics of this endpoint.",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "String describing an endpoint with the format ` + "`" + `` + "`" + `[prefix:]id` + "`" + `` + "`" + `. If no prefix\nis specified, a prefix of ` + "`" + `` + "`" + `cilium-local:` + "`" + `` + "`" + ` is assumed. Not all endpoints\nwill be addressable by all endpoint ID prefixes with the exception of the\nlocal Cilium UUID which is assigned to all endpoints.\n\nSupported endpoint id prefixes:\n  - cilium-local: Local Cilium endpoint UUID, e.g. cilium-local:3389595\n  - cilium-global: Global Cilium endpoint UUID, e.g. cilium-global:cluster1:nodeX:452343\n  - container-id: Container runtime ID, e.g. container-id:22222\n  - container-name: Container name, e.g. container-name:foobar\n  - pod-name: pod name for this container if K8s is enabled, e.g. pod-name:default:foobar\n  - docker-endpoint: Docker libnetwork endpoint ID, e.g. docker-endpoint:4444\n",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/EndpointNetworkStats"
+            }
+          },
+          "400": {
+            "description": "Invalid identity provided",
+            "x-go-name": "Invalid"
+          },
+          "404": {
+            "description": "Endpoint not found"
+          },
+          "429": {
+            "description": "Rate-limiting too many requests in the given time frame"
+          }
+        }
+      }
+    },
     "/fqdn/cache": {
       "get": {
-        "description": "Retrieves the list of DNS lookups intercepted from endpoints,\noptionally filtered by DNS name, CIDR IP range or source.\n",
+        "description": "Retrieves the list of DNS lookups intercepted from endpoints,\noptionally filtered by DNS name, CIDR IP range, source, endpoint\nlabels or source security identity. Results can be paginated with\nthe limit and continue parameters; truncated responses carry an\nX-Continue header with the token for the next page.\n",
         "tags": [
           "policy"
         ],
@@ -5002,6 +5769,33 @@ func init() {
             "description": "Source from which FQDN entries come from",
             "name": "source",
             "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "List of endpoint labels. Only lookups from endpoints carrying all\nof the given labels are returned.\n",
+            "name": "labels",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Numeric security identity of the endpoints the lookups were\nintercepted from.\n",
+            "name": "source-identity",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Maximum number of lookups to return. As responses are split on\nendpoint boundaries, a page may exceed the limit by the lookups\nof its last endpoint. 0 or absent returns everything.\n",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Continue token from the X-Continue header of a previous response",
+            "name": "continue",
+            "in": "query"
           }
         ],
         "responses": {
@@ -5012,6 +5806,12 @@ func init() {
               "items": {
                 "$ref": "#/definitions/DNSLookup"
               }
+            },
+            "headers": {
+              "X-Continue": {
+                "type": "string",
+                "description": "Token to pass as the continue parameter to retrieve the\nnext page of a truncated result. Absent on the last page.\n"
+              }
             }
           },
           "400": {
@@ -5026,7 +5826,7 @@ func init() {
         }
       },
       "delete": {
-        "description": "Deletes matching DNS lookups from the cache, optionally restricted by\nDNS name. The removed IP data will no longer be used in generated\npolicies.\n",
+        "description": "Deletes matching DNS lookups from the cache, optionally restricted by\nDNS name or source endpoint. The removed IP data will no longer be\nused in generated policies.\n",
         "tags": [
           "policy"
         ],
@@ -5037,6 +5837,12 @@ func init() {
             "description": "A toFQDNs compatible matchPattern expression",
             "name": "matchpattern",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "String describing an endpoint with the format ` + "`" + `` + "`" + `[prefix:]id` + "`" + `` + "`" + `",
+            "name": "endpoint-id",
+            "in": "query"
           }
         ],
         "responses": {
@@ -5092,41 +5898,125 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/DNSLookup"
+                "$ref": "#/definitions/DNSLookup"
+              }
+            }
+          },
+          "400": {
+            "description": "Invalid request (error parsing parameters)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "No DNS data with provided parameters found"
+          }
+        }
+      }
+    },
+    "/fqdn/explain/{name}": {
+      "get": {
+        "description": "Reports which ToFQDNs selectors match the given DNS name, the policy\nrules containing them, the endpoints selected by those rules, the\nidentities programmed for the selectors and the cached DNS lookups\nfor the name.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Explains how a DNS name interacts with FQDN policy",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "A DNS name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/FQDNExplainReport"
+            }
+          },
+          "400": {
+            "description": "Invalid request (error parsing parameters)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "No selectors match the provided name"
+          }
+        }
+      }
+    },
+    "/fqdn/names": {
+      "get": {
+        "description": "Retrieves the list of DNS-related fields (names to poll, selectors and\ntheir corresponding regexes).\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "List internal DNS selector representations",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/NameManager"
+            }
+          },
+          "400": {
+            "description": "Invalid request (error parsing parameters)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/fqdn/proxy/rules": {
+      "get": {
+        "description": "Retrieves the compiled regex rules of the DNS proxy per endpoint and\ndestination port, together with hit/miss counters and cumulative\ncompile time of the regex compilation cache.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Dump L7 DNS rules configured in the DNS proxy",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/DNSProxyRulesReport"
+            }
+          }
+        }
+      }
+    },
+    "/fqdn/zombies": {
+      "get": {
+        "description": "Retrieves the list of IPs that have expired or been evicted from the\nper-endpoint DNS caches but are retained because a connection may\nstill be using them, together with the reason each entry is kept.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "List DNS zombie IP -\u003e name mappings retained for live connections",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/DNSZombieMapping"
               }
             }
-          },
-          "400": {
-            "description": "Invalid request (error parsing parameters)",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "No DNS data with provided parameters found"
           }
         }
-      }
-    },
-    "/fqdn/names": {
-      "get": {
-        "description": "Retrieves the list of DNS-related fields (names to poll, selectors and\ntheir corresponding regexes).\n",
+      },
+      "delete": {
+        "description": "Forces an immediate run of the DNS garbage collector, releasing\nzombie entries whose connections are no longer alive.\n",
         "tags": [
           "policy"
         ],
-        "summary": "List internal DNS selector representations",
+        "summary": "Trigger a garbage collection run of the DNS zombie mappings",
         "responses": {
           "200": {
-            "description": "Success",
-            "schema": {
-              "$ref": "#/definitions/NameManager"
-            }
-          },
-          "400": {
-            "description": "Invalid request (error parsing parameters)",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+            "description": "Success"
           }
         }
       }
@@ -5813,6 +6703,33 @@ func init() {
         }
       }
     },
+    "/proxy/xds": {
+      "get": {
+        "description": "Dumps the listeners, routes, clusters, endpoints, secrets and network\npolicies currently published by the agent over the xDS API. Secret\ncontents are redacted.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Retrieve the current xDS configuration pushed to the Envoy proxy",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/XDSResourceGroup"
+              }
+            }
+          },
+          "500": {
+            "description": "xDS resource dump failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
     "/recorder": {
       "get": {
         "tags": [
@@ -6250,6 +7167,36 @@ func init() {
         }
       }
     },
+    "Attribution": {
+      "description": "Attribution of a socket or flow to the owning local endpoint and the security identities of both sides",
+      "type": "object",
+      "properties": {
+        "destination-identity": {
+          "description": "Security identity of the destination address",
+          "$ref": "#/definitions/Identity"
+        },
+        "endpoint-id": {
+          "description": "ID of the local endpoint owning the source address",
+          "type": "integer"
+        },
+        "namespace": {
+          "description": "K8s namespace of the owning pod",
+          "type": "string"
+        },
+        "pod-name": {
+          "description": "K8s pod name the endpoint belongs to",
+          "type": "string"
+        },
+        "service-frontend": {
+          "description": "Original service frontend address before socket-based load-balancing rewrote the destination, resolved from the socket cookie",
+          "type": "string"
+        },
+        "source-identity": {
+          "description": "Security identity of the source address",
+          "$ref": "#/definitions/Identity"
+        }
+      }
+    },
     "BandwidthManager": {
       "description": "Status of bandwidth manager\n\n+k8s:deepcopy-gen=true",
       "type": "object",
@@ -6581,6 +7528,10 @@ func init() {
           "type": "string",
           "format": "date-time"
         },
+        "rcode": {
+          "description": "The DNS response code. Non-zero for cached negative responses, e.g. NXDOMAIN or SERVFAIL.",
+          "type": "integer"
+        },
         "source": {
           "description": "The reason this FQDN IP association exists. Either a DNS lookup or an ongoing connection to an IP that was created by a DNS lookup.",
           "type": "string"
@@ -6591,6 +7542,92 @@ func init() {
         }
       }
     },
+    "DNSProxyRule": {
+      "description": "A compiled L7 DNS rule of the DNS proxy",
+      "type": "object",
+      "properties": {
+        "destPort": {
+          "description": "Destination port the rule applies to",
+          "type": "integer"
+        },
+        "endpointID": {
+          "description": "ID of the endpoint the rule applies to",
+          "type": "integer"
+        },
+        "regexString": {
+          "description": "Regular expression the matchNames and matchPatterns of the rule are compiled to",
+          "type": "string"
+        },
+        "selector": {
+          "description": "The L3 selector of the rule",
+          "type": "string"
+        }
+      }
+    },
+    "DNSProxyRulesReport": {
+      "description": "L7 DNS rules configured in the DNS proxy together with statistics of the regex compilation cache",
+      "type": "object",
+      "properties": {
+        "cacheCompileTime": {
+          "description": "Cumulative wall time spent compiling regexes on cache misses",
+          "type": "string"
+        },
+        "cacheEntries": {
+          "description": "Current number of entries in the regex compilation cache",
+          "type": "integer"
+        },
+        "cacheHits": {
+          "description": "Number of rule compilations served from the regex compilation cache",
+          "type": "integer"
+        },
+        "cacheMisses": {
+          "description": "Number of rule compilations that missed the regex compilation cache",
+          "type": "integer"
+        },
+        "rules": {
+          "description": "Compiled DNS rules by endpoint and destination port",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DNSProxyRule"
+          }
+        }
+      }
+    },
+    "DNSZombieMapping": {
+      "description": "An IP that is pending deletion from the DNS caches but may still be in use by a connection",
+      "type": "object",
+      "properties": {
+        "alive-at": {
+          "description": "The last time the connection tracking garbage collector marked this IP as in use",
+          "type": "string",
+          "format": "date-time"
+        },
+        "delete-pending-at": {
+          "description": "The time at which this IP was most recently scheduled for deletion",
+          "type": "string",
+          "format": "date-time"
+        },
+        "endpoint-id": {
+          "description": "The endpoint whose DNS cache this entry was evicted from",
+          "type": "integer"
+        },
+        "ip": {
+          "description": "The IP that is pending deletion",
+          "type": "string"
+        },
+        "names": {
+          "description": "DNS names that resolved to this IP",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "reason": {
+          "description": "The reason this entry is still retained",
+          "type": "string"
+        }
+      }
+    },
     "DaemonConfiguration": {
       "description": "Response to a daemon configuration request.\n",
       "type": "object",
@@ -6895,6 +7932,10 @@ func init() {
           "description": "MAC address",
           "type": "string"
         },
+        "network-namespace": {
+          "description": "Path to the network namespace of the endpoint",
+          "type": "string"
+        },
         "pid": {
           "description": "Process ID of the workload belonging to this endpoint",
           "type": "integer"
@@ -6924,6 +7965,14 @@ func init() {
         "options": {
           "description": "Changeable configuration",
           "$ref": "#/definitions/ConfigurationMap"
+        },
+        "regeneration-level": {
+          "description": "Level of the regeneration triggered by this update. When unset, the level is derived from the other fields.",
+          "type": "string",
+          "enum": [
+            "policy-only",
+            "datapath-rebuild"
+          ]
         }
       }
     },
@@ -6945,6 +7994,36 @@ func init() {
         }
       }
     },
+    "EndpointDNSStats": {
+      "description": "Aggregated DNS resolution statistics of an endpoint as observed by the DNS proxy",
+      "type": "object",
+      "properties": {
+        "average-latency-ms": {
+          "description": "Average upstream resolution latency in milliseconds",
+          "type": "number",
+          "format": "double"
+        },
+        "queries-proxied": {
+          "description": "Total number of queries proxied to the upstream DNS server",
+          "type": "integer"
+        },
+        "refused-by-policy": {
+          "description": "Total number of queries refused by policy",
+          "type": "integer"
+        },
+        "top-names": {
+          "description": "Most queried DNS names with their query counts",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
+        },
+        "upstream-failures": {
+          "description": "Total number of queries which failed upstream, including timeouts",
+          "type": "integer"
+        }
+      }
+    },
     "EndpointDatapathConfiguration": {
       "description": "Datapath configuration to be used for the endpoint",
       "type": "object",
@@ -7041,6 +8120,36 @@ func init() {
         }
       }
     },
+    "EndpointNetworkStats": {
+      "description": "Datapath traffic counters of an endpoint",
+      "type": "object",
+      "properties": {
+        "rx-bytes": {
+          "description": "Bytes received by the endpoint",
+          "type": "integer"
+        },
+        "rx-drops": {
+          "description": "Packets dropped on the way to the endpoint",
+          "type": "integer"
+        },
+        "rx-packets": {
+          "description": "Packets received by the endpoint",
+          "type": "integer"
+        },
+        "tx-bytes": {
+          "description": "Bytes transmitted by the endpoint",
+          "type": "integer"
+        },
+        "tx-drops": {
+          "description": "Packets from the endpoint dropped by the datapath",
+          "type": "integer"
+        },
+        "tx-packets": {
+          "description": "Packets transmitted by the endpoint",
+          "type": "integer"
+        }
+      }
+    },
     "EndpointNetworking": {
       "description": "Unique identifiers for this endpoint from outside cilium",
       "type": "object",
@@ -7126,6 +8235,10 @@ func init() {
         "policy-revision": {
           "description": "The agent-local policy revision",
           "type": "integer"
+        },
+        "statistics": {
+          "description": "Statistics of the policy map entries computed from this policy",
+          "$ref": "#/definitions/PolicyMapStatistics"
         }
       }
     },
@@ -7167,6 +8280,24 @@ func init() {
         }
       }
     },
+    "EndpointRegenerationStatistics": {
+      "description": "Statistics of a completed endpoint regeneration",
+      "type": "object",
+      "properties": {
+        "phaseDurations": {
+          "description": "Duration of each regeneration phase in seconds, keyed by phase name",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "reason": {
+          "description": "Reason which triggered the regeneration",
+          "type": "string"
+        }
+      }
+    },
     "EndpointState": {
       "description": "State of endpoint",
       "type": "string",
@@ -7193,6 +8324,10 @@ func init() {
           "description": "Status of internal controllers attached to this endpoint",
           "$ref": "#/definitions/ControllerStatuses"
         },
+        "dns-stats": {
+          "description": "Aggregated DNS resolution statistics as observed by the DNS proxy",
+          "$ref": "#/definitions/EndpointDNSStats"
+        },
         "external-identifiers": {
           "description": "Unique identifiers for this endpoint from outside cilium",
           "$ref": "#/definitions/EndpointIdentifiers"
@@ -7229,6 +8364,10 @@ func init() {
           "description": "The configuration in effect on this endpoint",
           "$ref": "#/definitions/EndpointConfigurationSpec"
         },
+        "regeneration": {
+          "description": "Statistics of the last completed regeneration of this endpoint",
+          "$ref": "#/definitions/EndpointRegenerationStatistics"
+        },
         "state": {
           "description": "Current state of endpoint",
           "$ref": "#/definitions/EndpointState"
@@ -7270,6 +8409,65 @@ func init() {
     "Error": {
       "type": "string"
     },
+    "FQDNExplainReport": {
+      "description": "Interaction of a DNS name with ToFQDNs policy, the DNS cache and programmed identities",
+      "type": "object",
+      "properties": {
+        "lookups": {
+          "description": "Cached DNS lookups for the name",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DNSLookup"
+          }
+        },
+        "name": {
+          "description": "The DNS name the report was generated for",
+          "type": "string"
+        },
+        "selectors": {
+          "description": "ToFQDNs selectors matching the name",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FQDNExplainSelector"
+          }
+        }
+      }
+    },
+    "FQDNExplainSelector": {
+      "description": "A ToFQDNs selector matching a DNS name, with the policy rules containing it and the state programmed for it",
+      "type": "object",
+      "properties": {
+        "endpoints": {
+          "description": "IDs of local endpoints selected by the policy rules containing the selector",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "identities": {
+          "description": "Identities programmed for the selector",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "policies": {
+          "description": "Labels of the policy rules containing the selector",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "regexString": {
+          "description": "Regular expression the selector is compiled to",
+          "type": "string"
+        },
+        "selector": {
+          "description": "The ToFQDNs selector",
+          "type": "string"
+        }
+      }
+    },
     "FrontendAddress": {
       "description": "Layer 4 address. The protocol is currently ignored, all services will\nbehave as if protocol any is specified. To restrict to a particular\nprotocol, use policy.\n",
       "type": "object",
@@ -8482,6 +9680,46 @@ func init() {
         }
       }
     },
+    "PolicyMapDirectionStatistics": {
+      "description": "Statistics of the policy map entries of one traffic direction\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "allow-entries": {
+          "description": "Number of entries allowing traffic",
+          "type": "integer"
+        },
+        "deny-entries": {
+          "description": "Number of entries denying traffic",
+          "type": "integer"
+        },
+        "l7-redirect-entries": {
+          "description": "Number of entries redirecting traffic to an L7 proxy",
+          "type": "integer"
+        },
+        "wildcard-entries": {
+          "description": "Number of entries matching all identities",
+          "type": "integer"
+        }
+      }
+    },
+    "PolicyMapStatistics": {
+      "description": "Per-direction statistics of the policy map entries of an endpoint\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "egress": {
+          "description": "Statistics of the egress policy map entries",
+          "$ref": "#/definitions/PolicyMapDirectionStatistics"
+        },
+        "ingress": {
+          "description": "Statistics of the ingress policy map entries",
+          "$ref": "#/definitions/PolicyMapDirectionStatistics"
+        },
+        "revision": {
+          "description": "The policy revision the statistics were computed from",
+          "type": "integer"
+        }
+      }
+    },
     "PolicyRule": {
       "description": "A policy rule including the rule labels it derives from\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -9262,6 +10500,26 @@ func init() {
           }
         }
       }
+    },
+    "XDSResourceGroup": {
+      "description": "Resources of a single xDS type published to the Envoy proxy",
+      "properties": {
+        "resources": {
+          "description": "JSON representation of the published resources",
+          "type": "array",
+          "items": {
+            "type": "object"
+          }
+        },
+        "type-url": {
+          "description": "xDS type URL of the resources in this group",
+          "type": "string"
+        },
+        "version": {
+          "description": "Version of the xDS cache these resources were read at",
+          "type": "integer"
+        }
+      }
     }
   },
   "parameters": {
@@ -60,6 +60,9 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		PolicyDeleteFqdnCacheHandler: policy.DeleteFqdnCacheHandlerFunc(func(params policy.DeleteFqdnCacheParams) middleware.Responder {
 			return middleware.NotImplemented("operation policy.DeleteFqdnCache has not yet been implemented")
 		}),
+		PolicyDeleteFqdnZombiesHandler: policy.DeleteFqdnZombiesHandlerFunc(func(params policy.DeleteFqdnZombiesParams) middleware.Responder {
+			return middleware.NotImplemented("operation policy.DeleteFqdnZombies has not yet been implemented")
+		}),
 		IpamDeleteIpamIPHandler: ipam.DeleteIpamIPHandlerFunc(func(params ipam.DeleteIpamIPParams) middleware.Responder {
 			return middleware.NotImplemented("operation ipam.DeleteIpamIP has not yet been implemented")
 		}),
@@ -75,12 +78,18 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		ServiceDeleteServiceIDHandler: service.DeleteServiceIDHandlerFunc(func(params service.DeleteServiceIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.DeleteServiceID has not yet been implemented")
 		}),
+		EndpointGetAttributionHandler: endpoint.GetAttributionHandlerFunc(func(params endpoint.GetAttributionParams) middleware.Responder {
+			return middleware.NotImplemented("operation endpoint.GetAttribution has not yet been implemented")
+		}),
 		DaemonGetClusterNodesHandler: daemon.GetClusterNodesHandlerFunc(func(params daemon.GetClusterNodesParams) middleware.Responder {
 			return middleware.NotImplemented("operation daemon.GetClusterNodes has not yet been implemented")
 		}),
 		DaemonGetConfigHandler: daemon.GetConfigHandlerFunc(func(params daemon.GetConfigParams) middleware.Responder {
 			return middleware.NotImplemented("operation daemon.GetConfig has not yet been implemented")
 		}),
+		DaemonGetControllersHandler: daemon.GetControllersHandlerFunc(func(params daemon.GetControllersParams) middleware.Responder {
+			return middleware.NotImplemented("operation daemon.GetControllers has not yet been implemented")
+		}),
 		DaemonGetDebuginfoHandler: daemon.GetDebuginfoHandlerFunc(func(params daemon.GetDebuginfoParams) middleware.Responder {
 			return middleware.NotImplemented("operation daemon.GetDebuginfo has not yet been implemented")
 		}),
@@ -102,15 +111,27 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		EndpointGetEndpointIDLogHandler: endpoint.GetEndpointIDLogHandlerFunc(func(params endpoint.GetEndpointIDLogParams) middleware.Responder {
 			return middleware.NotImplemented("operation endpoint.GetEndpointIDLog has not yet been implemented")
 		}),
+		EndpointGetEndpointIDStatsHandler: endpoint.GetEndpointIDStatsHandlerFunc(func(params endpoint.GetEndpointIDStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation endpoint.GetEndpointIDStats has not yet been implemented")
+		}),
 		PolicyGetFqdnCacheHandler: policy.GetFqdnCacheHandlerFunc(func(params policy.GetFqdnCacheParams) middleware.Responder {
 			return middleware.NotImplemented("operation policy.GetFqdnCache has not yet been implemented")
 		}),
 		PolicyGetFqdnCacheIDHandler: policy.GetFqdnCacheIDHandlerFunc(func(params policy.GetFqdnCacheIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation policy.GetFqdnCacheID has not yet been implemented")
 		}),
+		PolicyGetFqdnExplainNameHandler: policy.GetFqdnExplainNameHandlerFunc(func(params policy.GetFqdnExplainNameParams) middleware.Responder {
+			return middleware.NotImplemented("operation policy.GetFqdnExplainName has not yet been implemented")
+		}),
 		PolicyGetFqdnNamesHandler: policy.GetFqdnNamesHandlerFunc(func(params policy.GetFqdnNamesParams) middleware.Responder {
 			return middleware.NotImplemented("operation policy.GetFqdnNames has not yet been implemented")
 		}),
+		PolicyGetFqdnProxyRulesHandler: policy.GetFqdnProxyRulesHandlerFunc(func(params policy.GetFqdnProxyRulesParams) middleware.Responder {
+			return middleware.NotImplemented("operation policy.GetFqdnProxyRules has not yet been implemented")
+		}),
+		PolicyGetFqdnZombiesHandler: policy.GetFqdnZombiesHandlerFunc(func(params policy.GetFqdnZombiesParams) middleware.Responder {
+			return middleware.NotImplemented("operation policy.GetFqdnZombies has not yet been implemented")
+		}),
 		DaemonGetHealthzHandler: daemon.GetHealthzHandlerFunc(func(params daemon.GetHealthzParams) middleware.Responder {
 			return middleware.NotImplemented("operation daemon.GetHealthz has not yet been implemented")
 		}),
@@ -153,6 +174,9 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		PrefilterGetPrefilterHandler: prefilter.GetPrefilterHandlerFunc(func(params prefilter.GetPrefilterParams) middleware.Responder {
 			return middleware.NotImplemented("operation prefilter.GetPrefilter has not yet been implemented")
 		}),
+		DaemonGetProxyXdsHandler: daemon.GetProxyXdsHandlerFunc(func(params daemon.GetProxyXdsParams) middleware.Responder {
+			return middleware.NotImplemented("operation daemon.GetProxyXds has not yet been implemented")
+		}),
 		RecorderGetRecorderHandler: recorder.GetRecorderHandlerFunc(func(params recorder.GetRecorderParams) middleware.Responder {
 			return middleware.NotImplemented("operation recorder.GetRecorder has not yet been implemented")
 		}),
@@ -183,6 +207,9 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		PrefilterPatchPrefilterHandler: prefilter.PatchPrefilterHandlerFunc(func(params prefilter.PatchPrefilterParams) middleware.Responder {
 			return middleware.NotImplemented("operation prefilter.PatchPrefilter has not yet been implemented")
 		}),
+		DaemonPostControllersNameTriggerHandler: daemon.PostControllersNameTriggerHandlerFunc(func(params daemon.PostControllersNameTriggerParams) middleware.Responder {
+			return middleware.NotImplemented("operation daemon.PostControllersNameTrigger has not yet been implemented")
+		}),
 		IpamPostIpamHandler: ipam.PostIpamHandlerFunc(func(params ipam.PostIpamParams) middleware.Responder {
 			return middleware.NotImplemented("operation ipam.PostIpam has not yet been implemented")
 		}),
@@ -239,6 +266,8 @@ type CiliumAPIAPI struct {
 	EndpointDeleteEndpointIDHandler endpoint.DeleteEndpointIDHandler
 	// PolicyDeleteFqdnCacheHandler sets the operation handler for the delete fqdn cache operation
 	PolicyDeleteFqdnCacheHandler policy.DeleteFqdnCacheHandler
+	// PolicyDeleteFqdnZombiesHandler sets the operation handler for the delete fqdn zombies operation
+	PolicyDeleteFqdnZombiesHandler policy.DeleteFqdnZombiesHandler
 	// IpamDeleteIpamIPHandler sets the operation handler for the delete ipam IP operation
 	IpamDeleteIpamIPHandler ipam.DeleteIpamIPHandler
 	// PolicyDeletePolicyHandler sets the operation handler for the delete policy operation
@@ -249,10 +278,14 @@ type CiliumAPIAPI struct {
 	RecorderDeleteRecorderIDHandler recorder.DeleteRecorderIDHandler
 	// ServiceDeleteServiceIDHandler sets the operation handler for the delete service ID operation
 	ServiceDeleteServiceIDHandler service.DeleteServiceIDHandler
+	// EndpointGetAttributionHandler sets the operation handler for the get attribution operation
+	EndpointGetAttributionHandler endpoint.GetAttributionHandler
 	// DaemonGetClusterNodesHandler sets the operation handler for the get cluster nodes operation
 	DaemonGetClusterNodesHandler daemon.GetClusterNodesHandler
 	// DaemonGetConfigHandler sets the operation handler for the get config operation
 	DaemonGetConfigHandler daemon.GetConfigHandler
+	// DaemonGetControllersHandler sets the operation handler for the get controllers operation
+	DaemonGetControllersHandler daemon.GetControllersHandler
 	// DaemonGetDebuginfoHandler sets the operation handler for the get debuginfo operation
 	DaemonGetDebuginfoHandler daemon.GetDebuginfoHandler
 	// EndpointGetEndpointHandler sets the operation handler for the get endpoint operation
@@ -267,12 +300,20 @@ type CiliumAPIAPI struct {
 	EndpointGetEndpointIDLabelsHandler endpoint.GetEndpointIDLabelsHandler
 	// EndpointGetEndpointIDLogHandler sets the operation handler for the get endpoint ID log operation
 	EndpointGetEndpointIDLogHandler endpoint.GetEndpointIDLogHandler
+	// EndpointGetEndpointIDStatsHandler sets the operation handler for the get endpoint ID stats operation
+	EndpointGetEndpointIDStatsHandler endpoint.GetEndpointIDStatsHandler
 	// PolicyGetFqdnCacheHandler sets the operation handler for the get fqdn cache operation
 	PolicyGetFqdnCacheHandler policy.GetFqdnCacheHandler
 	// PolicyGetFqdnCacheIDHandler sets the operation handler for the get fqdn cache ID operation
 	PolicyGetFqdnCacheIDHandler policy.GetFqdnCacheIDHandler
+	// PolicyGetFqdnExplainNameHandler sets the operation handler for the get fqdn explain name operation
+	PolicyGetFqdnExplainNameHandler policy.GetFqdnExplainNameHandler
 	// PolicyGetFqdnNamesHandler sets the operation handler for the get fqdn names operation
 	PolicyGetFqdnNamesHandler policy.GetFqdnNamesHandler
+	// PolicyGetFqdnProxyRulesHandler sets the operation handler for the get fqdn proxy rules operation
+	PolicyGetFqdnProxyRulesHandler policy.GetFqdnProxyRulesHandler
+	// PolicyGetFqdnZombiesHandler sets the operation handler for the get fqdn zombies operation
+	PolicyGetFqdnZombiesHandler policy.GetFqdnZombiesHandler
 	// DaemonGetHealthzHandler sets the operation handler for the get healthz operation
 	DaemonGetHealthzHandler daemon.GetHealthzHandler
 	// PolicyGetIPHandler sets the operation handler for the get IP operation
@@ -301,6 +342,8 @@ type CiliumAPIAPI struct {
 	PolicyGetPolicySelectorsHandler policy.GetPolicySelectorsHandler
 	// PrefilterGetPrefilterHandler sets the operation handler for the get prefilter operation
 	PrefilterGetPrefilterHandler prefilter.GetPrefilterHandler
+	// DaemonGetProxyXdsHandler sets the operation handler for the get proxy xds operation
+	DaemonGetProxyXdsHandler daemon.GetProxyXdsHandler
 	// RecorderGetRecorderHandler sets the operation handler for the get recorder operation
 	RecorderGetRecorderHandler recorder.GetRecorderHandler
 	// RecorderGetRecorderIDHandler sets the operation handler for the get recorder ID operation
@@ -321,6 +364,8 @@ type CiliumAPIAPI struct {
 	EndpointPatchEndpointIDLabelsHandler endpoint.PatchEndpointIDLabelsHandler
 	// PrefilterPatchPrefilterHandler sets the operation handler for the patch prefilter operation
 	PrefilterPatchPrefilterHandler prefilter.PatchPrefilterHandler
+	// DaemonPostControllersNameTriggerHandler sets the operation handler for the post controllers name trigger operation
+	DaemonPostControllersNameTriggerHandler daemon.PostControllersNameTriggerHandler
 	// IpamPostIpamHandler sets the operation handler for the post ipam operation
 	IpamPostIpamHandler ipam.PostIpamHandler
 	// IpamPostIpamIPHandler sets the operation handler for the post ipam IP operation
@@ -415,6 +460,9 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.PolicyDeleteFqdnCacheHandler == nil {
 		unregistered = append(unregistered, "policy.DeleteFqdnCacheHandler")
 	}
+	if o.PolicyDeleteFqdnZombiesHandler == nil {
+		unregistered = append(unregistered, "policy.DeleteFqdnZombiesHandler")
+	}
 	if o.IpamDeleteIpamIPHandler == nil {
 		unregistered = append(unregistered, "ipam.DeleteIpamIPHandler")
 	}
@@ -430,12 +478,18 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.ServiceDeleteServiceIDHandler == nil {
 		unregistered = append(unregistered, "service.DeleteServiceIDHandler")
 	}
+	if o.EndpointGetAttributionHandler == nil {
+		unregistered = append(unregistered, "endpoint.GetAttributionHandler")
+	}
 	if o.DaemonGetClusterNodesHandler == nil {
 		unregistered = append(unregistered, "daemon.GetClusterNodesHandler")
 	}
 	if o.DaemonGetConfigHandler == nil {
 		unregistered = append(unregistered, "daemon.GetConfigHandler")
 	}
+	if o.DaemonGetControllersHandler == nil {
+		unregistered = append(unregistered, "daemon.GetControllersHandler")
+	}
 	if o.DaemonGetDebuginfoHandler == nil {
 		unregistered = append(unregistered, "daemon.GetDebuginfoHandler")
 	}
@@ -457,15 +511,27 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.EndpointGetEndpointIDLogHandler == nil {
 		unregistered = append(unregistered, "endpoint.GetEndpointIDLogHandler")
 	}
+	if o.EndpointGetEndpointIDStatsHandler == nil {
+		unregistered = append(unregistered, "endpoint.GetEndpointIDStatsHandler")
+	}
 	if o.PolicyGetFqdnCacheHandler == nil {
 		unregistered = append(unregistered, "policy.GetFqdnCacheHandler")
 	}
 	if o.PolicyGetFqdnCacheIDHandler == nil {
 		unregistered = append(unregistered, "policy.GetFqdnCacheIDHandler")
 	}
+	if o.PolicyGetFqdnExplainNameHandler == nil {
+		unregistered = append(unregistered, "policy.GetFqdnExplainNameHandler")
+	}
 	if o.PolicyGetFqdnNamesHandler == nil {
 		unregistered = append(unregistered, "policy.GetFqdnNamesHandler")
 	}
+	if o.PolicyGetFqdnProxyRulesHandler == nil {
+		unregistered = append(unregistered, "policy.GetFqdnProxyRulesHandler")
+	}
+	if o.PolicyGetFqdnZombiesHandler == nil {
+		unregistered = append(unregistered, "policy.GetFqdnZombiesHandler")
+	}
 	if o.DaemonGetHealthzHandler == nil {
 		unregistered = append(unregistered, "daemon.GetHealthzHandler")
 	}
@@ -508,6 +574,9 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.PrefilterGetPrefilterHandler == nil {
 		unregistered = append(unregistered, "prefilter.GetPrefilterHandler")
 	}
+	if o.DaemonGetProxyXdsHandler == nil {
+		unregistered = append(unregistered, "daemon.GetProxyXdsHandler")
+	}
 	if o.RecorderGetRecorderHandler == nil {
 		unregistered = append(unregistered, "recorder.GetRecorderHandler")
 	}
@@ -538,6 +607,9 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.PrefilterPatchPrefilterHandler == nil {
 		unregistered = append(unregistered, "prefilter.PatchPrefilterHandler")
 	}
+	if o.DaemonPostControllersNameTriggerHandler == nil {
+		unregistered = append(unregistered, "daemon.PostControllersNameTriggerHandler")
+	}
 	if o.IpamPostIpamHandler == nil {
 		unregistered = append(unregistered, "ipam.PostIpamHandler")
 	}
@@ -655,6 +727,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/fqdn/zombies"] = policy.NewDeleteFqdnZombies(o.context, o.PolicyDeleteFqdnZombiesHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/ipam/{ip}"] = ipam.NewDeleteIpamIP(o.context, o.IpamDeleteIpamIPHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -675,6 +751,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/attribution"] = endpoint.NewGetAttribution(o.context, o.EndpointGetAttributionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/cluster/nodes"] = daemon.NewGetClusterNodes(o.context, o.DaemonGetClusterNodesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -683,6 +763,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/controllers"] = daemon.NewGetControllers(o.context, o.DaemonGetControllersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/debuginfo"] = daemon.NewGetDebuginfo(o.context, o.DaemonGetDebuginfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -711,6 +795,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/endpoint/{id}/stats"] = endpoint.NewGetEndpointIDStats(o.context, o.EndpointGetEndpointIDStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/fqdn/cache"] = policy.NewGetFqdnCache(o.context, o.PolicyGetFqdnCacheHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -719,10 +807,22 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/fqdn/explain/{name}"] = policy.NewGetFqdnExplainName(o.context, o.PolicyGetFqdnExplainNameHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/fqdn/names"] = policy.NewGetFqdnNames(o.context, o.PolicyGetFqdnNamesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/fqdn/proxy/rules"] = policy.NewGetFqdnProxyRules(o.context, o.PolicyGetFqdnProxyRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/fqdn/zombies"] = policy.NewGetFqdnZombies(o.context, o.PolicyGetFqdnZombiesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/healthz"] = daemon.NewGetHealthz(o.context, o.DaemonGetHealthzHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -779,6 +879,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/proxy/xds"] = daemon.NewGetProxyXds(o.context, o.DaemonGetProxyXdsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/recorder"] = recorder.NewGetRecorder(o.context, o.RecorderGetRecorderHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -819,6 +923,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/controllers/{name}/trigger"] = daemon.NewPostControllersNameTrigger(o.context, o.DaemonPostControllersNameTriggerHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/ipam"] = ipam.NewPostIpam(o.context, o.IpamPostIpamHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -33,6 +33,10 @@ type DeleteFqdnCacheParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*String describing an endpoint with the format ``[prefix:]id``
+	  In: query
+	*/
+	EndpointID *string
 	/*A toFQDNs compatible matchPattern expression
 	  In: query
 	*/
@@ -50,6 +54,11 @@ func (o *DeleteFqdnCacheParams) BindRequest(r *http.Request, route *middleware.M
 
 	qs := runtime.Values(r.URL.Query())
 
+	qEndpointID, qhkEndpointID, _ := qs.GetOK("endpoint-id")
+	if err := o.bindEndpointID(qEndpointID, qhkEndpointID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qMatchpattern, qhkMatchpattern, _ := qs.GetOK("matchpattern")
 	if err := o.bindMatchpattern(qMatchpattern, qhkMatchpattern, route.Formats); err != nil {
 		res = append(res, err)
@@ -61,6 +70,24 @@ func (o *DeleteFqdnCacheParams) BindRequest(r *http.Request, route *middleware.M
 	return nil
 }
 
+// bindEndpointID binds and validates parameter EndpointID from query.
+func (o *DeleteFqdnCacheParams) bindEndpointID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.EndpointID = &raw
+
+	return nil
+}
+
 // bindMatchpattern binds and validates parameter Matchpattern from query.
 func (o *DeleteFqdnCacheParams) bindMatchpattern(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -15,6 +15,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetFqdnCacheParams creates a new GetFqdnCacheParams object
@@ -37,6 +38,23 @@ type GetFqdnCacheParams struct {
 	  In: query
 	*/
 	Cidr *string
+	/*Continue token from the X-Continue header of a previous response
+	  In: query
+	*/
+	Continue *string
+	/*List of endpoint labels. Only lookups from endpoints carrying all
+	of the given labels are returned.
+
+	  In: query
+	*/
+	Labels []string
+	/*Maximum number of lookups to return. As responses are split on
+	endpoint boundaries, a page may exceed the limit by the lookups
+	of its last endpoint. 0 or absent returns everything.
+
+	  In: query
+	*/
+	Limit *int64
 	/*A toFQDNs compatible matchPattern expression
 	  In: query
 	*/
@@ -45,6 +63,12 @@ type GetFqdnCacheParams struct {
 	  In: query
 	*/
 	Source *string
+	/*Numeric security identity of the endpoints the lookups were
+	intercepted from.
+
+	  In: query
+	*/
+	SourceIdentity *int64
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -63,6 +87,21 @@ func (o *GetFqdnCacheParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	qContinue, qhkContinue, _ := qs.GetOK("continue")
+	if err := o.bindContinue(qContinue, qhkContinue, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLabels, qhkLabels, _ := qs.GetOK("labels")
+	if err := o.bindLabels(qLabels, qhkLabels, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qMatchpattern, qhkMatchpattern, _ := qs.GetOK("matchpattern")
 	if err := o.bindMatchpattern(qMatchpattern, qhkMatchpattern, route.Formats); err != nil {
 		res = append(res, err)
@@ -73,6 +112,11 @@ func (o *GetFqdnCacheParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	qSourceIdentity, qhkSourceIdentity, _ := qs.GetOK("source-identity")
+	if err := o.bindSourceIdentity(qSourceIdentity, qhkSourceIdentity, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -97,6 +141,73 @@ func (o *GetFqdnCacheParams) bindCidr(rawData []string, hasKey bool, formats str
 	return nil
 }
 
+// bindContinue binds and validates parameter Continue from query.
+func (o *GetFqdnCacheParams) bindContinue(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Continue = &raw
+
+	return nil
+}
+
+// bindLabels binds and validates array parameter Labels from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetFqdnCacheParams) bindLabels(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvLabels string
+	if len(rawData) > 0 {
+		qvLabels = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	labelsIC := swag.SplitByFormat(qvLabels, "")
+	if len(labelsIC) == 0 {
+		return nil
+	}
+
+	var labelsIR []string
+	for _, labelsIV := range labelsIC {
+		labelsI := labelsIV
+
+		labelsIR = append(labelsIR, labelsI)
+	}
+
+	o.Labels = labelsIR
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetFqdnCacheParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	return nil
+}
+
 // bindMatchpattern binds and validates parameter Matchpattern from query.
 func (o *GetFqdnCacheParams) bindMatchpattern(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -132,3 +243,25 @@ func (o *GetFqdnCacheParams) bindSource(rawData []string, hasKey bool, formats s
 
 	return nil
 }
+
+// bindSourceIdentity binds and validates parameter SourceIdentity from query.
+func (o *GetFqdnCacheParams) bindSourceIdentity(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("source-identity", "query", "int64", raw)
+	}
+	o.SourceIdentity = &value
+
+	return nil
+}
@@ -25,6 +25,11 @@ GetFqdnCacheOK Success
 swagger:response getFqdnCacheOK
 */
 type GetFqdnCacheOK struct {
+	/*Token to pass as the continue parameter to retrieve the
+	next page of a truncated result. Absent on the last page.
+
+	*/
+	XContinue string `json:"X-Continue"`
 
 	/*
 	  In: Body
@@ -38,6 +43,17 @@ func NewGetFqdnCacheOK() *GetFqdnCacheOK {
 	return &GetFqdnCacheOK{}
 }
 
+// WithXContinue adds the xContinue to the get fqdn cache o k response
+func (o *GetFqdnCacheOK) WithXContinue(xContinue string) *GetFqdnCacheOK {
+	o.XContinue = xContinue
+	return o
+}
+
+// SetXContinue sets the xContinue to the get fqdn cache o k response
+func (o *GetFqdnCacheOK) SetXContinue(xContinue string) {
+	o.XContinue = xContinue
+}
+
 // WithPayload adds the payload to the get fqdn cache o k response
 func (o *GetFqdnCacheOK) WithPayload(payload []*models.DNSLookup) *GetFqdnCacheOK {
 	o.Payload = payload
@@ -52,6 +68,13 @@ func (o *GetFqdnCacheOK) SetPayload(payload []*models.DNSLookup) {
 // WriteResponse to the client
 func (o *GetFqdnCacheOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Continue
+
+	xContinue := o.XContinue
+	if xContinue != "" {
+		rw.Header().Set("X-Continue", xContinue)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -35,6 +35,7 @@
 #include "lib/lxc.h"
 #include "lib/identity.h"
 #include "lib/policy.h"
+#include "lib/qos.h"
 
 /* Override LB_SELECTION initially defined in node_config.h to force bpf_lxc to use the random backend selection
  * algorithm for in-cluster traffic. Otherwise, it will fail with the Maglev hash algorithm because Cilium doesn't provision
@@ -250,9 +251,16 @@ static __always_inline int handle_ipv6_from_lxc(struct __ctx_buff *ctx, __u32 *d
 			*dst_id = info->sec_label;
 			tunnel_endpoint = info->tunnel_endpoint;
 			encrypt_key = get_min_encrypt_key(info->key);
+#ifdef ENABLE_IPSEC
+			if (identity_bypasses_encryption(SECLABEL) ||
+			    identity_bypasses_encryption(info->sec_label))
+				encrypt_key = 0;
+#endif /* ENABLE_IPSEC */
 #ifdef ENABLE_WIREGUARD
 			if (info->tunnel_endpoint != 0 &&
-			    !identity_is_node(info->sec_label))
+			    !identity_is_node(info->sec_label) &&
+			    !identity_bypasses_encryption(SECLABEL) &&
+			    !identity_bypasses_encryption(info->sec_label))
 				dst_remote_ep = true;
 #endif /* ENABLE_WIREGUARD */
 		} else {
@@ -770,15 +778,29 @@ static __always_inline int handle_ipv4_from_lxc(struct __ctx_buff *ctx, __u32 *d
 			*dst_id = info->sec_label;
 			tunnel_endpoint = info->tunnel_endpoint;
 			encrypt_key = get_min_encrypt_key(info->key);
+#ifdef ENABLE_IPSEC
+			/* Exempted identities have their traffic sent in plain
+			 * text, clearing the encryption key here takes them off
+			 * the IPSec path in both tunnel and native routing
+			 * modes.
+			 */
+			if (identity_bypasses_encryption(SECLABEL) ||
+			    identity_bypasses_encryption(info->sec_label))
+				encrypt_key = 0;
+#endif /* ENABLE_IPSEC */
 #ifdef ENABLE_WIREGUARD
 			/* If we detect that the dst is a remote endpoint, we
 			 * need to mark the packet. The ip rule which matches
 			 * on the MARK_MAGIC_ENCRYPT mark will steer the packet
 			 * to the Wireguard tunnel. The marking happens lower
 			 * in the code in the same place where we handle IPSec.
+			 * Exempted identities skip the mark and thus bypass
+			 * the Wireguard tunnel.
 			 */
 			if (info->tunnel_endpoint != 0 &&
-			    !identity_is_node(info->sec_label))
+			    !identity_is_node(info->sec_label) &&
+			    !identity_bypasses_encryption(SECLABEL) &&
+			    !identity_bypasses_encryption(info->sec_label))
 				dst_remote_ep = true;
 #endif /* ENABLE_WIREGUARD */
 		} else {
@@ -956,6 +978,18 @@ ct_recreate4:
 	if (!revalidate_data(ctx, &data, &data_end, &ip4))
 		return DROP_INVALID;
 
+#ifdef ENABLE_QOS_DSCP
+	/* Rewrite the DSCP field before the packet leaves the endpoint so
+	 * that locally delivered and forwarded traffic alike carry the mark.
+	 */
+	ret = qos_mark_dscp4(ctx, ETH_HLEN, ip4, SECLABEL, tuple->dport,
+			     tuple->nexthdr);
+	if (IS_ERR(ret))
+		return ret;
+	if (!revalidate_data(ctx, &data, &data_end, &ip4))
+		return DROP_INVALID;
+#endif /* ENABLE_QOS_DSCP */
+
 	/* Allow a hairpin packet to be redirected even if ENABLE_ROUTING is
 	 * disabled. Otherwise, the packet will be dropped by the kernel if
 	 * it is going to be routed via an interface it came from after it has
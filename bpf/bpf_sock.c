@@ -349,6 +349,10 @@ static __always_inline int __sock4_xlate_fwd(struct bpf_sock_addr *ctx,
 	if (sock4_skip_xlate(svc, orig_key.address))
 		return -EPERM;
 
+	/* Per-service override to block access from the host namespace. */
+	if (in_hostns && lb4_svc_is_no_hostns(svc))
+		return -EPERM;
+
 #ifdef ENABLE_L7_LB
 	/* Do not perform service translation at socker layer for
 	 * services with L7 load balancing as we need to postpone
@@ -998,6 +1002,10 @@ static __always_inline int __sock6_xlate_fwd(struct bpf_sock_addr *ctx,
 	if (sock6_skip_xlate(svc, &orig_key.address))
 		return -EPERM;
 
+	/* Per-service override to block access from the host namespace. */
+	if (in_hostns && lb6_svc_is_no_hostns(svc))
+		return -EPERM;
+
 #ifdef ENABLE_L7_LB
 	/* See __sock4_xlate_fwd for commentary. */
 	if (lb6_svc_is_l7loadbalancer(svc)) {
@@ -296,7 +296,8 @@ struct policy_key {
 struct policy_entry {
 	__be16		proxy_port;
 	__u8		deny:1,
-			pad:7;
+			audit:1,
+			pad:6;
 	__u8		pad0;
 	__u16		pad1;
 	__u16		pad2;
@@ -734,6 +735,8 @@ enum {
 	SVC_FLAG_NAT_46X64      = (1 << 1),  /* NAT-46/64 entry */
 	SVC_FLAG_L7LOADBALANCER = (1 << 2),  /* tproxy redirect to local l7 loadbalancer */
 	SVC_FLAG_LOOPBACK       = (1 << 3),  /* hostport with a loopback hostIP */
+	SVC_FLAG_NO_HOST_NS     = (1 << 4),  /* ClusterIP unreachable from host netns */
+	SVC_FLAG_PRESERVE_CLIENT_ID = (1 << 5),  /* Keep client saddr, dispatch via DSR */
 };
 
 /* Backend flags (lb{4,6}_backends->flags) */
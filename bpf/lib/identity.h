@@ -89,6 +89,21 @@ static __always_inline bool identity_is_cluster(__u32 identity)
 	return true;
 }
 
+#ifndef ENCRYPTION_BYPASS
+# define ENCRYPTION_BYPASS(id) return false;
+#endif
+
+/**
+ * identity_bypasses_encryption returns true if traffic from or to the given
+ * identity must be sent in plain text instead of being carried over the
+ * node-to-node encryption tunnel. The ENCRYPTION_BYPASS macro is generated
+ * from the --encryption-bypass-identities agent option.
+ */
+static __always_inline bool identity_bypasses_encryption(__u32 identity __maybe_unused)
+{
+	ENCRYPTION_BYPASS(identity);
+}
+
 #if __ctx_is == __ctx_skb
 static __always_inline __u32 inherit_identity_from_host(struct __ctx_buff *ctx, __u32 *identity)
 {
@@ -257,6 +257,30 @@ bool lb6_svc_is_loopback(const struct lb6_service *svc __maybe_unused)
 	return svc->flags2 & SVC_FLAG_LOOPBACK;
 }
 
+static __always_inline
+bool lb4_svc_is_no_hostns(const struct lb4_service *svc __maybe_unused)
+{
+	return svc->flags2 & SVC_FLAG_NO_HOST_NS;
+}
+
+static __always_inline
+bool lb6_svc_is_no_hostns(const struct lb6_service *svc __maybe_unused)
+{
+	return svc->flags2 & SVC_FLAG_NO_HOST_NS;
+}
+
+static __always_inline
+bool lb4_svc_is_preserve_client_id(const struct lb4_service *svc __maybe_unused)
+{
+	return svc->flags2 & SVC_FLAG_PRESERVE_CLIENT_ID;
+}
+
+static __always_inline
+bool lb6_svc_is_preserve_client_id(const struct lb6_service *svc __maybe_unused)
+{
+	return svc->flags2 & SVC_FLAG_PRESERVE_CLIENT_ID;
+}
+
 static __always_inline
 bool lb4_svc_has_src_range_check(const struct lb4_service *svc __maybe_unused)
 {
@@ -638,6 +662,17 @@ struct lb6_service *lb6_lookup_backend_slot(struct __ctx_buff *ctx __maybe_unuse
 }
 
 /* Backend slot 0 is always reserved for the service frontend. */
+static __always_inline __u32
+lb6_select_random_backend_id(struct __ctx_buff *ctx,
+			     struct lb6_key *key,
+			     const struct lb6_service *svc)
+{
+	__u16 slot = (get_prandom_u32() % svc->count) + 1;
+	struct lb6_service *be = lb6_lookup_backend_slot(ctx, key, slot);
+
+	return be ? be->backend_id : 0;
+}
+
 #if LB_SELECTION == LB_SELECTION_RANDOM
 static __always_inline __u32
 lb6_select_backend_id(struct __ctx_buff *ctx,
@@ -645,15 +680,12 @@ lb6_select_backend_id(struct __ctx_buff *ctx,
 		      const struct ipv6_ct_tuple *tuple __maybe_unused,
 		      const struct lb6_service *svc)
 {
-	__u16 slot = (get_prandom_u32() % svc->count) + 1;
-	struct lb6_service *be = lb6_lookup_backend_slot(ctx, key, slot);
-
-	return be ? be->backend_id : 0;
+	return lb6_select_random_backend_id(ctx, key, svc);
 }
 #elif LB_SELECTION == LB_SELECTION_MAGLEV
 static __always_inline __u32
-lb6_select_backend_id(struct __ctx_buff *ctx __maybe_unused,
-		      struct lb6_key *key __maybe_unused,
+lb6_select_backend_id(struct __ctx_buff *ctx,
+		      struct lb6_key *key,
 		      const struct ipv6_ct_tuple *tuple,
 		      const struct lb6_service *svc)
 {
@@ -662,8 +694,11 @@ lb6_select_backend_id(struct __ctx_buff *ctx __maybe_unused,
 	void *maglev_lut;
 
 	maglev_lut = map_lookup_elem(&LB6_MAGLEV_MAP_OUTER, &index);
+	/* No LUT is provisioned for services annotated to use random backend
+	 * selection.
+	 */
 	if (unlikely(!maglev_lut))
-		return 0;
+		return lb6_select_random_backend_id(ctx, key, svc);
 
 	backend_ids = map_lookup_elem(maglev_lut, &zero);
 	if (unlikely(!backend_ids))
@@ -1285,6 +1320,17 @@ struct lb4_service *lb4_lookup_backend_slot(struct __ctx_buff *ctx __maybe_unuse
 }
 
 /* Backend slot 0 is always reserved for the service frontend. */
+static __always_inline __u32
+lb4_select_random_backend_id(struct __ctx_buff *ctx,
+			     struct lb4_key *key,
+			     const struct lb4_service *svc)
+{
+	__u16 slot = (get_prandom_u32() % svc->count) + 1;
+	struct lb4_service *be = lb4_lookup_backend_slot(ctx, key, slot);
+
+	return be ? be->backend_id : 0;
+}
+
 #if LB_SELECTION == LB_SELECTION_RANDOM
 static __always_inline __u32
 lb4_select_backend_id(struct __ctx_buff *ctx,
@@ -1292,15 +1338,12 @@ lb4_select_backend_id(struct __ctx_buff *ctx,
 		      const struct ipv4_ct_tuple *tuple __maybe_unused,
 		      const struct lb4_service *svc)
 {
-	__u16 slot = (get_prandom_u32() % svc->count) + 1;
-	struct lb4_service *be = lb4_lookup_backend_slot(ctx, key, slot);
-
-	return be ? be->backend_id : 0;
+	return lb4_select_random_backend_id(ctx, key, svc);
 }
 #elif LB_SELECTION == LB_SELECTION_MAGLEV
 static __always_inline __u32
-lb4_select_backend_id(struct __ctx_buff *ctx __maybe_unused,
-		      struct lb4_key *key __maybe_unused,
+lb4_select_backend_id(struct __ctx_buff *ctx,
+		      struct lb4_key *key,
 		      const struct ipv4_ct_tuple *tuple,
 		      const struct lb4_service *svc)
 {
@@ -1309,8 +1352,11 @@ lb4_select_backend_id(struct __ctx_buff *ctx __maybe_unused,
 	void *maglev_lut;
 
 	maglev_lut = map_lookup_elem(&LB4_MAGLEV_MAP_OUTER, &index);
+	/* No LUT is provisioned for services annotated to use random backend
+	 * selection.
+	 */
 	if (unlikely(!maglev_lut))
-		return 0;
+		return lb4_select_random_backend_id(ctx, key, svc);
 
 	backend_ids = map_lookup_elem(maglev_lut, &zero);
 	if (unlikely(!backend_ids))
@@ -272,6 +272,60 @@ struct {
 } VTEP_MAP __section_maps_btf;
 #endif /* ENABLE_VTEP */
 
+#ifdef ENABLE_QOS_DSCP
+struct qos_policy_key {
+	__u32 identity;
+	__u16 dport;
+	__u8 protocol;
+	__u8 pad;
+};
+
+struct qos_policy_val {
+	__u8 dscp;
+	__u8 pad[3];
+};
+
+/* Global map of DSCP marking policies for egress traffic of selected
+ * identities, populated from userspace.
+ */
+struct {
+	__uint(type, BPF_MAP_TYPE_HASH);
+	__type(key, struct qos_policy_key);
+	__type(value, struct qos_policy_val);
+	__uint(pinning, LIBBPF_PIN_BY_NAME);
+	__uint(max_entries, QOS_POLICY_MAP_SIZE);
+	__uint(map_flags, CONDITIONAL_PREALLOC);
+} QOS_POLICY_MAP __section_maps_btf;
+#endif /* ENABLE_QOS_DSCP */
+
+#ifdef ENABLE_DROP_CACHE
+struct drop_cache_key {
+	__u32 src_identity;
+	__u32 dst_identity;
+	__u16 dport;
+	__u8 protocol;
+	__u8 pad;
+};
+
+struct drop_cache_val {
+	__u64 expires;
+	__u32 hits;
+	__u32 pad;
+};
+
+/* Global cache of denied policy verdicts, short-circuiting repeated
+ * identical policy lookups until the entry expires or userspace flushes
+ * the cache on a policy change.
+ */
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__type(key, struct drop_cache_key);
+	__type(value, struct drop_cache_val);
+	__uint(pinning, LIBBPF_PIN_BY_NAME);
+	__uint(max_entries, DROP_CACHE_MAP_SIZE);
+} DROP_CACHE_MAP __section_maps_btf;
+#endif /* ENABLE_DROP_CACHE */
+
 #ifndef SKIP_CALLS_MAP
 static __always_inline void ep_tail_call(struct __ctx_buff *ctx __maybe_unused,
 					 const __u32 index __maybe_unused)
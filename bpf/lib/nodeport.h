@@ -146,6 +146,22 @@ static __always_inline bool nodeport_uses_dsr6(const struct ipv6_ct_tuple *tuple
 	return nodeport_uses_dsr(tuple->nexthdr);
 }
 
+/* As nodeport_uses_dsr6(), but for the forward path where the service entry
+ * is known. Services flagged to preserve the client identity are dispatched
+ * via DSR also for protocols the hybrid mode would otherwise SNAT, so that
+ * the backend node sees the client source address.
+ */
+static __always_inline bool
+nodeport_uses_dsr6_svc(const struct ipv6_ct_tuple *tuple,
+		       const struct lb6_service *svc __maybe_unused)
+{
+#if defined(ENABLE_DSR) && defined(ENABLE_DSR_HYBRID)
+	if (lb6_svc_is_preserve_client_id(svc))
+		return true;
+#endif
+	return nodeport_uses_dsr6(tuple);
+}
+
 static __always_inline int nodeport_nat_ipv6_fwd(struct __ctx_buff *ctx,
 						 const union v6addr *addr)
 {
@@ -921,7 +937,7 @@ skip_service_lookup:
 	if (!backend_local && lb6_svc_is_hostport(svc))
 		return DROP_INVALID;
 
-	if (backend_local || !nodeport_uses_dsr6(&tuple)) {
+	if (backend_local || !nodeport_uses_dsr6_svc(&tuple, svc)) {
 		struct ct_state ct_state = {};
 
 		ret = ct_lookup6(get_ct_map6(&tuple), &tuple, ctx, l4_off,
@@ -964,7 +980,7 @@ redo:
 
 	if (!backend_local) {
 		edt_set_aggregate(ctx, 0);
-		if (nodeport_uses_dsr6(&tuple)) {
+		if (nodeport_uses_dsr6_svc(&tuple, svc)) {
 #if DSR_ENCAP_MODE == DSR_ENCAP_IPIP
 			ctx_store_meta(ctx, CB_HINT,
 				       ((__u32)tuple.sport << 16) | tuple.dport);
@@ -1203,6 +1219,18 @@ static __always_inline bool nodeport_uses_dsr4(const struct ipv4_ct_tuple *tuple
 	return nodeport_uses_dsr(tuple->nexthdr);
 }
 
+/* See nodeport_uses_dsr6_svc(). */
+static __always_inline bool
+nodeport_uses_dsr4_svc(const struct ipv4_ct_tuple *tuple,
+		       const struct lb4_service *svc __maybe_unused)
+{
+#if defined(ENABLE_DSR) && defined(ENABLE_DSR_HYBRID)
+	if (lb4_svc_is_preserve_client_id(svc))
+		return true;
+#endif
+	return nodeport_uses_dsr4(tuple);
+}
+
 static __always_inline int nodeport_nat_ipv4_fwd(struct __ctx_buff *ctx)
 {
 	struct ipv4_nat_target target = {
@@ -1877,7 +1905,7 @@ skip_service_lookup:
 	/* Reply from DSR packet is never seen on this node again hence no
 	 * need to track in here.
 	 */
-	if (backend_local || !nodeport_uses_dsr4(&tuple)) {
+	if (backend_local || !nodeport_uses_dsr4_svc(&tuple, svc)) {
 		struct ct_state ct_state = {};
 
 		ret = ct_lookup4(get_ct_map4(&tuple), &tuple, ctx, l4_off,
@@ -1923,7 +1951,7 @@ redo:
 
 	if (!backend_local) {
 		edt_set_aggregate(ctx, 0);
-		if (nodeport_uses_dsr4(&tuple)) {
+		if (nodeport_uses_dsr4_svc(&tuple, svc)) {
 #if DSR_ENCAP_MODE == DSR_ENCAP_IPIP
 			ctx_store_meta(ctx, CB_HINT,
 				       ((__u32)tuple.sport << 16) | tuple.dport);
@@ -96,10 +96,60 @@ account(struct __ctx_buff *ctx, struct policy_entry *policy)
 	__sync_fetch_and_add(&policy->bytes, ctx_full_len(ctx));
 }
 
+#ifdef ENABLE_DROP_CACHE
+/* drop_cache_check returns true if a still valid cached deny verdict exists
+ * for the tuple, accounting the short-circuited lookup in the entry's hit
+ * counter. Expired entries are removed on the way.
+ */
+static __always_inline bool
+drop_cache_check(__u32 src_id, __u32 dst_id, __u16 dport, __u8 proto)
+{
+	struct drop_cache_key key = {
+		.src_identity = src_id,
+		.dst_identity = dst_id,
+		.dport = dport,
+		.protocol = proto,
+	};
+	struct drop_cache_val *val;
+
+	val = map_lookup_elem(&DROP_CACHE_MAP, &key);
+	if (!val)
+		return false;
+	if (ktime_get_ns() > val->expires) {
+		map_delete_elem(&DROP_CACHE_MAP, &key);
+		return false;
+	}
+	__sync_fetch_and_add(&val->hits, 1);
+	return true;
+}
+
+/* drop_cache_update caches a denied policy verdict for the tuple for
+ * DROP_CACHE_TTL nanoseconds. Userspace flushes the cache whenever the
+ * policy changes, so a cached verdict never outlives the policy which
+ * produced it.
+ */
+static __always_inline void
+drop_cache_update(__u32 src_id, __u32 dst_id, __u16 dport, __u8 proto)
+{
+	struct drop_cache_key key = {
+		.src_identity = src_id,
+		.dst_identity = dst_id,
+		.dport = dport,
+		.protocol = proto,
+	};
+	struct drop_cache_val val = {
+		.expires = ktime_get_ns() + DROP_CACHE_TTL,
+	};
+
+	map_update_elem(&DROP_CACHE_MAP, &key, &val, 0);
+}
+#endif /* ENABLE_DROP_CACHE */
+
 static __always_inline int
 __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 		    __u32 remote_id, __u16 dport, __u8 proto, int dir,
-		    bool is_untracked_fragment, __u8 *match_type)
+		    bool is_untracked_fragment, __u8 *match_type,
+		    __u8 *audited)
 {
 	struct policy_entry *policy;
 	struct policy_key key = {
@@ -181,8 +231,9 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 
 			account(ctx, policy);
 			*match_type = POLICY_MATCH_L3_L4;
+			*audited = policy->audit;
 			if (unlikely(policy->deny))
-				return DROP_POLICY_DENY;
+				return *audited ? CTX_ACT_OK : DROP_POLICY_DENY;
 			return policy->proxy_port;
 		}
 
@@ -192,8 +243,9 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 		if (likely(policy)) {
 			account(ctx, policy);
 			*match_type = POLICY_MATCH_L4_ONLY;
+			*audited = policy->audit;
 			if (unlikely(policy->deny))
-				return DROP_POLICY_DENY;
+				return *audited ? CTX_ACT_OK : DROP_POLICY_DENY;
 			return policy->proxy_port;
 		}
 
@@ -203,8 +255,9 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 		if (likely(policy)) {
 			account(ctx, policy);
 			*match_type = POLICY_MATCH_L4_ONLY;
+			*audited = policy->audit;
 			if (unlikely(policy->deny))
-				return DROP_POLICY_DENY;
+				return *audited ? CTX_ACT_OK : DROP_POLICY_DENY;
 			return CTX_ACT_OK;
 		}
 		key.sec_label = remote_id;
@@ -217,8 +270,9 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 	if (likely(policy)) {
 		account(ctx, policy);
 		*match_type = POLICY_MATCH_L3_ONLY;
+		*audited = policy->audit;
 		if (unlikely(policy->deny))
-			return DROP_POLICY_DENY;
+			return *audited ? CTX_ACT_OK : DROP_POLICY_DENY;
 		return CTX_ACT_OK;
 	}
 
@@ -228,8 +282,9 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 	if (policy) {
 		account(ctx, policy);
 		*match_type = POLICY_MATCH_ALL;
+		*audited = policy->audit;
 		if (unlikely(policy->deny))
-			return DROP_POLICY_DENY;
+			return *audited ? CTX_ACT_OK : DROP_POLICY_DENY;
 		return CTX_ACT_OK;
 	}
 
@@ -265,15 +320,19 @@ policy_can_access_ingress(struct __ctx_buff *ctx, __u32 src_id, __u32 dst_id,
 {
 	int ret;
 
+	*audited = 0;
+#ifdef ENABLE_DROP_CACHE
+	if (drop_cache_check(src_id, dst_id, dport, proto))
+		return DROP_POLICY_DENY;
+#endif
 	ret = __policy_can_access(&POLICY_MAP, ctx, dst_id, src_id, dport,
 				  proto, CT_INGRESS, is_untracked_fragment,
-				  match_type);
+				  match_type, audited);
 	if (ret >= CTX_ACT_OK)
 		return ret;
 
 	cilium_dbg(ctx, DBG_POLICY_DENIED, src_id, dst_id);
 
-	*audited = 0;
 #ifdef POLICY_AUDIT_MODE
 	if (IS_ERR(ret)) {
 		ret = CTX_ACT_OK;
@@ -281,6 +340,15 @@ policy_can_access_ingress(struct __ctx_buff *ctx, __u32 src_id, __u32 dst_id,
 	}
 #endif
 
+#ifdef ENABLE_DROP_CACHE
+	/* Only explicit denies are cached. Missing policy may be filled in
+	 * by an ongoing regeneration, and audited verdicts must keep going
+	 * through the slow path to emit their verdict events.
+	 */
+	if (ret == DROP_POLICY_DENY && !*audited)
+		drop_cache_update(src_id, dst_id, dport, proto);
+#endif
+
 	return ret;
 }
 
@@ -300,18 +368,26 @@ policy_can_egress(struct __ctx_buff *ctx, __u32 src_id, __u32 dst_id,
 #ifdef HAVE_ENCAP
 	if (src_id != HOST_ID && is_encap(dport, proto))
 		return DROP_ENCAP_PROHIBITED;
+#endif
+	*audited = 0;
+#ifdef ENABLE_DROP_CACHE
+	if (drop_cache_check(src_id, dst_id, dport, proto))
+		return DROP_POLICY_DENY;
 #endif
 	ret = __policy_can_access(&POLICY_MAP, ctx, src_id, dst_id, dport,
-				  proto, CT_EGRESS, false, match_type);
+				  proto, CT_EGRESS, false, match_type, audited);
 	if (ret >= 0)
 		return ret;
 	cilium_dbg(ctx, DBG_POLICY_DENIED, src_id, dst_id);
-	*audited = 0;
 #ifdef POLICY_AUDIT_MODE
 	if (IS_ERR(ret)) {
 		ret = CTX_ACT_OK;
 		*audited = 1;
 	}
+#endif
+#ifdef ENABLE_DROP_CACHE
+	if (ret == DROP_POLICY_DENY && !*audited)
+		drop_cache_update(src_id, dst_id, dport, proto);
 #endif
 	return ret;
 }
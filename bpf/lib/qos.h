@@ -0,0 +1,65 @@
+/* SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause) */
+/* Copyright Authors of Cilium */
+
+#ifndef __LIB_QOS_H_
+#define __LIB_QOS_H_
+
+#include "common.h"
+#include "ipv4.h"
+#include "maps.h"
+
+#ifdef ENABLE_QOS_DSCP
+/* qos_policy_lookup returns the DSCP marking policy for the (identity,
+ * destination port, protocol) tuple, falling back to the identity-wide
+ * policy matching any port and protocol.
+ */
+static __always_inline struct qos_policy_val *
+qos_policy_lookup(__u32 identity, __u16 dport, __u8 proto)
+{
+	struct qos_policy_key key = {
+		.identity = identity,
+		.dport = dport,
+		.protocol = proto,
+	};
+	struct qos_policy_val *val;
+
+	val = map_lookup_elem(&QOS_POLICY_MAP, &key);
+	if (val)
+		return val;
+
+	key.dport = 0;
+	key.protocol = 0;
+	return map_lookup_elem(&QOS_POLICY_MAP, &key);
+}
+
+/* qos_mark_dscp4 rewrites the DSCP field of the IPv4 header according to
+ * the marking policy of the tuple, if any, preserving the ECN bits. The
+ * caller must revalidate direct packet access after a successful return.
+ */
+static __always_inline int
+qos_mark_dscp4(struct __ctx_buff *ctx, int l3_off, const struct iphdr *ip4,
+	       __u32 identity, __u16 dport, __u8 proto)
+{
+	struct qos_policy_val *val;
+	__u8 tos = ip4->tos, new_tos;
+
+	val = qos_policy_lookup(identity, dport, proto);
+	if (!val)
+		return 0;
+
+	new_tos = (__u8)(val->dscp << 2) | (tos & 0x3);
+	if (new_tos == tos)
+		return 0;
+
+	/* l3_csum_replace() takes at min 2 bytes, zero extended. */
+	if (l3_csum_replace(ctx, l3_off + offsetof(struct iphdr, check),
+			    tos, new_tos, 2) < 0)
+		return DROP_CSUM_L3;
+	if (ctx_store_bytes(ctx, l3_off + offsetof(struct iphdr, tos),
+			    &new_tos, sizeof(new_tos), 0) < 0)
+		return DROP_WRITE_ERROR;
+
+	return 0;
+}
+#endif /* ENABLE_QOS_DSCP */
+#endif /* __LIB_QOS_H_ */
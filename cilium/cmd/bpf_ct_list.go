@@ -28,12 +28,17 @@ var (
 		Short:   "List connection tracking entries",
 		PreRun:  requireEndpointIDorGlobal,
 		Run: func(cmd *cobra.Command, args []string) {
+			if ctListSnapshotDir != "" && timeDiff {
+				Fatalf("--time-diff is not supported when reading from a snapshot")
+			}
 			maps := getMaps(args[0])
 			ctMaps := make([]interface{}, len(maps))
 			for i, m := range maps {
 				ctMaps[i] = m
 			}
-			common.RequireRootPrivilege("cilium bpf ct list")
+			if ctListSnapshotDir == "" {
+				common.RequireRootPrivilege("cilium bpf ct list")
+			}
 			dumpCt(ctMaps, args[0])
 		},
 	}
@@ -41,18 +46,25 @@ var (
 	timeDiff                bool
 	timeDiffClockSourceMode string
 	timeDiffClockSourceHz   int64
+	ctListSnapshotDir       string
 )
 
 func init() {
 	bpfCtListCmd.Flags().BoolVarP(&timeDiff, "time-diff", "d", false, "print time difference for entries")
 	bpfCtListCmd.Flags().StringVar(&timeDiffClockSourceMode, "time-diff-clocksource-mode", "", "manually set clock source mode (instead of contacting the server)")
 	bpfCtListCmd.Flags().Int64Var(&timeDiffClockSourceHz, "time-diff-clocksource-hz", 250, "manually set clock source Hz")
+	bpfCtListCmd.Flags().StringVar(&ctListSnapshotDir, "snapshot", "", "Read entries from a snapshot directory written by \"cilium bpf snapshot\" instead of the live maps")
 	bpfCtCmd.AddCommand(bpfCtListCmd)
 	command.AddOutputOption(bpfCtListCmd)
 }
 
 func getMaps(eID string) []*ctmap.Map {
 	if eID == "global" {
+		if ctListSnapshotDir != "" {
+			// Do not contact the agent for the IPv6 status, whether the
+			// IPv6 maps were in use is encoded in the snapshot directory.
+			return ctmap.GlobalMaps(true, true)
+		}
 		return ctmap.GlobalMaps(true, getIpv6EnableStatus())
 	}
 	id, _ := strconv.Atoi(eID)
@@ -124,6 +136,25 @@ func dumpCt(maps []interface{}, args ...interface{}) {
 	eID := args[0]
 
 	for _, m := range maps {
+		if ctListSnapshotDir != "" {
+			if command.OutputOption() {
+				callback := func(key bpf.MapKey, value bpf.MapValue) {
+					record := ctmap.CtMapRecord{Key: key.(ctmap.CtKey), Value: *value.(*ctmap.CtEntry)}
+					entries = append(entries, record)
+				}
+				if err := m.(*ctmap.Map).DumpSnapshotWithCallbackIfExists(ctListSnapshotDir, callback); err != nil {
+					Fatalf("Error while collecting snapshot entries: %s", err)
+				}
+			} else {
+				out, err := ctmap.DumpSnapshotEntries(m.(*ctmap.Map), ctListSnapshotDir)
+				if err != nil {
+					Fatalf("Error while dumping snapshot entries: %s", err)
+				}
+				fmt.Println(out)
+			}
+			continue
+		}
+
 		path, err := m.(ctmap.CtMap).Path()
 		if err == nil {
 			err = m.(ctmap.CtMap).Open()
@@ -21,6 +21,8 @@ const (
 
 var (
 	ipCacheListUsage = "List endpoint IPs (local and remote) and their corresponding security identities.\n" + lpmKernelVersionWarning("cilium_ipcache")
+
+	ipCacheListSnapshotDir string
 )
 
 var bpfIPCacheListCmd = &cobra.Command{
@@ -29,10 +31,16 @@ var bpfIPCacheListCmd = &cobra.Command{
 	Short:   "List endpoint IPs (local and remote) and their corresponding security identities",
 	Long:    ipCacheListUsage,
 	Run: func(cmd *cobra.Command, args []string) {
-		common.RequireRootPrivilege("cilium bpf ipcache list")
+		var err error
 
 		bpfIPCacheList := make(map[string][]string)
-		if err := ipcache.IPCacheMap().Dump(bpfIPCacheList); err != nil {
+		if ipCacheListSnapshotDir != "" {
+			err = ipcache.IPCacheMap().DumpSnapshot(ipCacheListSnapshotDir, bpfIPCacheList)
+		} else {
+			common.RequireRootPrivilege("cilium bpf ipcache list")
+			err = ipcache.IPCacheMap().Dump(bpfIPCacheList)
+		}
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "error dumping contents of map: %s\n", err)
 			os.Exit(1)
 		}
@@ -54,6 +62,7 @@ var bpfIPCacheListCmd = &cobra.Command{
 }
 
 func init() {
+	bpfIPCacheListCmd.Flags().StringVar(&ipCacheListSnapshotDir, "snapshot", "", "Read entries from a snapshot directory written by \"cilium bpf snapshot\" instead of the live map")
 	bpfIPCacheCmd.AddCommand(bpfIPCacheListCmd)
 	command.AddOutputOption(bpfIPCacheListCmd)
 }
@@ -25,40 +25,59 @@ const (
 
 var (
 	listRevNAT, listFrontends, listBackends, listSrcRanges bool
+
+	lbListSnapshotDir string
 )
 
+// dumpLBMap dumps m into serviceList, either from the live map or from the
+// snapshot directory when --snapshot is given.
+func dumpLBMap(m *bpf.Map, serviceList map[string][]string) error {
+	if lbListSnapshotDir != "" {
+		return m.DumpSnapshotIfExists(lbListSnapshotDir, serviceList)
+	}
+	return m.DumpIfExists(serviceList)
+}
+
+// dumpLBMapWithCallback is the callback variant of dumpLBMap.
+func dumpLBMapWithCallback(m *bpf.Map, cb bpf.DumpCallback) error {
+	if lbListSnapshotDir != "" {
+		return m.DumpSnapshotWithCallbackIfExists(lbListSnapshotDir, cb)
+	}
+	return m.DumpWithCallbackIfExists(cb)
+}
+
 func dumpSrcRanges(serviceList map[string][]string) {
-	if err := lbmap.SourceRange4Map.DumpIfExists(serviceList); err != nil {
+	if err := dumpLBMap(lbmap.SourceRange4Map, serviceList); err != nil {
 		Fatalf("Unable to dump IPv4 source range table: %s", err)
 	}
-	if err := lbmap.SourceRange6Map.DumpIfExists(serviceList); err != nil {
+	if err := dumpLBMap(lbmap.SourceRange6Map, serviceList); err != nil {
 		Fatalf("Unable to dump IPv6 source range table: %s", err)
 	}
 }
 
 func dumpRevNat(serviceList map[string][]string) {
-	if err := lbmap.RevNat4Map.DumpIfExists(serviceList); err != nil {
+	if err := dumpLBMap(lbmap.RevNat4Map, serviceList); err != nil {
 		Fatalf("Unable to dump IPv4 reverse NAT table: %s", err)
 	}
-	if err := lbmap.RevNat6Map.DumpIfExists(serviceList); err != nil {
+	if err := dumpLBMap(lbmap.RevNat6Map, serviceList); err != nil {
 		Fatalf("Unable to dump IPv6 reverse NAT table: %s", err)
 	}
 }
 
 func dumpFrontends(serviceList map[string][]string) {
-	if err := lbmap.Service4MapV2.DumpIfExists(serviceList); err != nil {
+	if err := dumpLBMap(lbmap.Service4MapV2, serviceList); err != nil {
 		Fatalf("Unable to dump IPv4 frontend table: %s", err)
 	}
-	if err := lbmap.Service6MapV2.DumpIfExists(serviceList); err != nil {
+	if err := dumpLBMap(lbmap.Service6MapV2, serviceList); err != nil {
 		Fatalf("Unable to dump IPv6 frontend table: %s", err)
 	}
 }
 
 func dumpBackends(serviceList map[string][]string) {
-	if err := lbmap.Backend4MapV2.DumpIfExists(serviceList); err != nil {
+	if err := dumpLBMap(lbmap.Backend4MapV2, serviceList); err != nil {
 		Fatalf("Unable to dump IPv4 backend table: %s", err)
 	}
-	if err := lbmap.Backend6MapV2.DumpIfExists(serviceList); err != nil {
+	if err := dumpLBMap(lbmap.Backend6MapV2, serviceList); err != nil {
 		Fatalf("Unable to dump IPv6 backend table: %s", err)
 	}
 }
@@ -72,10 +91,10 @@ func dumpSVC(serviceList map[string][]string) {
 		id := key.(lbmap.BackendKey).GetID()
 		backendMap[id] = value.DeepCopyMapValue().(lbmap.BackendValue).ToHost()
 	}
-	if err := lbmap.Backend4MapV2.DumpWithCallbackIfExists(parseBackendEntry); err != nil {
+	if err := dumpLBMapWithCallback(lbmap.Backend4MapV2, parseBackendEntry); err != nil {
 		Fatalf("Unable to dump IPv4 backends table: %s", err)
 	}
-	if err := lbmap.Backend6MapV2.DumpWithCallbackIfExists(parseBackendEntry); err != nil {
+	if err := dumpLBMapWithCallback(lbmap.Backend6MapV2, parseBackendEntry); err != nil {
 		Fatalf("Unable to dump IPv6 backends table: %s", err)
 	}
 
@@ -111,10 +130,10 @@ func dumpSVC(serviceList map[string][]string) {
 		serviceList[svc] = append(serviceList[svc], entry)
 	}
 
-	if err := lbmap.Service4MapV2.DumpWithCallbackIfExists(parseSVCEntry); err != nil {
+	if err := dumpLBMapWithCallback(lbmap.Service4MapV2, parseSVCEntry); err != nil {
 		Fatalf("Unable to dump IPv4 services table: %s", err)
 	}
-	if err := lbmap.Service6MapV2.DumpWithCallbackIfExists(parseSVCEntry); err != nil {
+	if err := dumpLBMapWithCallback(lbmap.Service6MapV2, parseSVCEntry); err != nil {
 		Fatalf("Unable to dump IPv6 services table: %s", err)
 	}
 }
@@ -125,7 +144,9 @@ var bpfLBListCmd = &cobra.Command{
 	Aliases: []string{"ls"},
 	Short:   "List load-balancing configuration",
 	Run: func(cmd *cobra.Command, args []string) {
-		common.RequireRootPrivilege("cilium bpf lb list")
+		if lbListSnapshotDir == "" {
+			common.RequireRootPrivilege("cilium bpf lb list")
+		}
 
 		// Ensure that the BPF map objects have been initialized before trying
 		// to list them. Note, this is _not_ creating a new map, but rather
@@ -174,5 +195,6 @@ func init() {
 	bpfLBListCmd.Flags().BoolVarP(&listFrontends, "frontends", "", false, "List all service frontend entries")
 	bpfLBListCmd.Flags().BoolVarP(&listBackends, "backends", "", false, "List all service backend entries")
 	bpfLBListCmd.Flags().BoolVarP(&listSrcRanges, "source-ranges", "", false, "List all source range entries")
+	bpfLBListCmd.Flags().StringVar(&lbListSnapshotDir, "snapshot", "", "Read entries from a snapshot directory written by \"cilium bpf snapshot\" instead of the live maps")
 	command.AddOutputOption(bpfLBListCmd)
 }
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/common"
+	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/maps/ipcache"
+	"github.com/cilium/cilium/pkg/maps/lbmap"
+)
+
+// bpfSnapshotCmd represents the bpf_snapshot command
+var bpfSnapshotCmd = &cobra.Command{
+	Use:   "snapshot <directory>",
+	Short: "Write snapshots of the datapath BPF maps into a directory",
+	Long: "Serializes the contents of the connection tracking, ipcache and " +
+		"load-balancing BPF maps into per-map snapshot files. The snapshots " +
+		"can be inspected offline, e.g. on another machine, with the " +
+		"--snapshot option of the corresponding list commands.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			Usagef(cmd, "<directory> argument is required")
+		}
+		common.RequireRootPrivilege("cilium bpf snapshot")
+
+		dir := args[0]
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			Fatalf("Unable to create snapshot directory %s: %s", dir, err)
+		}
+
+		// Ensure that the BPF map objects have been initialized before
+		// trying to snapshot them, see the note in bpf_lb_list.go.
+		lbmap.Init(lbmap.InitParams{IPv4: true, IPv6: true})
+
+		maps := []*bpf.Map{
+			&ipcache.IPCacheMap().Map,
+			lbmap.Service4MapV2, lbmap.Service6MapV2,
+			lbmap.Backend4MapV2, lbmap.Backend6MapV2,
+			lbmap.RevNat4Map, lbmap.RevNat6Map,
+			lbmap.SourceRange4Map, lbmap.SourceRange6Map,
+		}
+		for _, m := range ctmap.GlobalMaps(true, true) {
+			maps = append(maps, &m.Map)
+		}
+
+		for _, m := range maps {
+			path, err := m.Path()
+			if err != nil {
+				Fatalf("Unable to determine path of map %s: %s", m.Name(), err)
+			}
+			if _, err := os.Stat(path); os.IsNotExist(err) {
+				// e.g. maps of a disabled protocol family
+				continue
+			}
+			if err := m.WriteSnapshotToDir(dir); err != nil {
+				Fatalf("Unable to snapshot map %s: %s", m.Name(), err)
+			}
+			fmt.Printf("Wrote snapshot of %s\n", m.Name())
+		}
+	},
+}
+
+func init() {
+	bpfCmd.AddCommand(bpfSnapshotCmd)
+}
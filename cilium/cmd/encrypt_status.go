@@ -8,7 +8,9 @@ import (
 	"os/exec"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/prometheus/procfs"
 	"github.com/spf13/cobra"
@@ -31,8 +33,17 @@ var (
 	voidType    void
 	countErrors int
 	regex       = regexp.MustCompile("oseq[[:blank:]](0[xX][[:xdigit:]]+)?")
+
+	perNodeStatus bool
 )
 
+// wireguardHandshakeTimeout is the time after which a WireGuard peer is
+// reported as stale. WireGuard attempts a new handshake at the latest every
+// REKEY_AFTER_TIME (120s) plus some jitter when there is traffic, so a
+// handshake older than this indicates that no traffic is currently being
+// encrypted to the peer.
+const wireguardHandshakeTimeout = 3 * time.Minute
+
 var encryptStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Display the current encryption state",
@@ -43,6 +54,7 @@ var encryptStatusCmd = &cobra.Command{
 }
 
 func init() {
+	encryptStatusCmd.Flags().BoolVar(&perNodeStatus, "per-node", false, "Display the encryption state for each remote node")
 	encryptCmd.AddCommand(encryptStatusCmd)
 	command.AddOutputOption(encryptStatusCmd)
 }
@@ -125,8 +137,15 @@ func getEncryptionMode() {
 	switch encryptionStatusResponse.Mode {
 	case models.EncryptionStatusModeIPsec:
 		dumpIPsecStatus()
+		if perNodeStatus {
+			dumpPerNodeIPsecStatus()
+		}
 	case models.EncryptionStatusModeWireguard:
-		dumpWireGuardStatus(encryptionStatusResponse)
+		if perNodeStatus {
+			dumpPerNodeWireGuardStatus(encryptionStatusResponse)
+		} else {
+			dumpWireGuardStatus(encryptionStatusResponse)
+		}
 	}
 }
 
@@ -151,3 +170,77 @@ func dumpWireGuardStatus(p *models.EncryptionStatus) {
 		fmt.Printf("\tNumber of peers: %d\n", wg.PeerCount)
 	}
 }
+
+// dumpPerNodeIPsecStatus aggregates the XFRM states by destination address
+// and reports per destination whether a security association is present,
+// along with the bytes it carried and the number of failures seen on it.
+func dumpPerNodeIPsecStatus() {
+	type saStatus struct {
+		states  int
+		bytes   uint64
+		packets uint64
+		failed  uint32
+	}
+	xfrmStates, err := netlink.XfrmStateList(netlink.FAMILY_ALL)
+	if err != nil {
+		Fatalf("Cannot get xfrm state: %s", err)
+	}
+	perDst := make(map[string]*saStatus)
+	dsts := make([]string, 0, len(perDst))
+	for _, s := range xfrmStates {
+		dst := s.Dst.String()
+		status, ok := perDst[dst]
+		if !ok {
+			status = &saStatus{}
+			perDst[dst] = status
+			dsts = append(dsts, dst)
+		}
+		status.states++
+		status.bytes += s.Statistics.Bytes
+		status.packets += s.Statistics.Packets
+		status.failed += s.Statistics.Failed
+	}
+	sort.Strings(dsts)
+	fmt.Printf("Security associations per destination:\n")
+	if len(dsts) == 0 {
+		fmt.Printf("\tNone\n")
+		return
+	}
+	for _, dst := range dsts {
+		status := perDst[dst]
+		fmt.Printf("\t%s: %d SA(s), %d bytes (%d packets), %d failure(s)\n",
+			dst, status.states, status.bytes, status.packets, status.failed)
+	}
+}
+
+// dumpPerNodeWireGuardStatus reports for each WireGuard peer whether traffic
+// is currently being encrypted to it, based on the age of the last handshake,
+// along with the bytes transferred over the tunnel.
+func dumpPerNodeWireGuardStatus(p *models.EncryptionStatus) {
+	if p.Msg != "" {
+		fmt.Printf("Last error: %s\n", p.Msg)
+	}
+	for _, wg := range p.Wireguard.Interfaces {
+		fmt.Printf("Interface: %s\n", wg.Name)
+		fmt.Printf("\tPublic key: %s\n", wg.PublicKey)
+		fmt.Printf("\tNumber of peers: %d\n", wg.PeerCount)
+		for _, peer := range wg.Peers {
+			lastHandshake := time.Time(peer.LastHandshakeTime)
+			status := "encrypting"
+			handshake := "never"
+			if lastHandshake.IsZero() {
+				status = "no handshake"
+			} else {
+				age := time.Since(lastHandshake).Round(time.Second)
+				handshake = fmt.Sprintf("%s ago", age)
+				if age > wireguardHandshakeTimeout {
+					status = "stale handshake"
+				}
+			}
+			fmt.Printf("\tPeer: %s [%s]\n", peer.Endpoint, status)
+			fmt.Printf("\t\tLast handshake: %s\n", handshake)
+			fmt.Printf("\t\tTransfer: %d bytes sent, %d bytes received\n",
+				peer.TransferTx, peer.TransferRx)
+		}
+	}
+}
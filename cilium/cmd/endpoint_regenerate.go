@@ -5,20 +5,45 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/labels"
+)
+
+var (
+	regenerateLabels     []string
+	regenerateRate       int
+	regeneratePolicyOnly bool
 )
 
 // endpointRegenerateCmd represents the endpoint_regenerate command
 var endpointRegenerateCmd = &cobra.Command{
-	Use:    "regenerate <endpoint-id>",
-	Short:  "Force regeneration of endpoint program",
-	PreRun: requireEndpointID,
+	Use:   "regenerate ( <endpoint-id> | -l <label> )",
+	Short: "Force regeneration of endpoint program",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if len(regenerateLabels) == 0 {
+			requireEndpointID(cmd, args)
+		} else if len(args) > 0 {
+			Usagef(cmd, "Cannot use both an endpoint id and label selection")
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		id := args[0]
 		cfg := &models.EndpointConfigurationSpec{}
+		if regeneratePolicyOnly {
+			cfg.RegenerationLevel = models.EndpointConfigurationSpecRegenerationLevelPolicyOnly
+		}
+
+		if len(regenerateLabels) > 0 {
+			regenerateMatchingEndpoints(cfg)
+			return
+		}
+
+		id := args[0]
 		if err := client.EndpointConfigPatch(id, cfg); err != nil {
 			Fatalf("Cannot regenerate endpoint %s: %s\n", id, err)
 		} else {
@@ -27,6 +52,72 @@ var endpointRegenerateCmd = &cobra.Command{
 	},
 }
 
+// regenerateMatchingEndpoints regenerates all local endpoints carrying the
+// labels selected with --labels, printing per-endpoint progress. The rate of
+// regenerations is bounded by --rate to avoid starving the agent.
+func regenerateMatchingEndpoints(cfg *models.EndpointConfigurationSpec) {
+	selection := labels.NewLabelsFromModel(regenerateLabels).GetModel()
+
+	eps, err := client.EndpointList()
+	if err != nil {
+		Fatalf("Cannot list endpoints: %s\n", err)
+	}
+
+	matched := make([]*models.Endpoint, 0, len(eps))
+	for _, ep := range eps {
+		if endpointHasLabels(ep, selection) {
+			matched = append(matched, ep)
+		}
+	}
+	if len(matched) == 0 {
+		fmt.Printf("No endpoints match the given labels\n")
+		return
+	}
+
+	var interval time.Duration
+	if regenerateRate > 0 {
+		interval = time.Second / time.Duration(regenerateRate)
+	}
+
+	failed := 0
+	for i, ep := range matched {
+		if i > 0 && interval > 0 {
+			time.Sleep(interval)
+		}
+		id := strconv.FormatInt(ep.ID, 10)
+		if err := client.EndpointConfigPatch(id, cfg); err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "[%d/%d] Cannot regenerate endpoint %s: %s\n", i+1, len(matched), id, err)
+			continue
+		}
+		fmt.Printf("[%d/%d] Endpoint %s successfully regenerated\n", i+1, len(matched), id)
+	}
+	if failed > 0 {
+		Fatalf("Failed to regenerate %d out of %d endpoints\n", failed, len(matched))
+	}
+}
+
+// endpointHasLabels returns true if the endpoint carries all given labels, in
+// the normalized form returned by labels.Labels.GetModel.
+func endpointHasLabels(ep *models.Endpoint, selection []string) bool {
+	if ep.Status == nil || ep.Status.Labels == nil {
+		return false
+	}
+	epLabels := map[string]struct{}{}
+	for _, lbl := range labels.NewLabelsFromModel(ep.Status.Labels.SecurityRelevant).GetModel() {
+		epLabels[lbl] = struct{}{}
+	}
+	for _, lbl := range selection {
+		if _, ok := epLabels[lbl]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func init() {
 	endpointCmd.AddCommand(endpointRegenerateCmd)
+	endpointRegenerateCmd.Flags().StringSliceVarP(&regenerateLabels, "labels", "l", []string{}, "Regenerate all endpoints carrying these labels instead of a single endpoint")
+	endpointRegenerateCmd.Flags().IntVar(&regenerateRate, "rate", 0, "Maximum number of regenerations triggered per second when selecting by labels (0 is unlimited)")
+	endpointRegenerateCmd.Flags().BoolVar(&regeneratePolicyOnly, "policy-only", false, "Only recompute policy and update policy maps instead of rebuilding the full datapath")
 }
@@ -6,6 +6,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -32,6 +33,29 @@ var fqdnNames = &cobra.Command{
 	},
 }
 
+var fqdnExplain = &cobra.Command{
+	Use:   "explain <name>",
+	Short: "Explain how a DNS name interacts with FQDN policy",
+	Long: `Reports which ToFQDNs selectors match the given DNS name, the policy rules
+containing them, the endpoints selected by those rules, the identities
+programmed for the selectors and the cached DNS lookups for the name.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		explainFQDNName(args[0])
+	},
+}
+
+var fqdnProxyRules = &cobra.Command{
+	Use:   "proxy-rules",
+	Short: "Dump compiled DNS rules and regex cache statistics of the DNS proxy",
+	Long: `Dumps the compiled regex rules of the DNS proxy per endpoint and destination
+port, together with hit/miss counters and cumulative compile time of the
+regex compilation cache, to debug why a query is rejected by the proxy.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		listFQDNProxyRules()
+	},
+}
+
 var fqdnCacheCmd = &cobra.Command{
 	Use:   "cache",
 	Short: "Manage fqdn proxy cache",
@@ -56,6 +80,30 @@ var fqdnListCacheCmd = &cobra.Command{
 	},
 }
 
+var fqdnZombiesCmd = &cobra.Command{
+	Use:   "zombies",
+	Short: "Manage fqdn zombie IP to name mappings pending garbage collection",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var fqdnListZombiesCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List fqdn zombie mappings and the reason they are retained",
+	Run: func(cmd *cobra.Command, args []string) {
+		listFQDNZombies()
+	},
+}
+
+var fqdnGcZombiesCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Trigger a garbage collection run over the fqdn zombie mappings",
+	Run: func(cmd *cobra.Command, args []string) {
+		gcFQDNZombies()
+	},
+}
+
 var fqdnCacheMatchPattern string
 var fqdnEndpointID string
 var fqdnSource string
@@ -64,16 +112,62 @@ func init() {
 	fqdnCacheCmd.AddCommand(fqdnListCacheCmd)
 	fqdnCacheCmd.AddCommand(fqdnCleanCacheCmd)
 	fqdnCmd.AddCommand(fqdnCacheCmd)
+	fqdnCmd.AddCommand(fqdnExplain)
 	fqdnCmd.AddCommand(fqdnNames)
+	fqdnCmd.AddCommand(fqdnProxyRules)
+	fqdnZombiesCmd.AddCommand(fqdnListZombiesCmd)
+	fqdnZombiesCmd.AddCommand(fqdnGcZombiesCmd)
+	fqdnCmd.AddCommand(fqdnZombiesCmd)
 	rootCmd.AddCommand(fqdnCmd)
 
 	fqdnCleanCacheCmd.Flags().BoolVarP(&force, "force", "f", false, "Skip confirmation")
 	fqdnCleanCacheCmd.Flags().StringVarP(&fqdnCacheMatchPattern, "matchpattern", "p", "", "Delete cache entries with FQDNs that match matchpattern")
+	fqdnCleanCacheCmd.Flags().StringVarP(&fqdnEndpointID, "endpoint", "e", "", "Delete cache entries of a specific endpoint id")
 
 	fqdnListCacheCmd.Flags().StringVarP(&fqdnCacheMatchPattern, "matchpattern", "p", "", "List cache entries with FQDN that match matchpattern")
 	fqdnListCacheCmd.Flags().StringVarP(&fqdnEndpointID, "endpoint", "e", "", "List cache entries for a specific endpoint id")
 	fqdnListCacheCmd.Flags().StringVarP(&fqdnSource, "source", "s", "", "List cache entries from a specific source (lookup, connection)")
 	command.AddOutputOption(fqdnListCacheCmd)
+	command.AddOutputOption(fqdnExplain)
+	command.AddOutputOption(fqdnProxyRules)
+	command.AddOutputOption(fqdnListZombiesCmd)
+}
+
+func listFQDNZombies() {
+	var zombies []*models.DNSZombieMapping = []*models.DNSZombieMapping{}
+
+	result, err := client.Policy.GetFqdnZombies(nil)
+	if err != nil {
+		Fatalf("Error: %s\n", err)
+	} else {
+		zombies = result.Payload
+	}
+
+	if command.OutputOption() {
+		if err := command.PrintOutput(zombies); err != nil {
+			Fatalf("Unable to provide %s output: %s", command.OutputOptionString(), err)
+		}
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "Endpoint\tIP\tAliveAt\tReason\tNames\t")
+	for _, zombie := range zombies {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t\n",
+			zombie.EndpointID,
+			zombie.IP,
+			zombie.AliveAt.String(),
+			zombie.Reason,
+			strings.Join(zombie.Names, ","))
+	}
+	w.Flush()
+}
+
+func gcFQDNZombies() {
+	if _, err := client.Policy.DeleteFqdnZombies(nil); err != nil {
+		Fatalf("Error: %s\n", err)
+	}
+	fmt.Println("FQDN zombie garbage collection triggered")
 }
 
 func cleanFQDNCache() {
@@ -91,6 +185,10 @@ func cleanFQDNCache() {
 		params.SetMatchpattern(&fqdnCacheMatchPattern)
 	}
 
+	if fqdnEndpointID != "" {
+		params.SetEndpointID(&fqdnEndpointID)
+	}
+
 	_, err := client.Policy.DeleteFqdnCache(params)
 	if err != nil {
 		Fatalf("Error: %s\n", err)
@@ -171,6 +269,93 @@ func listFQDNCache() {
 	w.Flush()
 }
 
+func explainFQDNName(name string) {
+	params := policy.NewGetFqdnExplainNameParams().WithName(name)
+	result, err := client.Policy.GetFqdnExplainName(params)
+	if err != nil {
+		switch err := err.(type) {
+		case *policy.GetFqdnExplainNameNotFound:
+			Fatalf("No selectors or DNS data match %s\n", name)
+		default:
+			Fatalf("Error: %s\n", err)
+		}
+	}
+	report := result.Payload
+
+	if command.OutputOption() {
+		if err := command.PrintOutput(report); err != nil {
+			Fatalf("Unable to provide %s output: %s", command.OutputOptionString(), err)
+		}
+		return
+	}
+
+	fmt.Printf("Name: %s\n", report.Name)
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "Selector\tRegex\tPolicies\tEndpoints\tIdentities\t")
+	for _, sel := range report.Selectors {
+		endpoints := make([]string, 0, len(sel.Endpoints))
+		for _, epID := range sel.Endpoints {
+			endpoints = append(endpoints, strconv.FormatInt(epID, 10))
+		}
+		identities := make([]string, 0, len(sel.Identities))
+		for _, id := range sel.Identities {
+			identities = append(identities, strconv.FormatInt(id, 10))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n",
+			sel.Selector,
+			sel.RegexString,
+			strings.Join(sel.Policies, ";"),
+			strings.Join(endpoints, ","),
+			strings.Join(identities, ","))
+	}
+	w.Flush()
+
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "Endpoint\tSource\tFQDN\tTTL\tExpirationTime\tIPs\t")
+	for _, lookup := range report.Lookups {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\t\n",
+			lookup.EndpointID,
+			lookup.Source,
+			lookup.Fqdn,
+			lookup.TTL,
+			lookup.ExpirationTime.String(),
+			strings.Join(lookup.Ips, ","))
+	}
+	w.Flush()
+}
+
+func listFQDNProxyRules() {
+	result, err := client.Policy.GetFqdnProxyRules(nil)
+	if err != nil {
+		Fatalf("Error: %s\n", err)
+	}
+	report := result.Payload
+
+	if command.OutputOption() {
+		if err := command.PrintOutput(report); err != nil {
+			Fatalf("Unable to provide %s output: %s", command.OutputOptionString(), err)
+		}
+		return
+	}
+
+	fmt.Printf("Regex cache: %d entries, %d hits, %d misses, %s compile time\n\n",
+		report.CacheEntries,
+		report.CacheHits,
+		report.CacheMisses,
+		report.CacheCompileTime)
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "Endpoint\tPort\tSelector\tRegex\t")
+	for _, rule := range report.Rules {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t\n",
+			rule.EndpointID,
+			rule.DestPort,
+			rule.Selector,
+			rule.RegexString)
+	}
+	w.Flush()
+}
+
 func listFQDNNames() {
 	result, err := client.Policy.GetFqdnNames(nil)
 	if err != nil {
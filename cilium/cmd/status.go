@@ -4,8 +4,11 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"sort"
 	"text/tabwriter"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 
 	"github.com/cilium/cilium/api/v1/client/daemon"
 	"github.com/cilium/cilium/api/v1/models"
+	operatorApi "github.com/cilium/cilium/operator/api"
 	pkg "github.com/cilium/cilium/pkg/client"
 	"github.com/cilium/cilium/pkg/command"
 	healthPkg "github.com/cilium/cilium/pkg/health/client"
@@ -24,16 +28,22 @@ var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Display status of daemon",
 	Run: func(cmd *cobra.Command, args []string) {
+		if statusOperator {
+			statusOperatorDaemon()
+			return
+		}
 		statusDaemon()
 	},
 }
 
 var (
-	statusDetails pkg.StatusDetails
-	allHealth     bool
-	brief         bool
-	timeout       time.Duration
-	healthLines   = 10
+	statusDetails   pkg.StatusDetails
+	allHealth       bool
+	brief           bool
+	timeout         time.Duration
+	healthLines     = 10
+	statusOperator  bool
+	operatorAddress string
 )
 
 func init() {
@@ -47,6 +57,8 @@ func init() {
 	statusCmd.Flags().BoolVar(&brief, "brief", false, "Only print a one-line status message")
 	statusCmd.Flags().BoolVar(&verbose, "verbose", false, "Equivalent to --all-addresses --all-controllers --all-nodes --all-redirects --all-clusters --all-health")
 	statusCmd.Flags().DurationVar(&timeout, "timeout", 30*time.Second, "Sets the timeout to use when querying for health")
+	statusCmd.Flags().BoolVar(&statusOperator, "operator", false, "Display the status of the cilium-operator instead of the agent")
+	statusCmd.Flags().StringVar(&operatorAddress, "operator-address", "localhost:9234", "Address of the cilium-operator API server")
 	command.AddOutputOption(statusCmd)
 }
 
@@ -111,3 +123,93 @@ func statusDaemon() {
 		w.Flush()
 	}
 }
+
+// statusOperatorDaemon queries the status endpoint of the cilium-operator
+// apiserver and prints the result. The endpoint is served outside of the
+// open-api spec, hence the plain HTTP client.
+func statusOperatorDaemon() {
+	httpClient := &http.Client{Timeout: timeout}
+	resp, err := httpClient.Get("http://" + operatorAddress + "/status")
+	if err != nil {
+		Fatalf("Cannot get operator status: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		Fatalf("Cannot get operator status: %s", resp.Status)
+	}
+
+	var sr operatorApi.StatusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&sr); err != nil {
+		Fatalf("Cannot decode operator status: %s", err)
+	}
+
+	if command.OutputOption() {
+		if err := command.PrintOutput(sr); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 2, 0, 3, ' ', 0)
+	fmt.Fprintf(w, "Leader:\t%t\n", sr.Leader)
+	formatOperatorControllers(w, sr.Controllers)
+	for _, m := range sr.Modules {
+		fmt.Fprintf(w, "%s:\twork queue depth %d", m.Name, m.WorkQueueDepth)
+		if m.Msg != "" {
+			fmt.Fprintf(w, ", %s", m.Msg)
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	w.Flush()
+}
+
+// formatOperatorControllers prints the controller statuses the same way
+// FormatStatusResponse does for the agent: a summary line, followed by the
+// failing controllers, or all of them with --all-controllers.
+func formatOperatorControllers(w *tabwriter.Writer, controllers models.ControllerStatuses) {
+	nFailing, out := 0, []string{"  Name\tLast success\tLast error\tCount\tMessage\n"}
+	for _, ctrl := range controllers {
+		status := ctrl.Status
+		if status == nil {
+			continue
+		}
+
+		if status.ConsecutiveFailureCount > 0 {
+			nFailing++
+		} else if !statusDetails.AllControllers {
+			continue
+		}
+
+		failSince := timeSince(time.Time(status.LastFailureTimestamp))
+		successSince := timeSince(time.Time(status.LastSuccessTimestamp))
+
+		err := "no error"
+		if status.LastFailureMsg != "" {
+			err = status.LastFailureMsg
+		}
+
+		out = append(out, fmt.Sprintf("  %s\t%s\t%s\t%d\t%s\t\n",
+			ctrl.Name, successSince, failSince, status.ConsecutiveFailureCount, err))
+	}
+
+	nOK := len(controllers) - nFailing
+	fmt.Fprintf(w, "Controller Status:\t%d/%d healthy\n", nOK, len(controllers))
+	if len(out) > 1 {
+		tab := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		sort.Strings(out)
+		for _, s := range out {
+			fmt.Fprint(tab, s)
+		}
+		tab.Flush()
+	}
+}
+
+func timeSince(since time.Time) string {
+	out := "never"
+	if !since.IsZero() {
+		t := time.Since(since)
+		out = t.Truncate(time.Second).String() + " ago"
+	}
+
+	return out
+}
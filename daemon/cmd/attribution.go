@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/cilium/cilium/api/v1/models"
+	. "github.com/cilium/cilium/api/v1/server/restapi/endpoint"
+	"github.com/cilium/cilium/pkg/api"
+	identitymodel "github.com/cilium/cilium/pkg/identity/model"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/lbmap"
+)
+
+type getAttribution struct {
+	d *Daemon
+}
+
+// NewGetAttributionHandler returns a new instance of the attribution handler.
+func NewGetAttributionHandler(d *Daemon) GetAttributionHandler {
+	return &getAttribution{d: d}
+}
+
+func (h *getAttribution) Handle(params GetAttributionParams) middleware.Responder {
+	log.WithField(logfields.Params, logfields.Repr(params)).Debug("GET /attribution request")
+
+	sourceIP := net.ParseIP(params.SourceIP)
+	if sourceIP == nil {
+		return api.Error(GetAttributionBadRequestCode, fmt.Errorf("invalid source IP %q", params.SourceIP))
+	}
+
+	ep := h.d.endpointManager.LookupIP(sourceIP)
+	if ep == nil {
+		return NewGetAttributionNotFound()
+	}
+
+	attribution := &models.Attribution{
+		EndpointID:     int64(ep.GetID()),
+		Namespace:      ep.GetK8sNamespace(),
+		PodName:        ep.GetK8sPodName(),
+		SourceIdentity: h.identityModel(params.HTTPRequest.Context(), params.SourceIP),
+	}
+
+	if params.DestinationIP != nil {
+		destIP := net.ParseIP(*params.DestinationIP)
+		if destIP == nil {
+			return api.Error(GetAttributionBadRequestCode, fmt.Errorf("invalid destination IP %q", *params.DestinationIP))
+		}
+		attribution.DestinationIdentity = h.identityModel(params.HTTPRequest.Context(), *params.DestinationIP)
+
+		if params.Cookie != nil && params.DestinationPort != nil {
+			if *params.DestinationPort < 0 || *params.DestinationPort > 65535 {
+				return api.Error(GetAttributionBadRequestCode, fmt.Errorf("invalid destination port %d", *params.DestinationPort))
+			}
+			attribution.ServiceFrontend = lookupServiceFrontend(uint64(*params.Cookie), destIP, uint16(*params.DestinationPort))
+		}
+	}
+
+	return NewGetAttributionOK().WithPayload(attribution)
+}
+
+// identityModel resolves the security identity of the given IP from the
+// ipcache, including the identity labels when they are known to the agent.
+func (h *getAttribution) identityModel(ctx context.Context, ip string) *models.Identity {
+	id, exists := h.d.ipcache.LookupByIP(ip)
+	if !exists {
+		return nil
+	}
+
+	if secID := h.d.identityAllocator.LookupIdentityByID(ctx, id.ID); secID != nil {
+		return identitymodel.CreateModel(secID)
+	}
+
+	return &models.Identity{ID: int64(id.ID)}
+}
+
+// lookupServiceFrontend resolves the original service frontend the socket
+// with the given cookie connected to, by looking the translated backend
+// address up in the socket-based load-balancing reverse NAT map. An empty
+// string is returned when the destination was not translated.
+func lookupServiceFrontend(cookie uint64, destIP net.IP, destPort uint16) string {
+	var (
+		frontendIP   net.IP
+		frontendPort uint16
+		err          error
+	)
+	if destIP.To4() != nil {
+		frontendIP, frontendPort, err = lbmap.GetSockRevNat4(cookie, destIP, destPort)
+	} else {
+		frontendIP, frontendPort, err = lbmap.GetSockRevNat6(cookie, destIP, destPort)
+	}
+	if err != nil {
+		return ""
+	}
+
+	return net.JoinHostPort(frontendIP.String(), strconv.Itoa(int(frontendPort)))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cilium/cilium/pkg/api"
 	"github.com/cilium/cilium/pkg/eventqueue"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	monitoragent "github.com/cilium/cilium/pkg/monitor/agent"
 	"github.com/cilium/cilium/pkg/node"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
@@ -35,6 +36,23 @@ func (h *patchConfig) configModify(params PatchConfigParams, resChan chan interf
 
 	cfgSpec := params.Configuration
 
+	// monitor-drop-sampling is not part of the endpoint option library,
+	// handle it before validation so that the sampling limits can be
+	// adjusted at runtime, e.g. while investigating a drop storm.
+	if spec, ok := cfgSpec.Options[option.MonitorDropSampling]; ok {
+		limits, err := monitoragent.ParseDropSamplingSpec(spec)
+		if err != nil {
+			msg := fmt.Errorf("Invalid %s: %s", option.MonitorDropSampling, err)
+			resChan <- api.Error(PatchConfigBadRequestCode, msg)
+			return
+		}
+		delete(cfgSpec.Options, option.MonitorDropSampling)
+		option.Config.ConfigPatchMutex.Lock()
+		option.Config.MonitorDropSampling = spec
+		option.Config.ConfigPatchMutex.Unlock()
+		d.monitorAgent.SetDropSampling(limits)
+	}
+
 	om, err := option.Config.Opts.Library.ValidateConfigurationMap(cfgSpec.Options)
 	if err != nil {
 		msg := fmt.Errorf("Invalid configuration option %s", err)
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// configDriftControllerName is the name of the controller periodically
+	// comparing the rendered datapath configuration against the
+	// configuration the loaded datapath was built from.
+	configDriftControllerName = "datapath-config-drift-check"
+
+	// configDriftCheckInterval is the interval in which the datapath
+	// configuration is checked for drift.
+	configDriftCheckInterval = 5 * time.Minute
+)
+
+// renderNodeConfig renders the node configuration header the datapath would
+// be compiled with if it were reinitialized now. The rendered configuration
+// covers both the node_config.h macros and the datapath map parameters
+// derived from the agent configuration.
+func (d *Daemon) renderNodeConfig() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := d.datapath.WriteNodeConfig(&buf, &d.nodeDiscovery.LocalConfig); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// setDatapathConfigDrift records whether the loaded datapath has drifted from
+// the agent configuration, for reporting via the status API.
+func (d *Daemon) setDatapathConfigDrift(drifted bool) {
+	d.datapathDriftMutex.Lock()
+	d.datapathDrift = drifted
+	d.datapathDriftMutex.Unlock()
+}
+
+// datapathConfigDrifted returns whether the loaded datapath was built from a
+// configuration which no longer matches the agent configuration.
+func (d *Daemon) datapathConfigDrifted() bool {
+	d.datapathDriftMutex.RLock()
+	defer d.datapathDriftMutex.RUnlock()
+	return d.datapathDrift
+}
+
+// checkDatapathConfigDrift compares the node configuration header the loaded
+// datapath was built from against a fresh rendering of the agent
+// configuration. A mismatch means a partial reload left stale programs
+// behind, e.g. because a previous reinitialization failed half-way through.
+func (d *Daemon) checkDatapathConfigDrift(ctx context.Context) error {
+	rendered, err := d.renderNodeConfig()
+	if err != nil {
+		return fmt.Errorf("unable to render node configuration: %w", err)
+	}
+
+	nodeConfigPath := option.Config.GetNodeConfigPath()
+	loaded, err := os.ReadFile(nodeConfigPath)
+	if err != nil {
+		return fmt.Errorf("unable to read node configuration %s: %w", nodeConfigPath, err)
+	}
+
+	if bytes.Equal(rendered, loaded) {
+		d.setDatapathConfigDrift(false)
+		return nil
+	}
+
+	d.setDatapathConfigDrift(true)
+
+	if option.Config.DatapathConfigDriftReconcile {
+		log.Warning("Datapath configuration drift detected, reinitializing datapath")
+		return d.reconcileDatapathConfig(ctx, rendered)
+	}
+
+	return fmt.Errorf("datapath configuration drifted from %s, datapath reload required", nodeConfigPath)
+}
+
+// reconcileDatapathConfig rewrites the node configuration header and
+// reinitializes the base datapath programs, bringing the loaded datapath back
+// in line with the agent configuration.
+func (d *Daemon) reconcileDatapathConfig(ctx context.Context, rendered []byte) error {
+	nodeConfigPath := option.Config.GetNodeConfigPath()
+	if err := os.WriteFile(nodeConfigPath, rendered, 0644); err != nil {
+		return fmt.Errorf("unable to write node configuration %s: %w", nodeConfigPath, err)
+	}
+
+	if err := d.Datapath().Loader().Reinitialize(d.ctx, d, d.mtuConfig.GetDeviceMTU(), d.Datapath(), d.l7Proxy); err != nil {
+		return fmt.Errorf("unable to recompile base programs: %w", err)
+	}
+
+	d.TriggerDatapathRegen(true, "datapath configuration drift reconciliation")
+	d.setDatapathConfigDrift(false)
+
+	return nil
+}
+
+// startConfigDriftController starts the controller periodically checking the
+// loaded datapath for configuration drift.
+func (d *Daemon) startConfigDriftController() {
+	controller.NewManager().UpdateController(configDriftControllerName,
+		controller.ControllerParams{
+			DoFunc:      d.checkDatapathConfigDrift,
+			RunInterval: configDriftCheckInterval,
+			Context:     d.ctx,
+		})
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/cilium/cilium/api/v1/models"
+	restapi "github.com/cilium/cilium/api/v1/server/restapi/daemon"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+)
+
+type getControllers struct{}
+
+// NewGetControllersHandler returns the controller status list endpoint handler
+func NewGetControllersHandler(d *Daemon) restapi.GetControllersHandler {
+	return &getControllers{}
+}
+
+func (h *getControllers) Handle(params restapi.GetControllersParams) middleware.Responder {
+	log.WithField(logfields.Params, logfields.Repr(params)).Debug("GET /controllers request")
+
+	statuses := controller.GetGlobalStatus()
+	if params.Name != nil {
+		filtered := make(models.ControllerStatuses, 0, len(statuses))
+		for _, status := range statuses {
+			if strings.Contains(status.Name, *params.Name) {
+				filtered = append(filtered, status)
+			}
+		}
+		statuses = filtered
+	}
+
+	return restapi.NewGetControllersOK().WithPayload(statuses)
+}
+
+type postControllersNameTrigger struct{}
+
+// NewPostControllersNameTriggerHandler returns the manual controller trigger
+// endpoint handler
+func NewPostControllersNameTriggerHandler(d *Daemon) restapi.PostControllersNameTriggerHandler {
+	return &postControllersNameTrigger{}
+}
+
+func (h *postControllersNameTrigger) Handle(params restapi.PostControllersNameTriggerParams) middleware.Responder {
+	log.WithField(logfields.Controller, params.Name).Debug("POST /controllers/{name}/trigger request")
+
+	if !controller.TriggerGlobalController(params.Name) {
+		return restapi.NewPostControllersNameTriggerNotFound()
+	}
+
+	return restapi.NewPostControllersNameTriggerOK()
+}
@@ -73,6 +73,7 @@ import (
 	monitoragent "github.com/cilium/cilium/pkg/monitor/agent"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	"github.com/cilium/cilium/pkg/mtu"
+	"github.com/cilium/cilium/pkg/ndpproxy"
 	"github.com/cilium/cilium/pkg/node"
 	nodemanager "github.com/cilium/cilium/pkg/node/manager"
 	nodeStore "github.com/cilium/cilium/pkg/node/store"
@@ -83,6 +84,7 @@ import (
 	policyAPI "github.com/cilium/cilium/pkg/policy/api"
 	"github.com/cilium/cilium/pkg/probe"
 	"github.com/cilium/cilium/pkg/proxy"
+	"github.com/cilium/cilium/pkg/qos"
 	"github.com/cilium/cilium/pkg/rate"
 	"github.com/cilium/cilium/pkg/recorder"
 	"github.com/cilium/cilium/pkg/redirectpolicy"
@@ -93,6 +95,7 @@ import (
 	"github.com/cilium/cilium/pkg/status"
 	"github.com/cilium/cilium/pkg/trigger"
 	wg "github.com/cilium/cilium/pkg/wireguard/agent"
+	"github.com/cilium/cilium/pkg/zones"
 	cnitypes "github.com/cilium/cilium/plugins/cilium-cni/types"
 )
 
@@ -124,15 +127,38 @@ type Daemon struct {
 	statusResponse     models.StatusResponse
 	statusCollector    *status.Collector
 
+	// datapathDrift is true when the loaded datapath was built from a
+	// configuration which no longer matches the agent configuration.
+	datapathDriftMutex lock.RWMutex
+	datapathDrift      bool
+
 	monitorAgent *monitoragent.Agent
 	ciliumHealth *health.CiliumHealth
 
 	deviceManager *linuxdatapath.DeviceManager
 
+	// ndpProxy answers neighbor solicitations for local pod IPv6 addresses
+	// on the configured uplink devices. Only set when ndp-proxy-devices is
+	// configured.
+	ndpProxy *ndpproxy.NDPProxy
+
 	// dnsNameManager tracks which api.FQDNSelector are present in policy which
 	// apply to locally running endpoints.
 	dnsNameManager *fqdn.NameManager
 
+	// dnsPolicyUpdateQueue queues DNS responses for asynchronous expansion
+	// into ToFQDNs policy. Only set when
+	// tofqdns-proxy-async-policy-updates is enabled.
+	dnsPolicyUpdateQueue chan *dnsPolicyUpdate
+
+	// dnsResponseLocks serializes the handling of DNS responses, sharded by
+	// response IP.
+	dnsResponseLocks *dnsResponseLocks
+
+	// fqdnGlobalCache is the cluster-wide FQDN cache shared via the
+	// kvstore. Only set when tofqdns-enable-global-cache is enabled.
+	fqdnGlobalCache fqdnGlobalCache
+
 	// Used to synchronize generation of daemon's BPF programs and endpoint BPF
 	// programs.
 	compilationMutex *lock.RWMutex
@@ -188,6 +214,13 @@ type Daemon struct {
 
 	egressGatewayManager *egressgateway.Manager
 
+	// zoneManager keeps operator-defined zone identities in sync with the
+	// ipcache
+	zoneManager *zones.Manager
+
+	// qosManager programs DSCP marking policies into the datapath
+	qosManager *qos.Manager
+
 	cgroupManager *manager.CgroupManager
 
 	apiLimiterSet *rate.APILimiterSet
@@ -270,6 +303,14 @@ func (d *Daemon) init() error {
 		if err := d.Datapath().Loader().Reinitialize(d.ctx, d, d.mtuConfig.GetDeviceMTU(), d.Datapath(), d.l7Proxy); err != nil {
 			return fmt.Errorf("failed while reinitializing datapath: %w", err)
 		}
+
+		if option.Config.DatapathConfigDriftCheck {
+			d.startConfigDriftController()
+		}
+
+		if option.Config.SysctlReconcile {
+			d.startSysctlReconciler()
+		}
 	}
 
 	return nil
@@ -529,8 +570,19 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 
 	if option.Config.RunMonitorAgent {
 		d.monitorAgent = monitoragent.NewAgent(ctx)
+		if option.Config.MonitorDropSampling != "" {
+			limits, err := monitoragent.ParseDropSamplingSpec(option.Config.MonitorDropSampling)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid %s: %w", option.MonitorDropSampling, err)
+			}
+			d.monitorAgent.SetDropSampling(limits)
+		}
 	}
 
+	// Watch for controllers getting stuck so they can be diagnosed instead
+	// of silently degrading.
+	controller.StartWatchdog(ctx)
+
 	d.configModifyQueue = eventqueue.NewEventQueueBuffered("config-modify-queue", ConfigModifyQueueSize)
 	d.configModifyQueue.Run()
 
@@ -618,6 +670,33 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 			}
 		}
 	}
+	d.zoneManager = zones.NewManager(d.ipcache)
+	for _, spec := range option.Config.CIDRZones {
+		zone, err := zones.ParseZoneSpec(spec)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s: %w", option.CIDRZone, err)
+		}
+		if err := d.zoneManager.UpsertZone(zone); err != nil {
+			return nil, nil, fmt.Errorf("unable to apply %s: %w", option.CIDRZone, err)
+		}
+	}
+	for _, spec := range option.Config.CIDRZoneFeeds {
+		feed, err := zones.ParseFeedSpec(spec)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s: %w", option.CIDRZoneFeed, err)
+		}
+		d.zoneManager.RunFeedController(feed)
+	}
+	// CIDR groups must be defined before any policy referencing them is
+	// imported, otherwise the import fails with an unknown group error.
+	for _, spec := range option.Config.CIDRGroups {
+		name, cidrs, err := policyAPI.ParseCIDRGroupSpec(spec)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s: %w", option.CIDRGroup, err)
+		}
+		policyAPI.SetCIDRGroup(name, cidrs)
+	}
+
 	nodeMngr = nodeMngr.WithIPCache(d.ipcache)
 	nodeMngr = nodeMngr.WithSelectorCacheUpdater(d.policy.GetSelectorCache()) // must be after initPolicy
 	nodeMngr = nodeMngr.WithPolicyTriggerer(epMgr)                            // must be after initPolicy
@@ -662,7 +741,7 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 	d.cgroupManager = manager.NewCgroupManager()
 
 	if option.Config.EnableIPv4EgressGateway {
-		d.egressGatewayManager = egressgateway.NewEgressGatewayManager(&d, d.identityAllocator)
+		d.egressGatewayManager = egressgateway.NewEgressGatewayManager(&d, d.identityAllocator, &d)
 	}
 
 	d.k8sWatcher = watchers.NewK8sWatcher(
@@ -739,6 +818,20 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 	if len(restoredCIDRidentities) > 0 {
 		d.ipcache.UpsertGeneratedIdentities(restoredCIDRidentities, nil)
 	}
+	// Program QoS DSCP policies after the QoS policy map has been created
+	// by initMaps() above.
+	if len(option.Config.QoSDSCPPolicies) > 0 {
+		d.qosManager = qos.NewManager()
+		for _, spec := range option.Config.QoSDSCPPolicies {
+			p, err := qos.ParsePolicySpec(spec)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid %s: %w", option.QoSDSCPPolicy, err)
+			}
+			if err := d.qosManager.AddPolicy(p); err != nil {
+				return nil, nil, fmt.Errorf("unable to apply %s: %w", option.QoSDSCPPolicy, err)
+			}
+		}
+	}
 	// Upsert restored local Ingress IPs
 	restoredIngressIPs := []string{}
 	for _, ingressIP := range oldIngressIPs {
@@ -771,6 +864,7 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 	}
 
 	debug.RegisterStatusObject("k8s-service-cache", &d.k8sWatcher.K8sSvcCache)
+	debug.RegisterStatusObject("k8s-service-propagation", d.k8sWatcher.ServicePropagation)
 	debug.RegisterStatusObject("ipam", d.ipam)
 	debug.RegisterStatusObject("ongoing-endpoint-creations", d.endpointCreations)
 
@@ -47,6 +47,7 @@ import (
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/envoy"
 	"github.com/cilium/cilium/pkg/flowdebug"
+	"github.com/cilium/cilium/pkg/fqdn"
 	"github.com/cilium/cilium/pkg/hive"
 	"github.com/cilium/cilium/pkg/hive/cell"
 	"github.com/cilium/cilium/pkg/hubble/exporter/exporteroption"
@@ -54,9 +55,11 @@ import (
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/ip"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
+	"github.com/cilium/cilium/pkg/ipfix"
 	"github.com/cilium/cilium/pkg/ipmasq"
 	"github.com/cilium/cilium/pkg/k8s"
 	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
+	k8sEvents "github.com/cilium/cilium/pkg/k8s/events"
 	"github.com/cilium/cilium/pkg/k8s/watchers/resources"
 	"github.com/cilium/cilium/pkg/kvstore"
 	"github.com/cilium/cilium/pkg/labels"
@@ -73,6 +76,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/policymap"
 	"github.com/cilium/cilium/pkg/metrics"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+	"github.com/cilium/cilium/pkg/ndpproxy"
 	"github.com/cilium/cilium/pkg/node"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
@@ -180,6 +184,18 @@ func initializeFlags() {
 	flags.String(option.BPFRoot, "", "Path to BPF filesystem")
 	option.BindEnv(Vp, option.BPFRoot)
 
+	flags.Duration(option.BPFOrphanGCInterval, 0, "Interval between periodic sweeps for orphaned BPF maps and interfaces left by previous agent runs, 0 only sweeps on startup")
+	option.BindEnv(Vp, option.BPFOrphanGCInterval)
+
+	flags.Bool(option.BPFOrphanGCDryRun, false, "Report orphaned BPF resources without removing them")
+	option.BindEnv(Vp, option.BPFOrphanGCDryRun)
+
+	flags.Duration(option.LBMapVerifyInterval, 0, "Interval between periodic cross-checks of the BPF LB maps against the desired service state, 0 to disable")
+	option.BindEnv(Vp, option.LBMapVerifyInterval)
+
+	flags.Bool(option.LBMapVerifyRepair, false, "Repair the inconsistencies found by the LB map verifier instead of only reporting them")
+	option.BindEnv(Vp, option.LBMapVerifyRepair)
+
 	flags.Bool(option.EnableBPFClockProbe, false, "Enable BPF clock source probing for more efficient tick retrieval")
 	option.BindEnv(Vp, option.EnableBPFClockProbe)
 
@@ -241,6 +257,9 @@ func initializeFlags() {
 	flags.Bool(option.EnableHealthCheckNodePort, defaults.EnableHealthCheckNodePort, "Enables a healthcheck nodePort server for NodePort services with 'healthCheckNodePort' being set")
 	option.BindEnv(Vp, option.EnableHealthCheckNodePort)
 
+	flags.Bool(option.EnableHealthCheckProxyProtocol, false, "Enables PROXY protocol support on the healthcheck nodePort server")
+	option.BindEnv(Vp, option.EnableHealthCheckProxyProtocol)
+
 	flags.StringSlice(option.EndpointStatus, []string{},
 		"Enable additional CiliumEndpoint status features ("+strings.Join(option.EndpointStatusValues(), ",")+")")
 	option.BindEnv(Vp, option.EndpointStatus)
@@ -277,6 +296,9 @@ func initializeFlags() {
 	flags.String(option.IPv6MCastDevice, "", "Device that joins a Solicited-Node multicast group for IPv6")
 	option.BindEnv(Vp, option.IPv6MCastDevice)
 
+	flags.StringSlice(option.NDPProxyDevices, []string{}, "List of devices on which neighbor solicitations for local pod IPv6 addresses are answered (e.g. the uplink on L2-adjacent IPv6 fabrics)")
+	option.BindEnv(Vp, option.NDPProxyDevices)
+
 	flags.Bool(option.EnableRemoteNodeIdentity, defaults.EnableRemoteNodeIdentity, "Enable use of remote node identity")
 	option.BindEnv(Vp, option.EnableRemoteNodeIdentity)
 
@@ -299,6 +321,9 @@ func initializeFlags() {
 	flags.Bool(option.DisableCiliumEndpointCRDName, false, "Disable use of CiliumEndpoint CRD")
 	option.BindEnv(Vp, option.DisableCiliumEndpointCRDName)
 
+	flags.Bool(option.EnablePodNetworkReadinessGate, false, "Enable setting the cilium.io/network-ready pod readiness gate condition once the endpoint's network programming is in place on the local node; propagation of the endpoint's identity to peer nodes is not awaited")
+	option.BindEnv(Vp, option.EnablePodNetworkReadinessGate)
+
 	flags.String(option.EgressMasqueradeInterfaces, "", "Limit egress masquerading to interface selector")
 	option.BindEnv(Vp, option.EgressMasqueradeInterfaces)
 
@@ -335,6 +360,9 @@ func initializeFlags() {
 	flags.String(option.EnablePolicy, option.DefaultEnforcement, "Enable policy enforcement")
 	option.BindEnv(Vp, option.EnablePolicy)
 
+	flags.Bool(option.EnablePolicyConntrackFlush, false, "Flush the conntrack entries of established connections that a policy change no longer allows")
+	option.BindEnv(Vp, option.EnablePolicyConntrackFlush)
+
 	flags.Bool(option.EnableExternalIPs, defaults.EnableExternalIPs, fmt.Sprintf("Enable k8s service externalIPs feature (requires enabling %s)", option.EnableNodePort))
 	option.BindEnv(Vp, option.EnableExternalIPs)
 
@@ -365,6 +393,9 @@ func initializeFlags() {
 	flags.Bool(option.EnableWireguard, false, "Enable wireguard")
 	option.BindEnv(Vp, option.EnableWireguard)
 
+	flags.StringSlice(option.EncryptionBypassIdentities, []string{}, "List of numeric security identities whose traffic bypasses node-to-node encryption")
+	option.BindEnv(Vp, option.EncryptionBypassIdentities)
+
 	flags.Bool(option.EnableWireguardUserspaceFallback, false, "Enables the fallback to the wireguard userspace implementation")
 	option.BindEnv(Vp, option.EnableWireguardUserspaceFallback)
 
@@ -413,6 +444,42 @@ func initializeFlags() {
 	// Disable version check if Envoy build is disabled
 	option.BindEnvWithLegacyEnvFallback(Vp, option.DisableEnvoyVersionCheck, "CILIUM_DISABLE_ENVOY_BUILD")
 
+	flags.StringSlice(option.CIDRZone, []string{},
+		"Zone identity specification mapping external CIDRs to a stable user-reserved identity, e.g. corp=130:10.0.0.0/8;172.16.0.0/12. Zones are configured per agent via this flag only, changing the set of zones requires an agent restart")
+	option.BindEnv(Vp, option.CIDRZone)
+
+	flags.StringSlice(option.CIDRZoneFeed, []string{},
+		"IP range feed specification refreshing a zone's CIDRs periodically, e.g. corp=https://example.com/ranges.txt@1h")
+	option.BindEnv(Vp, option.CIDRZoneFeed)
+
+	flags.StringSlice(option.CIDRGroup, []string{},
+		"Named CIDR group which policy rules may reference via cidrGroupRef, e.g. corp=10.0.0.0/8;172.16.0.0/12")
+	option.BindEnv(Vp, option.CIDRGroup)
+
+	flags.StringSlice(option.QoSDSCPPolicy, []string{},
+		"DSCP marking policy for egress traffic of an identity, e.g. 1000:53/udp=46")
+	option.BindEnv(Vp, option.QoSDSCPPolicy)
+
+	flags.Bool(option.EnableMultiNetwork, false,
+		"Label endpoint identities with their secondary network attachments so policies can be scoped by network")
+	option.BindEnv(Vp, option.EnableMultiNetwork)
+
+	flags.Duration(option.DropVerdictCacheTTL, 0,
+		"Time for which the datapath may cache and short-circuit repeated identical drop verdicts (0 disables the cache)")
+	option.BindEnv(Vp, option.DropVerdictCacheTTL)
+
+	flags.Bool(option.DatapathConfigDriftCheck, false,
+		"Periodically check the loaded datapath for configuration drift and report it in the agent status")
+	option.BindEnv(Vp, option.DatapathConfigDriftCheck)
+
+	flags.Bool(option.DatapathConfigDriftReconcile, false,
+		"Reinitialize the datapath when configuration drift is detected instead of only reporting it")
+	option.BindEnv(Vp, option.DatapathConfigDriftReconcile)
+
+	flags.Bool(option.SysctlReconcile, true,
+		"Periodically re-apply the sysctl settings required by Cilium when they are reverted externally")
+	option.BindEnv(Vp, option.SysctlReconcile)
+
 	flags.Var(option.NewNamedMapOptions(option.FixedIdentityMapping, &option.Config.FixedIdentityMapping, option.Config.FixedIdentityMappingValidator),
 		option.FixedIdentityMapping, "Key-value for the fixed identity mapping which allows to use reserved label for fixed identities, e.g. 128=kv-store,129=kube-dns")
 	option.BindEnv(Vp, option.FixedIdentityMapping)
@@ -429,6 +496,9 @@ func initializeFlags() {
 	flags.String(option.IPAM, ipamOption.IPAMClusterPool, "Backend to use for IPAM")
 	option.BindEnv(Vp, option.IPAM)
 
+	flags.String(option.IPAMDelegateEndpoint, "", fmt.Sprintf("gRPC endpoint of the external IPAM delegate for --%s=%s", option.IPAM, ipamOption.IPAMDelegatedGRPC))
+	option.BindEnv(Vp, option.IPAMDelegateEndpoint)
+
 	flags.String(option.CNIChainingMode, "", "Enable CNI chaining with the specified plugin")
 	option.BindEnv(Vp, option.CNIChainingMode)
 
@@ -491,6 +561,13 @@ func initializeFlags() {
 	flags.Duration(option.KVstoreConnectivityTimeout, defaults.KVstoreConnectivityTimeout, "Time after which an incomplete kvstore operation  is considered failed")
 	option.BindEnv(Vp, option.KVstoreConnectivityTimeout)
 
+	flags.Duration(option.KVstoreStartupTimeout, 0, "Time to wait for the initial kvstore connection on startup (0 waits indefinitely)")
+	option.BindEnv(Vp, option.KVstoreStartupTimeout)
+
+	flags.String(option.KVstoreStartupAction, option.StartupActionFail,
+		fmt.Sprintf("Behavior when the kvstore is not reachable within %s on startup. Must be one of %v", option.KVstoreStartupTimeout, option.StartupActionOptions))
+	option.BindEnv(Vp, option.KVstoreStartupAction)
+
 	flags.Duration(option.IPAllocationTimeout, defaults.IPAllocationTimeout, "Time after which an incomplete CIDR allocation is considered failed")
 	option.BindEnv(Vp, option.IPAllocationTimeout)
 
@@ -502,6 +579,10 @@ func initializeFlags() {
 	flags.MarkHidden(option.K8sSyncTimeoutName)
 	option.BindEnv(Vp, option.K8sSyncTimeoutName)
 
+	flags.String(option.K8sSyncTimeoutActionName, option.StartupActionFail,
+		fmt.Sprintf("Behavior when the k8s caches do not synchronize within %s. Must be one of %v", option.K8sSyncTimeoutName, option.StartupActionOptions))
+	option.BindEnv(Vp, option.K8sSyncTimeoutActionName)
+
 	flags.Duration(option.AllocatorListTimeoutName, defaults.AllocatorListTimeout, "Timeout for listing allocator state before exiting")
 	option.BindEnv(Vp, option.AllocatorListTimeoutName)
 
@@ -615,6 +696,9 @@ func initializeFlags() {
 	flags.Bool(option.EnableServiceTopology, false, "Enable support for service topology aware hints")
 	option.BindEnv(Vp, option.EnableServiceTopology)
 
+	flags.Float64(option.ServiceTopologySpilloverThreshold, 0, "Minimum fraction of a service's backends which must remain after topology aware hints filtering before traffic spills over to all zones (0 disables spillover)")
+	option.BindEnv(Vp, option.ServiceTopologySpilloverThreshold)
+
 	flags.Bool(option.EnableIdentityMark, true, "Enable setting identity mark for local traffic")
 	option.BindEnv(Vp, option.EnableIdentityMark)
 
@@ -710,6 +794,12 @@ func initializeFlags() {
 	flags.Int(option.MonitorQueueSizeName, 0, "Size of the event queue when reading monitor events")
 	option.BindEnv(Vp, option.MonitorQueueSizeName)
 
+	flags.String(option.IPFIXCollectorAddress, "", "Address (host:port) of the IPFIX collector to export sampled flows to (empty to disable)")
+	option.BindEnv(Vp, option.IPFIXCollectorAddress)
+
+	flags.Int(option.IPFIXSampleRate, 128, "Export one of every N observed trace events via IPFIX")
+	option.BindEnv(Vp, option.IPFIXSampleRate)
+
 	flags.Int(option.MTUName, 0, "Overwrite auto-detected MTU of underlying network")
 	option.BindEnv(Vp, option.MTUName)
 
@@ -757,6 +847,9 @@ func initializeFlags() {
 	flags.Int(option.TracePayloadlen, 128, "Length of payload to capture when tracing")
 	option.BindEnv(Vp, option.TracePayloadlen)
 
+	flags.Bool(option.ValidateConfig, false, "Validate the agent configuration, run kernel and connectivity probes, print a report and exit")
+	option.BindEnv(Vp, option.ValidateConfig)
+
 	flags.Bool(option.Version, false, "Print version information")
 	option.BindEnv(Vp, option.Version)
 
@@ -816,6 +909,9 @@ func initializeFlags() {
 	flags.StringSlice(option.MonitorAggregationFlags, option.MonitorAggregationFlagsDefault, "TCP flags that trigger monitor reports when monitor aggregation is enabled")
 	option.BindEnv(Vp, option.MonitorAggregationFlags)
 
+	flags.String(option.MonitorDropSampling, "", "Comma-separated list of <drop reason code>=<max notifications per second> pairs to sample drop notifications per drop reason, adjustable at runtime via 'cilium config monitor-drop-sampling=<spec>'")
+	option.BindEnv(Vp, option.MonitorDropSampling)
+
 	flags.Int(option.NATMapEntriesGlobalName, option.NATMapEntriesGlobalDefault, "Maximum number of entries for the global BPF NAT table")
 	option.BindEnv(Vp, option.NATMapEntriesGlobalName)
 
@@ -847,6 +943,19 @@ func initializeFlags() {
 	flags.Int(option.ToFQDNsMaxIPsPerHost, defaults.ToFQDNsMaxIPsPerHost, "Maximum number of IPs to maintain per FQDN name for each endpoint")
 	option.BindEnv(Vp, option.ToFQDNsMaxIPsPerHost)
 
+	flags.Int(option.ToFQDNsEndpointMaxEntries, 0, "Maximum number of DNS names to retain in each endpoint's FQDN cache (0 is unlimited)")
+	option.BindEnv(Vp, option.ToFQDNsEndpointMaxEntries)
+
+	flags.String(option.ToFQDNsCacheEvictionPolicy, fqdn.CacheEvictionPolicyLRU, fmt.Sprintf("Eviction policy applied when an endpoint's FQDN cache exceeds %s, available options are '%v'", option.ToFQDNsEndpointMaxEntries, []string{fqdn.CacheEvictionPolicyLRU, fqdn.CacheEvictionPolicyTTL}))
+	option.BindEnv(Vp, option.ToFQDNsCacheEvictionPolicy)
+
+	flags.Int(option.ToFQDNsNamespaceIPsQuota, 0, "Maximum number of IPs tracked in the FQDN caches of a namespace's endpoints before its DNS responses stop being expanded into ToFQDNs policy (0 disables the quota)")
+	option.BindEnv(Vp, option.ToFQDNsNamespaceIPsQuota)
+
+	flags.Var(option.NewNamedMapOptions(option.ToFQDNsNamespaceIPsQuotaOverrides, &option.Config.ToFQDNsNamespaceIPsQuotaOverrides, nil),
+		option.ToFQDNsNamespaceIPsQuotaOverrides, "Per-namespace overrides of the FQDN IPs quota, e.g. tenant-a=5000,tenant-b=100")
+	option.BindEnv(Vp, option.ToFQDNsNamespaceIPsQuotaOverrides)
+
 	flags.Int(option.DNSMaxIPsPerRestoredRule, defaults.DNSMaxIPsPerRestoredRule, "Maximum number of IPs to maintain for each restored DNS rule")
 	option.BindEnv(Vp, option.DNSMaxIPsPerRestoredRule)
 
@@ -862,6 +971,15 @@ func initializeFlags() {
 	flags.DurationVar(&option.Config.FQDNProxyResponseMaxDelay, option.FQDNProxyResponseMaxDelay, 100*time.Millisecond, "The maximum time the DNS proxy holds an allowed DNS response before sending it along. Responses are sent as soon as the datapath is updated with the new IP information.")
 	option.BindEnv(Vp, option.FQDNProxyResponseMaxDelay)
 
+	flags.Bool(option.ToFQDNsProxyAsyncPolicyUpdates, false, "Expand DNS responses into ToFQDNs policy asynchronously off the DNS response path instead of delaying responses until the datapath is updated")
+	option.BindEnv(Vp, option.ToFQDNsProxyAsyncPolicyUpdates)
+
+	flags.Duration(option.ToFQDNsPolicyUpdateBatchWindow, 0, fmt.Sprintf("Window over which queued DNS responses coalesce into a single ToFQDNs policy update, 0 disables batching (requires --%s)", option.ToFQDNsProxyAsyncPolicyUpdates))
+	option.BindEnv(Vp, option.ToFQDNsPolicyUpdateBatchWindow)
+
+	flags.StringVar(&option.Config.FQDNIdentityAllocationFailureAction, option.FQDNIdentityAllocationFailureAction, option.FQDNIdentityAllocationFailureActionFailOpen, fmt.Sprintf("Behavior when allocating CIDR identities for a DNS response fails, available options are '%v'", option.FQDNIdentityAllocationFailureOptions))
+	option.BindEnv(Vp, option.FQDNIdentityAllocationFailureAction)
+
 	flags.Int(option.FQDNRegexCompileLRUSize, defaults.FQDNRegexCompileLRUSize, "Size of the FQDN regex compilation LRU. Useful for heavy but repeated DNS L7 rules with MatchName or MatchPattern")
 	flags.MarkHidden(option.FQDNRegexCompileLRUSize)
 	option.BindEnv(Vp, option.FQDNRegexCompileLRUSize)
@@ -872,12 +990,78 @@ func initializeFlags() {
 	flags.Bool(option.ToFQDNsEnableDNSCompression, defaults.ToFQDNsEnableDNSCompression, "Allow the DNS proxy to compress responses to endpoints that are larger than 512 Bytes or the EDNS0 option, if present")
 	option.BindEnv(Vp, option.ToFQDNsEnableDNSCompression)
 
+	flags.Bool(option.ToFQDNsEnableGlobalCache, false, "Share DNS lookups observed by this agent with all agents in the cluster via the kvstore (requires a kvstore)")
+	option.BindEnv(Vp, option.ToFQDNsEnableGlobalCache)
+
+	flags.Bool(option.ToFQDNsPreResolve, false, "Proactively resolve toFQDNs matchNames when a policy is imported and periodically re-resolve them honoring the TTL of the responses")
+	option.BindEnv(Vp, option.ToFQDNsPreResolve)
+
+	flags.Bool(option.ToFQDNsFilterResponseIPs, false, "Restrict A/AAAA records in DNS responses to the IPs that are already plumbed into policy, avoiding a race where an endpoint connects to an IP the agent has not installed yet")
+	option.BindEnv(Vp, option.ToFQDNsFilterResponseIPs)
+
 	flags.Int(option.DNSProxyConcurrencyLimit, 0, "Limit concurrency of DNS message processing")
 	option.BindEnv(Vp, option.DNSProxyConcurrencyLimit)
 
 	flags.Duration(option.DNSProxyConcurrencyProcessingGracePeriod, 0, "Grace time to wait when DNS proxy concurrent limit has been reached during DNS message processing")
 	option.BindEnv(Vp, option.DNSProxyConcurrencyProcessingGracePeriod)
 
+	flags.Int(option.DNSProxyEndpointConcurrencyLimit, 0, "Limit concurrency of DNS message processing per endpoint")
+	option.BindEnv(Vp, option.DNSProxyEndpointConcurrencyLimit)
+
+	flags.Float64(option.DNSProxyEndpointMaxQPS, 0, "Maximum rate of DNS queries of a single endpoint handled by the DNS proxy, 0 disables the limit")
+	option.BindEnv(Vp, option.DNSProxyEndpointMaxQPS)
+
+	flags.Int(option.DNSProxyEndpointMaxBurst, 100, "Number of DNS queries of a single endpoint allowed to burst beyond the rate limit")
+	option.BindEnv(Vp, option.DNSProxyEndpointMaxBurst)
+
+	flags.Int(option.DNSProxyLockCount, defaults.DNSProxyLockCount, "Number of mutexes serializing DNS response handling, sharded by response IP")
+	flags.MarkHidden(option.DNSProxyLockCount)
+	option.BindEnv(Vp, option.DNSProxyLockCount)
+
+	flags.Bool(option.DNSProxyLockShardByEndpoint, false, "Include the endpoint ID in the DNS response lock shard hash to reduce contention between endpoints resolving the same names")
+	flags.MarkHidden(option.DNSProxyLockShardByEndpoint)
+	option.BindEnv(Vp, option.DNSProxyLockShardByEndpoint)
+
+	flags.Bool(option.DNSProxySocketHandover, false, "Hand the DNS proxy listening sockets over to the next agent instance on restart so DNS resolution keeps working while the agent restarts")
+	option.BindEnv(Vp, option.DNSProxySocketHandover)
+
+	flags.Bool(option.DNSProxyBindEndpointNetNS, false, "Additionally bind the DNS proxy listening sockets inside the network namespace of each endpoint to avoid host-namespace port conflicts and support chaining setups")
+	option.BindEnv(Vp, option.DNSProxyBindEndpointNetNS)
+
+	flags.Bool(option.DNSProxyEnableDNS0x20Encoding, false, "Enable DNS 0x20 (random case) encoding of DNS queries forwarded upstream by the DNS proxy")
+	option.BindEnv(Vp, option.DNSProxyEnableDNS0x20Encoding)
+
+	flags.String(option.DNSProxyEDNSClientSubnetMode, "preserve", "Handling of EDNS0 Client Subnet options in DNS queries forwarded upstream by the DNS proxy (\"preserve\", \"strip\" or \"zero\")")
+	option.BindEnv(Vp, option.DNSProxyEDNSClientSubnetMode)
+
+	flags.Bool(option.DNSProxyUseReservedIdentity, false, "Use the reserved dns-proxy identity instead of the querying endpoint's identity for connections from the DNS proxy to upstream DNS servers")
+	option.BindEnv(Vp, option.DNSProxyUseReservedIdentity)
+
+	flags.Bool(option.DNSProxyEnableDNSOverTLS, false, "Forward DNS queries proxied by the DNS proxy to upstream DNS servers over DNS-over-TLS")
+	option.BindEnv(Vp, option.DNSProxyEnableDNSOverTLS)
+
+	flags.String(option.DNSProxyDNSOverTLSServerName, "", "Name to validate the certificate of upstream DNS-over-TLS servers against")
+	option.BindEnv(Vp, option.DNSProxyDNSOverTLSServerName)
+
+	flags.String(option.DNSProxyDNSOverTLSCAFile, "", "CA bundle to validate the certificate of upstream DNS-over-TLS servers with, instead of the system roots")
+	option.BindEnv(Vp, option.DNSProxyDNSOverTLSCAFile)
+
+	flags.Var(option.NewNamedMapOptions(option.DNSProxyDNSOverTLSNamespaceUpstreams, &option.Config.DNSProxyDNSOverTLSNamespaceUpstreams, nil),
+		option.DNSProxyDNSOverTLSNamespaceUpstreams, "Per-namespace DNS-over-TLS upstream overrides, e.g. tenant-a=10.0.0.1:853,tenant-b=resolver.internal:853")
+	option.BindEnv(Vp, option.DNSProxyDNSOverTLSNamespaceUpstreams)
+
+	flags.Int(option.DNSProxyDoHListenPort, 0, "Port the DNS proxy terminates DNS-over-HTTPS traffic from endpoints on (0 to disable DoH interception)")
+	option.BindEnv(Vp, option.DNSProxyDoHListenPort)
+
+	flags.String(option.DNSProxyDoHCertFile, "", "TLS certificate presented to endpoints by the DNS-over-HTTPS listener")
+	option.BindEnv(Vp, option.DNSProxyDoHCertFile)
+
+	flags.String(option.DNSProxyDoHKeyFile, "", "TLS key for the DNS-over-HTTPS listener certificate")
+	option.BindEnv(Vp, option.DNSProxyDoHKeyFile)
+
+	flags.StringSlice(option.DNSProxyDoHResolverIPs, []string{}, "Well-known DNS-over-HTTPS resolver IPs whose HTTPS traffic from endpoints is redirected to the DoH listener")
+	option.BindEnv(Vp, option.DNSProxyDoHResolverIPs)
+
 	flags.Int(option.PolicyQueueSize, defaults.PolicyQueueSize, "size of queues for policy-related events")
 	option.BindEnv(Vp, option.PolicyQueueSize)
 
@@ -961,6 +1145,9 @@ func initializeFlags() {
 	flags.Bool(option.HubbleSkipUnknownCGroupIDs, true, "Skip Hubble events with unknown cgroup ids")
 	option.BindEnv(Vp, option.HubbleSkipUnknownCGroupIDs)
 
+	flags.Bool(option.HubbleHostNetworkPodAttribution, false, "Attribute Hubble socket events of host-network pods to the owning pod via their cgroup ids")
+	option.BindEnv(Vp, option.HubbleHostNetworkPodAttribution)
+
 	flags.StringSlice(option.DisableIptablesFeederRules, []string{}, "Chains to ignore when installing feeder rules.")
 	option.BindEnv(Vp, option.DisableIptablesFeederRules)
 
@@ -1046,6 +1233,9 @@ func initializeFlags() {
 	flags.MarkHidden(option.EnableICMPRules)
 	option.BindEnv(Vp, option.EnableICMPRules)
 
+	flags.Bool(option.EnableNodeSelectorLabels, defaults.EnableNodeSelectorLabels, "Enable ingestion of node labels into node identities, allowing fromNodes/toNodes rules in Cilium Network Policies")
+	option.BindEnv(Vp, option.EnableNodeSelectorLabels)
+
 	flags.Bool(option.BypassIPAvailabilityUponRestore, false, "Bypasses the IP availability error within IPAM upon endpoint restore")
 	flags.MarkHidden(option.BypassIPAvailabilityUponRestore)
 	option.BindEnv(Vp, option.BypassIPAvailabilityUponRestore)
@@ -1559,14 +1749,42 @@ func (d *Daemon) initKVStore() {
 		}
 	}
 
-	if err := kvstore.Setup(context.TODO(), option.Config.KVStore, option.Config.KVStoreOpt, goopts); err != nil {
+	setupCtx := context.TODO()
+	if option.Config.KVstoreStartupTimeout > 0 {
+		var cancel context.CancelFunc
+		setupCtx, cancel = context.WithTimeout(setupCtx, option.Config.KVstoreStartupTimeout)
+		defer cancel()
+	}
+
+	if err := kvstore.Setup(setupCtx, option.Config.KVStore, option.Config.KVStoreOpt, goopts); err != nil {
 		addrkey := fmt.Sprintf("%s.address", option.Config.KVStore)
 		addr := option.Config.KVStoreOpt[addrkey]
 
-		log.WithError(err).WithFields(logrus.Fields{
+		scopedLog := log.WithError(err).WithFields(logrus.Fields{
 			"kvstore": option.Config.KVStore,
 			"address": addr,
-		}).Fatal("Unable to setup kvstore")
+		})
+
+		if option.Config.KVstoreStartupAction != option.StartupActionContinue {
+			scopedLog.Fatal("Unable to setup kvstore")
+		}
+
+		// Continue the startup in degraded mode so that restored endpoints
+		// keep being served, and retry the kvstore connection in the
+		// background until it succeeds.
+		scopedLog.Error("Unable to setup kvstore, continuing with degraded startup")
+		controller.NewManager().UpdateController("kvstore-startup-retry",
+			controller.ControllerParams{
+				DoFunc: func(ctx context.Context) error {
+					if err := kvstore.Setup(ctx, option.Config.KVStore, option.Config.KVStoreOpt, goopts); err != nil {
+						return err
+					}
+					log.Info("Connection to kvstore established after degraded startup")
+					return nil
+				},
+				Context: d.ctx,
+			},
+		)
 	}
 }
 
@@ -1721,6 +1939,10 @@ func runDaemon(d *Daemon, restoredEndpoints *endpointRestoreState, cleaner *daem
 		// Wait only for certain caches, but not all!
 		// (Check Daemon.InitK8sSubsystem() for more info)
 		<-d.k8sCachesSynced
+
+		// Allow the agent to attach events for significant conditions to
+		// the Kubernetes objects they relate to.
+		k8sEvents.Init(params.Clientset)
 	}
 	bootstrapStats.k8sInit.End(true)
 	restoreComplete := d.initRestore(restoredEndpoints)
@@ -1760,9 +1982,48 @@ func runDaemon(d *Daemon, restoredEndpoints *endpointRestoreState, cleaner *daem
 		ms := maps.NewMapSweeper(&EndpointMapManager{
 			EndpointManager: d.endpointManager,
 		})
+		ms.DryRun = option.Config.BPFOrphanGCDryRun
 		ms.CollectStaleMapGarbage()
 		ms.RemoveDisabledMaps()
 
+		if interval := option.Config.BPFOrphanGCInterval; interval > 0 {
+			controller.NewManager().UpdateController("bpf-orphan-gc",
+				controller.ControllerParams{
+					RunInterval: interval,
+					DoFunc: func(ctx context.Context) error {
+						ms.CollectStaleMapGarbage()
+						if ms.DryRun {
+							return nil
+						}
+						// Removing leftover veths also removes
+						// the tc filters and qdiscs attached to
+						// them.
+						return clearCiliumVeths()
+					},
+				})
+		}
+
+		if interval := option.Config.LBMapVerifyInterval; interval > 0 {
+			controller.NewManager().UpdateController("lbmap-verifier",
+				controller.ControllerParams{
+					RunInterval: interval,
+					DoFunc: func(ctx context.Context) error {
+						found, err := d.svc.VerifyLBMaps(option.Config.LBMapVerifyRepair)
+						if found > 0 {
+							log.WithField("inconsistencies", found).
+								Warning("LB map verification found inconsistencies between the BPF maps and the desired service state")
+						}
+						return err
+					},
+				})
+		}
+
+		if option.Config.MetricsConfig.EndpointNetworkBytesEnabled ||
+			option.Config.MetricsConfig.EndpointNetworkPacketsEnabled ||
+			option.Config.MetricsConfig.EndpointNetworkDropsEnabled {
+			d.startEndpointNetworkStatsExporter()
+		}
+
 		if len(d.restoredCIDRs) > 0 {
 			// Release restored CIDR identities after a grace period (default 10
 			// minutes).  Any identities actually in use will still exist after
@@ -1784,6 +2045,14 @@ func runDaemon(d *Daemon, restoredEndpoints *endpointRestoreState, cleaner *daem
 			d.restoredCIDRs = nil
 		}
 	}()
+	if option.Config.EnableIPv6 && len(option.Config.NDPProxyDevices) > 0 {
+		d.ndpProxy = ndpproxy.NewNDPProxy()
+		if err := d.ndpProxy.Start(option.Config.NDPProxyDevices); err != nil {
+			log.WithError(err).Fatal("Unable to start NDP proxy")
+		}
+		cleaner.cleanupFuncs.Add(func() { d.ndpProxy.Close() })
+	}
+
 	d.endpointManager.Subscribe(d)
 	// Add the endpoint manager unsubscribe as the last step in cleanup
 	defer cleaner.cleanupFuncs.Add(func() { d.endpointManager.Unsubscribe(d) })
@@ -1888,6 +2157,15 @@ func runDaemon(d *Daemon, restoredEndpoints *endpointRestoreState, cleaner *daem
 	bootstrapStats.updateMetrics()
 	go d.launchHubble()
 
+	if option.Config.IPFIXCollectorAddress != "" {
+		ipfixExporter, err := ipfix.NewExporter(option.Config.IPFIXCollectorAddress, option.Config.IPFIXSampleRate)
+		if err != nil {
+			log.WithError(err).Fatal("Unable to start IPFIX flow export")
+		}
+		d.monitorAgent.RegisterNewConsumer(ipfixExporter)
+		cleaner.cleanupFuncs.Add(ipfixExporter.Close)
+	}
+
 	err = option.Config.StoreInFile(option.Config.StateDir)
 	if err != nil {
 		log.WithError(err).Error("Unable to store Cilium's configuration")
@@ -1962,6 +2240,12 @@ func (d *Daemon) instantiateAPI() *restapi.CiliumAPIAPI {
 		// /endpoint/{id}/healthz
 		restAPI.EndpointGetEndpointIDHealthzHandler = NewGetEndpointIDHealthzHandler(d)
 
+		// /endpoint/{id}/stats
+		restAPI.EndpointGetEndpointIDStatsHandler = NewGetEndpointIDStatsHandler(d)
+
+		// /attribution/
+		restAPI.EndpointGetAttributionHandler = NewGetAttributionHandler(d)
+
 		// /identity/
 		restAPI.PolicyGetIdentityHandler = newGetIdentityHandler(d)
 		restAPI.PolicyGetIdentityIDHandler = newGetIdentityIDHandler(d.identityAllocator)
@@ -2013,9 +2297,16 @@ func (d *Daemon) instantiateAPI() *restapi.CiliumAPIAPI {
 		restAPI.IpamDeleteIpamIPHandler = NewDeleteIPAMIPHandler(d)
 	}
 
+	// /controllers
+	restAPI.DaemonGetControllersHandler = NewGetControllersHandler(d)
+	restAPI.DaemonPostControllersNameTriggerHandler = NewPostControllersNameTriggerHandler(d)
+
 	// /debuginfo
 	restAPI.DaemonGetDebuginfoHandler = NewGetDebugInfoHandler(d)
 
+	// /proxy/xds
+	restAPI.DaemonGetProxyXdsHandler = NewGetProxyXdsHandler(d)
+
 	// /map
 	restAPI.DaemonGetMapHandler = NewGetMapHandler(d)
 	restAPI.DaemonGetMapNameHandler = NewGetMapNameHandler(d)
@@ -2029,7 +2320,13 @@ func (d *Daemon) instantiateAPI() *restapi.CiliumAPIAPI {
 		restAPI.PolicyGetFqdnCacheHandler = NewGetFqdnCacheHandler(d)
 		restAPI.PolicyDeleteFqdnCacheHandler = NewDeleteFqdnCacheHandler(d)
 		restAPI.PolicyGetFqdnCacheIDHandler = NewGetFqdnCacheIDHandler(d)
+		restAPI.PolicyGetFqdnExplainNameHandler = NewGetFqdnExplainNameHandler(d)
 		restAPI.PolicyGetFqdnNamesHandler = NewGetFqdnNamesHandler(d)
+		restAPI.PolicyGetFqdnProxyRulesHandler = NewGetFqdnProxyRulesHandler(d)
+
+		// /fqdn/zombies
+		restAPI.PolicyGetFqdnZombiesHandler = NewGetFqdnZombiesHandler(d)
+		restAPI.PolicyDeleteFqdnZombiesHandler = NewDeleteFqdnZombiesHandler(d)
 	}
 
 	// /ip/
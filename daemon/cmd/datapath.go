@@ -31,6 +31,7 @@ import (
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/maps/dropcache"
 	"github.com/cilium/cilium/pkg/maps/egressmap"
 	"github.com/cilium/cilium/pkg/maps/eventsmap"
 	"github.com/cilium/cilium/pkg/maps/fragmap"
@@ -42,6 +43,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/nat"
 	"github.com/cilium/cilium/pkg/maps/neighborsmap"
 	"github.com/cilium/cilium/pkg/maps/policymap"
+	"github.com/cilium/cilium/pkg/maps/qosmap"
 	"github.com/cilium/cilium/pkg/maps/signalmap"
 	"github.com/cilium/cilium/pkg/maps/srv6map"
 	"github.com/cilium/cilium/pkg/maps/tunnel"
@@ -348,6 +350,18 @@ func (d *Daemon) initMaps() error {
 		}
 	}
 
+	if len(option.Config.QoSDSCPPolicies) > 0 {
+		if err := qosmap.InitQoSPolicyMap(); err != nil {
+			return err
+		}
+	}
+
+	if option.Config.DropVerdictCacheTTL > 0 {
+		if err := dropcache.InitDropCacheMap(); err != nil {
+			return err
+		}
+	}
+
 	if option.Config.EnableSRv6 {
 		srv6map.CreateMaps()
 	}
@@ -449,6 +463,17 @@ func (d *Daemon) initMaps() error {
 			Context:     d.ctx,
 		})
 
+	if option.Config.DropVerdictCacheTTL > 0 {
+		// Periodically sync the hit counters of the drop verdict cache
+		// with the prometheus server.
+		controller.NewManager().UpdateController("dropcache-bpf-prom-sync",
+			controller.ControllerParams{
+				DoFunc:      dropcache.SyncStats,
+				RunInterval: 5 * time.Second,
+				Context:     d.ctx,
+			})
+	}
+
 	if !option.Config.RestoreState {
 		// If we are not restoring state, all endpoints can be
 		// deleted. Entries will be re-populated.
@@ -35,6 +35,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/proxy"
 )
 
@@ -455,7 +456,9 @@ func (d *Daemon) createEndpoint(ctx context.Context, owner regeneration.Owner, e
 			if err != nil {
 				return "", err
 			}
-			return p.Annotations[annotation.ProxyVisibility], nil
+			return policy.MergeVisibilityAnnotations(
+				p.Annotations[annotation.ProxyVisibility],
+				p.Annotations[annotation.DNSVisibility]), nil
 		})
 		ep.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress string, err error) {
 			p, err := d.k8sWatcher.GetCachedPod(ns, podName)
@@ -714,6 +717,16 @@ func (d *Daemon) DeleteEndpoint(id string) (int, error) {
 func (d *Daemon) EndpointDeleted(ep *endpoint.Endpoint, conf endpoint.DeleteConfig) {
 	d.SendNotification(monitorAPI.EndpointDeleteMessage(ep))
 
+	if option.Config.DNSProxyBindEndpointNetNS && proxy.DefaultDNSProxy != nil {
+		if netNSPath := ep.GetNetNSPath(); netNSPath != "" {
+			proxy.DefaultDNSProxy.CloseNetNSListeners(netNSPath)
+		}
+	}
+
+	if d.ndpProxy != nil && ep.IPv6.IsValid() {
+		d.ndpProxy.RemoveIP(ep.IPv6)
+	}
+
 	if !conf.NoIPRelease {
 		if option.Config.EnableIPv4 {
 			if err := d.ipam.ReleaseIP(ep.IPv4.AsSlice()); err != nil {
@@ -738,6 +751,18 @@ func (d *Daemon) EndpointDeleted(ep *endpoint.Endpoint, conf endpoint.DeleteConf
 // It is called after Daemon calls into d.endpointManager.AddEndpoint().
 func (d *Daemon) EndpointCreated(ep *endpoint.Endpoint) {
 	d.SendNotification(monitorAPI.EndpointCreateMessage(ep))
+
+	if option.Config.DNSProxyBindEndpointNetNS && proxy.DefaultDNSProxy != nil {
+		if netNSPath := ep.GetNetNSPath(); netNSPath != "" {
+			if err := proxy.DefaultDNSProxy.ListenInNetNS(netNSPath); err != nil {
+				ep.Logger(daemonSubsys).WithError(err).Warning("Unable to bind the DNS proxy inside the endpoint network namespace")
+			}
+		}
+	}
+
+	if d.ndpProxy != nil && ep.IPv6.IsValid() {
+		d.ndpProxy.AddIP(ep.IPv6)
+	}
 }
 
 type deleteEndpointID struct {
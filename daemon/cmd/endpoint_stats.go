@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/cilium/cilium/api/v1/models"
+	. "github.com/cilium/cilium/api/v1/server/restapi/endpoint"
+	"github.com/cilium/cilium/pkg/api"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+)
+
+type getEndpointIDStats struct {
+	d *Daemon
+}
+
+func NewGetEndpointIDStatsHandler(d *Daemon) GetEndpointIDStatsHandler {
+	return &getEndpointIDStats{d: d}
+}
+
+func (h *getEndpointIDStats) Handle(params GetEndpointIDStatsParams) middleware.Responder {
+	log.WithField(logfields.EndpointID, params.ID).Debug("GET /endpoint/{id}/stats request")
+
+	r, err := h.d.apiLimiterSet.Wait(params.HTTPRequest.Context(), apiRequestEndpointGet)
+	if err != nil {
+		return api.Error(http.StatusTooManyRequests, err)
+	}
+	defer r.Done()
+
+	ep, err := h.d.endpointManager.Lookup(params.ID)
+	if err != nil {
+		r.Error(err)
+		return api.Error(GetEndpointIDStatsInvalidCode, err)
+	} else if ep == nil {
+		r.Error(errEndpointNotFound)
+		return NewGetEndpointIDStatsNotFound()
+	}
+
+	stats, err := ep.GetNetworkStats()
+	if err != nil {
+		r.Error(err)
+		return api.Error(GetEndpointIDStatsInvalidCode, err)
+	}
+	return NewGetEndpointIDStatsOK().WithPayload(stats)
+}
+
+// endpointNetworkStatsInterval is how often the per-endpoint traffic
+// counters are sampled into the Prometheus metrics.
+const endpointNetworkStatsInterval = 30 * time.Second
+
+// Direction label values, matching the datapath metrics exported from the
+// metrics map.
+const (
+	dirIngress = "INGRESS"
+	dirEgress  = "EGRESS"
+)
+
+// nsDirectionKey aggregates endpoint traffic per namespace and direction to
+// bound the cardinality of the exported metrics.
+type nsDirectionKey struct {
+	namespace string
+	direction string
+}
+
+type endpointNetworkCounters struct {
+	bytes   int64
+	packets int64
+	drops   int64
+}
+
+// startEndpointNetworkStatsExporter periodically sums up the traffic
+// counters of all local endpoints per namespace and direction and feeds the
+// increments into the endpoint network Prometheus metrics.
+func (d *Daemon) startEndpointNetworkStatsExporter() {
+	prev := make(map[nsDirectionKey]endpointNetworkCounters)
+	controller.NewManager().UpdateController("endpoint-network-stats",
+		controller.ControllerParams{
+			RunInterval: endpointNetworkStatsInterval,
+			DoFunc: func(ctx context.Context) error {
+				totals := make(map[nsDirectionKey]endpointNetworkCounters, len(prev))
+				for _, ep := range d.endpointManager.GetEndpoints() {
+					stats, err := ep.GetNetworkStats()
+					if err != nil {
+						// Endpoints may disappear or still be
+						// initializing between iterations.
+						continue
+					}
+					addEndpointNetworkStats(totals, ep.GetK8sNamespace(), stats)
+				}
+				for key, total := range totals {
+					addEndpointNetworkDeltas(key, total, prev[key])
+					prev[key] = total
+				}
+				// Forget namespaces without endpoints so that their
+				// stale totals are not misread as counter resets once
+				// the namespace is populated again.
+				for key := range prev {
+					if _, ok := totals[key]; !ok {
+						delete(prev, key)
+					}
+				}
+				return nil
+			},
+		})
+}
+
+func addEndpointNetworkStats(totals map[nsDirectionKey]endpointNetworkCounters, namespace string, stats *models.EndpointNetworkStats) {
+	in := totals[nsDirectionKey{namespace, dirIngress}]
+	in.bytes += stats.RxBytes
+	in.packets += stats.RxPackets
+	in.drops += stats.RxDrops
+	totals[nsDirectionKey{namespace, dirIngress}] = in
+
+	out := totals[nsDirectionKey{namespace, dirEgress}]
+	out.bytes += stats.TxBytes
+	out.packets += stats.TxPackets
+	out.drops += stats.TxDrops
+	totals[nsDirectionKey{namespace, dirEgress}] = out
+}
+
+func addEndpointNetworkDeltas(key nsDirectionKey, total, prev endpointNetworkCounters) {
+	metrics.EndpointNetworkBytes.WithLabelValues(key.namespace, key.direction).
+		Add(counterDelta(total.bytes, prev.bytes))
+	metrics.EndpointNetworkPackets.WithLabelValues(key.namespace, key.direction).
+		Add(counterDelta(total.packets, prev.packets))
+	metrics.EndpointNetworkDrops.WithLabelValues(key.namespace, key.direction).
+		Add(counterDelta(total.drops, prev.drops))
+}
+
+// counterDelta returns the increment between two samples of a monotonic
+// counter. A decrease means the underlying policy maps were recreated, in
+// which case the new total is the increment since the reset.
+func counterDelta(total, prev int64) float64 {
+	if total < prev {
+		return float64(total)
+	}
+	return float64(total - prev)
+}
@@ -5,12 +5,16 @@ package cmd
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"net"
 	"net/netip"
 	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,13 +31,24 @@ import (
 	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/fqdn"
+	fqdndns "github.com/cilium/cilium/pkg/fqdn/dns"
 	"github.com/cilium/cilium/pkg/fqdn/dnsproxy"
 	"github.com/cilium/cilium/pkg/fqdn/matchpattern"
+	"github.com/cilium/cilium/pkg/fqdn/re"
+	fqdnstore "github.com/cilium/cilium/pkg/fqdn/store"
 	"github.com/cilium/cilium/pkg/identity"
 	secIDCache "github.com/cilium/cilium/pkg/identity/cache"
 	"github.com/cilium/cilium/pkg/ip"
+	k8sEvents "github.com/cilium/cilium/pkg/k8s/events"
+	"github.com/cilium/cilium/pkg/kvstore/store"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/metrics"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+	"github.com/cilium/cilium/pkg/node"
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
 	policyApi "github.com/cilium/cilium/pkg/policy/api"
 	"github.com/cilium/cilium/pkg/proxy"
@@ -43,11 +58,14 @@ import (
 )
 
 const (
-	upstream        = "upstreamTime"
-	processingTime  = "processingTime"
-	semaphoreTime   = "semaphoreTime"
-	policyCheckTime = "policyCheckTime"
-	dataplaneTime   = "dataplaneTime"
+	upstream              = "upstreamTime"
+	processingTime        = "processingTime"
+	semaphoreTime         = "semaphoreTime"
+	endpointSemaphoreTime = "endpointSemaphoreTime"
+	policyCheckTime       = "policyCheckTime"
+	dataplaneTime         = "dataplaneTime"
+	identityAllocTime     = "identityAllocationTime"
+	ipcachePlumbingTime   = "ipcachePlumbingTime"
 
 	metricErrorTimeout = "timeout"
 	metricErrorProxy   = "proxyErr"
@@ -56,8 +74,26 @@ const (
 
 	dnsSourceLookup     = "lookup"
 	dnsSourceConnection = "connection"
+
+	// dnsGCJobName is the name of the controller which removes stale or
+	// undesired entries from the DNS caches.
+	dnsGCJobName = "dns-garbage-collector-job"
+
+	// fqdnIdentityReconcilerJobName is the name of the controller which
+	// detects and repairs leaked CIDR identity references from the FQDN
+	// subsystem.
+	fqdnIdentityReconcilerJobName = "fqdn-identity-reconciler"
+
+	// fqdnIdentityReconcilerInterval is how often the FQDN identity
+	// reconciler compares the selector-held identity references against the
+	// local identity allocator.
+	fqdnIdentityReconcilerInterval = 5 * time.Minute
 )
 
+// dnsGCControllerManager tracks the DNS garbage collector controller so that
+// a GC run can be triggered on demand via the API.
+var dnsGCControllerManager = controller.NewManager()
+
 func identitiesForFQDNSelectorIPs(selectorsWithIPsToUpdate map[policyApi.FQDNSelector][]net.IP, identityAllocator secIDCache.IdentityAllocator) (map[policyApi.FQDNSelector][]*identity.Identity, []*identity.Identity, map[netip.Prefix]*identity.Identity, error) {
 	var err error
 
@@ -151,6 +187,18 @@ func (d *Daemon) updateSelectorCacheFQDNs(ctx context.Context, selectors map[pol
 	return d.endpointManager.UpdatePolicyMaps(ctx, notifyWg)
 }
 
+const (
+	// dnsCacheRestoreFile is the file in StateDir the global DNS cache is
+	// periodically persisted to, so that IP-to-name mappings survive agent
+	// restarts without waiting for pods to re-resolve.
+	dnsCacheRestoreFile = "fqdn-cache.json"
+
+	// dnsCacheRestoreMaxAge bounds the age of restored DNS lookups.
+	// Entries looked up longer ago are garbage collected at restore time,
+	// even if their TTL has not yet expired.
+	dnsCacheRestoreMaxAge = 24 * time.Hour
+)
+
 // bootstrapFQDN initializes the toFQDNs related subsystems: dnsNameManager and the DNS proxy.
 // dnsNameManager will use the default resolver and, implicitly, the
 // default DNS cache. The proxy binds to all interfaces, and uses the
@@ -160,6 +208,7 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 		MinTTL:          option.Config.ToFQDNsMinTTL,
 		Cache:           fqdn.NewDNSCache(option.Config.ToFQDNsMinTTL),
 		UpdateSelectors: d.updateSelectors,
+		PreResolve:      option.Config.ToFQDNsPreResolve,
 	}
 	// Disable cleanup tracking on the default DNS cache. This cache simply
 	// tracks which api.FQDNSelector are present in policy which apply to
@@ -169,6 +218,7 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 	rg := fqdn.NewNameManager(cfg)
 	d.policy.GetSelectorCache().SetLocalIdentityNotifier(rg)
 	d.dnsNameManager = rg
+	rg.StartPreResolver(d.ctx)
 
 	// Controller to cleanup TTL expired entries from the DNS policies.
 	// dns-garbage-collector-job runs the logic to remove stale or undesired
@@ -183,9 +233,8 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 	// liveness for each to-delete IP. When an IP is not in-use it is finally
 	// deleted from the global DNSCache. Until then, each of these IPs is
 	// inserted into the global cache as a synthetic DNS lookup.
-	dnsGCJobName := "dns-garbage-collector-job"
 	dnsGCJobInterval := 1 * time.Minute
-	controller.NewManager().UpdateController(dnsGCJobName, controller.ControllerParams{
+	dnsGCControllerManager.UpdateController(dnsGCJobName, controller.ControllerParams{
 		RunInterval: dnsGCJobInterval,
 		DoFunc: func(ctx context.Context) error {
 			var (
@@ -281,6 +330,51 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 		Context: d.ctx,
 	})
 
+	// Periodic consistency checker which compares the CIDR identity
+	// references held by the ToFQDNs selectors against the reference counts
+	// of the local identity allocator, and repairs reference leaks. An
+	// identity is only considered leaked once it has been seen unreferenced
+	// in two consecutive runs, so that in-flight DNS updates holding
+	// temporary references are not racing the repair.
+	leakSuspects := make(map[identity.NumericIdentity]struct{})
+	d.controllers.UpdateController(fqdnIdentityReconcilerJobName, controller.ControllerParams{
+		RunInterval: fqdnIdentityReconcilerInterval,
+		DoFunc: func(ctx context.Context) error {
+			leakSuspects = d.reconcileFQDNIdentities(ctx, leakSuspects)
+			return nil
+		},
+		Context: d.ctx,
+	})
+
+	// Restore the global DNS cache persisted by the previous agent run. This
+	// carries over mappings which are not covered by the restored endpoints
+	// below, e.g. for endpoints whose pods were deleted while the agent was
+	// down. Entries which have expired, or which are older than the restore
+	// age bound, are garbage collected before the merge.
+	dnsCachePath := filepath.Join(option.Config.StateDir, dnsCacheRestoreFile)
+	if restoredCache, err := readPreCache(dnsCachePath); err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).WithField(logfields.Path, dnsCachePath).Error("Cannot restore persisted FQDN cache")
+		}
+	} else {
+		restoredCache.GC(time.Now(), nil)
+		restoredCache.ForceExpire(time.Now().Add(-dnsCacheRestoreMaxAge), nil)
+		d.dnsNameManager.GetDNSCache().UpdateFromCache(restoredCache, nil)
+		log.WithField(logfields.Path, dnsCachePath).Info("Restored persisted FQDN cache")
+	}
+
+	// Periodically persist the global DNS cache, so that it can be restored
+	// on the next agent start.
+	dnsCachePersistJobName := "fqdn-cache-persist-job"
+	dnsCachePersistJobInterval := 5 * time.Minute
+	controller.NewManager().UpdateController(dnsCachePersistJobName, controller.ControllerParams{
+		RunInterval: dnsCachePersistJobInterval,
+		DoFunc: func(ctx context.Context) error {
+			return d.persistDNSCache(dnsCachePath)
+		},
+		Context: d.ctx,
+	})
+
 	// Prefill the cache with the CLI provided pre-cache data. This allows various bridging arrangements during upgrades, or just ensure critical DNS mappings remain.
 	if preCachePath != "" {
 		log.WithField(logfields.Path, preCachePath).Info("Reading toFQDNs pre-cache data")
@@ -331,6 +425,40 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 		return nil
 	}
 
+	switch option.Config.ToFQDNsCacheEvictionPolicy {
+	case fqdn.CacheEvictionPolicyLRU, fqdn.CacheEvictionPolicyTTL:
+	default:
+		return fmt.Errorf("Invalid value %q for --%s, available options are '%v'",
+			option.Config.ToFQDNsCacheEvictionPolicy, option.ToFQDNsCacheEvictionPolicy,
+			[]string{fqdn.CacheEvictionPolicyLRU, fqdn.CacheEvictionPolicyTTL})
+	}
+
+	switch option.Config.FQDNIdentityAllocationFailureAction {
+	case option.FQDNIdentityAllocationFailureActionFailOpen, option.FQDNIdentityAllocationFailureActionFailClosed:
+	default:
+		return fmt.Errorf("Invalid value %q for --%s, available options are '%v'",
+			option.Config.FQDNIdentityAllocationFailureAction, option.FQDNIdentityAllocationFailureAction,
+			option.FQDNIdentityAllocationFailureOptions)
+	}
+
+	if option.Config.DNSProxyLockCount <= 0 {
+		return fmt.Errorf("Invalid value %d for --%s, the lock count must be positive",
+			option.Config.DNSProxyLockCount, option.DNSProxyLockCount)
+	}
+	d.dnsResponseLocks = newDNSResponseLocks(option.Config.DNSProxyLockCount, option.Config.DNSProxyLockShardByEndpoint)
+
+	// Join the cluster-wide FQDN cache to learn DNS lookups observed by
+	// other agents. This happens in the background as the kvstore may not
+	// be connected yet.
+	if option.Config.ToFQDNsEnableGlobalCache && option.Config.KVStore != "" {
+		go d.joinFQDNGlobalCache()
+	}
+
+	if option.Config.ToFQDNsProxyAsyncPolicyUpdates {
+		d.dnsPolicyUpdateQueue = make(chan *dnsPolicyUpdate, dnsPolicyUpdateQueueSize)
+		go d.processDNSPolicyUpdates()
+	}
+
 	// Once we stop returning errors from StartDNSProxy this should live in
 	// StartProxySupport
 	port, err := proxy.GetProxyPort(proxy.DNSProxyName)
@@ -344,8 +472,23 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 		return err
 	}
 	proxy.DefaultDNSProxy, err = dnsproxy.StartDNSProxy("", port, option.Config.ToFQDNsEnableDNSCompression,
+		option.Config.DNSProxyEnableDNS0x20Encoding, option.Config.DNSProxyEDNSClientSubnetMode,
+		option.Config.DNSProxyUseReservedIdentity,
+		dnsproxy.DoTConfig{
+			Enabled:            option.Config.DNSProxyEnableDNSOverTLS,
+			ServerName:         option.Config.DNSProxyDNSOverTLSServerName,
+			CAFile:             option.Config.DNSProxyDNSOverTLSCAFile,
+			NamespaceUpstreams: option.Config.DNSProxyDNSOverTLSNamespaceUpstreams,
+		},
+		dnsproxy.DoHConfig{
+			Port:     uint16(option.Config.DNSProxyDoHListenPort),
+			CertFile: option.Config.DNSProxyDoHCertFile,
+			KeyFile:  option.Config.DNSProxyDoHKeyFile,
+		},
 		option.Config.DNSMaxIPsPerRestoredRule, d.lookupEPByIP, d.LookupSecIDByIP, d.lookupIPsBySecID,
-		d.notifyOnDNSMsg, option.Config.DNSProxyConcurrencyLimit, option.Config.DNSProxyConcurrencyProcessingGracePeriod)
+		d.notifyOnDNSMsg, option.Config.DNSProxyConcurrencyLimit, option.Config.DNSProxyConcurrencyProcessingGracePeriod,
+		option.Config.DNSProxyEndpointConcurrencyLimit,
+		option.Config.DNSProxyEndpointMaxQPS, option.Config.DNSProxyEndpointMaxBurst)
 	if err == nil {
 		// Increase the ProxyPort reference count so that it will never get released.
 		err = d.l7Proxy.SetProxyPort(proxy.DNSProxyName, proxy.ProxyTypeDNS, proxy.DefaultDNSProxy.GetBindPort(), false)
@@ -353,6 +496,15 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 			log.Infof("Reusing previous DNS proxy port: %d", port)
 		}
 		proxy.DefaultDNSProxy.SetRejectReply(option.Config.FQDNRejectResponse)
+		if option.Config.ToFQDNsFilterResponseIPs {
+			// An IP is considered plumbed once it is present in the
+			// ipcache, i.e. an identity has been allocated for it and
+			// pushed to the datapath.
+			proxy.DefaultDNSProxy.SetIPPlumbedCheck(func(ip net.IP) bool {
+				_, exists := d.ipcache.LookupByIP(ip.String())
+				return exists
+			})
+		}
 		// Restore old rules
 		for _, possibleEP := range possibleEndpoints {
 			// Upgrades from old ciliums have this nil
@@ -364,6 +516,65 @@ func (d *Daemon) bootstrapFQDN(possibleEndpoints map[uint16]*endpoint.Endpoint,
 	return err // filled by StartDNSProxy
 }
 
+// reconcileFQDNIdentities compares the CIDR identity references held by the
+// ToFQDNs selectors in the selector cache against the reference counts of the
+// local identity allocator, and releases identities which no subsystem
+// references anymore. Identities still present in the ipcache are in active
+// use, e.g. by CIDR policy rules, and are never touched. A discrepancy in the
+// other direction, a selector referencing an identity unknown to the
+// allocator, cannot be repaired from here and is only reported.
+//
+// The given suspects are the identities found unreferenced by the previous
+// run; only identities which are still unreferenced now are repaired. The
+// returned map holds the suspects for the next run.
+func (d *Daemon) reconcileFQDNIdentities(ctx context.Context, suspects map[identity.NumericIdentity]struct{}) map[identity.NumericIdentity]struct{} {
+	selectorRefs := d.policy.GetSelectorCache().FQDNSelectorIdentityReferences()
+	localRefs := d.identityAllocator.LocalIdentityReferences()
+
+	for id := range selectorRefs {
+		if _, ok := localRefs[id]; !ok {
+			log.WithFields(logrus.Fields{
+				logfields.Identity:   id,
+				logfields.Controller: fqdnIdentityReconcilerJobName,
+			}).Error("ToFQDNs selector references a CIDR identity unknown to the local allocator")
+		}
+	}
+
+	nextSuspects := make(map[identity.NumericIdentity]struct{})
+	var leakedRefs []identity.NumericIdentity
+	leakedIdentities := 0
+	for id, refCount := range localRefs {
+		if _, held := selectorRefs[id]; held {
+			continue
+		}
+		if len(d.lookupIPsBySecID(id)) > 0 {
+			continue
+		}
+		if _, suspected := suspects[id]; !suspected {
+			nextSuspects[id] = struct{}{}
+			continue
+		}
+		// Release every outstanding reference so that the identity is
+		// deallocated.
+		leakedIdentities++
+		for i := 0; i < refCount; i++ {
+			leakedRefs = append(leakedRefs, id)
+		}
+	}
+
+	if len(leakedRefs) > 0 {
+		metrics.FQDNIdentityReferenceLeaks.Add(float64(len(leakedRefs)))
+		log.WithFields(logrus.Fields{
+			logfields.Controller: fqdnIdentityReconcilerJobName,
+			"leakedIdentities":   leakedIdentities,
+			"leakedReferences":   len(leakedRefs),
+		}).Error("Detected leaked FQDN CIDR identity references, releasing them")
+		d.identityAllocator.ReleaseCIDRIdentitiesByID(ctx, leakedRefs)
+	}
+
+	return nextSuspects
+}
+
 // updateDNSDatapathRules updates the DNS proxy iptables rules. Must be
 // called after iptables has been initailized, and only after
 // successful bootstrapFQDN().
@@ -371,13 +582,26 @@ func (d *Daemon) updateDNSDatapathRules(ctx context.Context) error {
 	return d.l7Proxy.AckProxyPort(ctx, proxy.DNSProxyName)
 }
 
+// dnsProxyStatKey is the context key under which the ProxyRequestContext of
+// the DNS request being processed travels through the NameManager into
+// updateSelectors, where the identity allocation stage is timed.
+type dnsProxyStatKey struct{}
+
 // updateSelectors propagates the mapping of FQDNSelector to identity, as well
 // as the set of FQDNSelectors which have no IPs which correspond to them
 // (usually due to TTL expiry), down to policy layer managed by this daemon.
 func (d *Daemon) updateSelectors(ctx context.Context, selectorWithIPsToUpdate map[policyApi.FQDNSelector][]net.IP, selectorsWithoutIPs []policyApi.FQDNSelector) (wg *sync.WaitGroup, usedIdentities []*identity.Identity, newlyAllocatedIdentities map[netip.Prefix]*identity.Identity, err error) {
+	stat, _ := ctx.Value(dnsProxyStatKey{}).(*dnsproxy.ProxyRequestContext)
+
 	// Convert set of selectors with IPs to update to set of selectors
 	// with identities corresponding to said IPs.
+	if stat != nil {
+		stat.IdentityAllocationTime.Start()
+	}
 	selectorsIdentities, usedIdentities, newlyAllocatedIdentities, err := identitiesForFQDNSelectorIPs(selectorWithIPsToUpdate, d.identityAllocator)
+	if stat != nil {
+		stat.IdentityAllocationTime.End(err == nil)
+	}
 	if err != nil {
 		return &sync.WaitGroup{}, nil, nil, err
 	}
@@ -388,12 +612,27 @@ func (d *Daemon) updateSelectors(ctx context.Context, selectorWithIPsToUpdate ma
 
 // lookupEPByIP returns the endpoint that this IP belongs to
 func (d *Daemon) lookupEPByIP(endpointIP net.IP) (endpoint *endpoint.Endpoint, err error) {
-	e := d.endpointManager.LookupIP(endpointIP)
-	if e == nil {
-		return nil, fmt.Errorf("Cannot find endpoint with IP %s", endpointIP.String())
+	if e := d.endpointManager.LookupIP(endpointIP); e != nil {
+		return e, nil
+	}
+
+	// DNS queries from the node itself are not tied to a regular endpoint.
+	// Attribute them to the host endpoint so that ToFQDN rules in host
+	// policies observe the lookups and the host identity is applied.
+	if option.Config.EnableHostFirewall && isLocalNodeIP(endpointIP) {
+		if e := d.endpointManager.GetHostEndpoint(); e != nil {
+			return e, nil
+		}
 	}
 
-	return e, nil
+	return nil, fmt.Errorf("Cannot find endpoint with IP %s", endpointIP.String())
+}
+
+// isLocalNodeIP reports whether ip is one of the local node's own addresses.
+func isLocalNodeIP(ip net.IP) bool {
+	return ip.Equal(node.GetIPv4()) || ip.Equal(node.GetIPv6()) ||
+		ip.Equal(node.GetInternalIPv4Router()) || ip.Equal(node.GetIPv6Router()) ||
+		ip.IsLoopback()
 }
 
 func (d *Daemon) lookupIPsBySecID(nid identity.NumericIdentity) []string {
@@ -426,21 +665,36 @@ func (d *Daemon) notifyOnDNSMsg(lookupTime time.Time, ep *endpoint.Endpoint, epI
 		if errors.Is(stat.Err, dnsproxy.ErrFailedAcquireSemaphore{}) || errors.Is(stat.Err, dnsproxy.ErrTimedOutAcquireSemaphore{}) {
 			metrics.FQDNSemaphoreRejectedTotal.Add(1)
 		}
+		if errors.Is(stat.Err, dnsproxy.ErrEndpointRateLimited{}) {
+			metrics.FQDNRateLimitRejectedTotal.Add(1)
+		}
+		if errors.As(stat.Err, &dnsproxy.ErrDNS0x20ResponseMismatch{}) {
+			metrics.FQDNResponseMismatchTotal.Add(1)
+		}
 		metrics.ProxyUpstreamTime.WithLabelValues(metricError, metrics.L7DNS, upstream).Observe(
 			stat.UpstreamTime.Total().Seconds())
 		metrics.ProxyUpstreamTime.WithLabelValues(metricError, metrics.L7DNS, processingTime).Observe(
 			stat.ProcessingTime.Total().Seconds())
 		metrics.ProxyUpstreamTime.WithLabelValues(metricError, metrics.L7DNS, semaphoreTime).Observe(
 			stat.SemaphoreAcquireTime.Total().Seconds())
+		metrics.ProxyUpstreamTime.WithLabelValues(metricError, metrics.L7DNS, endpointSemaphoreTime).Observe(
+			stat.EndpointSemaphoreAcquireTime.Total().Seconds())
 		metrics.ProxyUpstreamTime.WithLabelValues(metricError, metrics.L7DNS, policyCheckTime).Observe(
 			stat.PolicyCheckTime.Total().Seconds())
 		metrics.ProxyUpstreamTime.WithLabelValues(metricError, metrics.L7DNS, dataplaneTime).Observe(
 			stat.DataplaneTime.Total().Seconds())
+		metrics.ProxyUpstreamTime.WithLabelValues(metricError, metrics.L7DNS, identityAllocTime).Observe(
+			stat.IdentityAllocationTime.Total().Seconds())
+		metrics.ProxyUpstreamTime.WithLabelValues(metricError, metrics.L7DNS, ipcachePlumbingTime).Observe(
+			stat.IPCachePlumbingTime.Total().Seconds())
 	}
 
 	switch {
 	case stat.IsTimeout():
 		metricError = metricErrorTimeout
+		if ep != nil {
+			ep.DNSStats.CountUpstreamFailure()
+		}
 		endMetric()
 		return nil
 	case stat.Err != nil:
@@ -507,24 +761,87 @@ func (d *Daemon) notifyOnDNSMsg(lookupTime time.Time, ep *endpoint.Endpoint, epI
 	}
 	ep.UpdateProxyStatistics(strings.ToUpper(protocol), serverPort, false, !msg.Response, verdict)
 
+	switch {
+	case stat.Err != nil:
+		ep.DNSStats.CountUpstreamFailure()
+	case !allowed:
+		ep.DNSStats.CountRefused()
+	case msg.Response:
+		ep.DNSStats.ObserveLatency(stat.UpstreamTime.Total())
+	default:
+		ep.DNSStats.CountProxied(qname)
+	}
+
+	dnsRecord := &accesslog.LogRecordDNS{
+		Query:             qname,
+		IPs:               responseIPs,
+		TTL:               TTL,
+		CNAMEs:            CNAMEs,
+		ObservationSource: stat.DataSource,
+		RCode:             rcode,
+		QTypes:            qTypes,
+		AnswerTypes:       recordTypes,
+	}
 	record := logger.NewLogRecord(flowType, false,
 		func(lr *logger.LogRecord) { lr.LogRecord.TransportProtocol = accesslog.TransportProtocol(protoID) },
 		logger.LogTags.Verdict(verdict, reason),
 		logger.LogTags.Addressing(addrInfo),
-		logger.LogTags.DNS(&accesslog.LogRecordDNS{
-			Query:             qname,
-			IPs:               responseIPs,
-			TTL:               TTL,
-			CNAMEs:            CNAMEs,
-			ObservationSource: stat.DataSource,
-			RCode:             rcode,
-			QTypes:            qTypes,
-			AnswerTypes:       recordTypes,
-		}),
+		logger.LogTags.DNS(dnsRecord),
 	)
-	record.Log()
+	if msg.Response {
+		// Emit the record only once the response has been expanded into
+		// policy below, so that it carries the latency of every stage of
+		// the request, including identity allocation and ipcache
+		// plumbing. The record keeps the timestamp it was created with.
+		defer func() {
+			dnsRecord.UpstreamLatencyNs = stat.UpstreamTime.Total().Nanoseconds()
+			dnsRecord.PolicyCheckLatencyNs = stat.PolicyCheckTime.Total().Nanoseconds()
+			dnsRecord.IdentityAllocationLatencyNs = stat.IdentityAllocationTime.Total().Nanoseconds()
+			dnsRecord.IPCachePlumbingLatencyNs = stat.IPCachePlumbingTime.Total().Nanoseconds()
+			record.Log()
+		}()
+	} else {
+		record.Log()
+	}
+
+	if msg.Response {
+		// Emit the lookup on the monitor stream so that FQDN policy can be
+		// debugged in real time via "cilium monitor --type agent" instead of
+		// polling the fqdn/cache API.
+		selectors := make([]string, 0)
+		for selector := range d.dnsNameManager.GetSelectorsMatchingName(fqdndns.FQDN(qname)) {
+			selectors = append(selectors, selector.String())
+		}
+		sort.Strings(selectors)
+		ips := make([]string, 0, len(responseIPs))
+		for _, responseIP := range responseIPs {
+			ips = append(ips, responseIP.String())
+		}
+		d.SendNotification(monitorAPI.DNSLookupMessage(ep.GetID(), qname, ips, TTL, selectors))
+	}
 
 	if msg.Response && msg.Rcode == dns.RcodeSuccess && len(responseIPs) > 0 {
+		if ns := ep.GetK8sNamespace(); ns != "" {
+			if quota, ok := fqdnNamespaceQuota(ns); ok && d.fqdnNamespaceQuotaExceeded(ns, quota, len(responseIPs)) {
+				if fqdnQuotaLogLimiter.Allow() {
+					log.WithFields(logrus.Fields{
+						logfields.K8sNamespace: ns,
+						"quota":                quota,
+					}).Warning("Namespace exceeds its FQDN IPs quota, DNS response IPs are not expanded into ToFQDNs policy")
+				}
+				k8sEvents.Warning(k8sEvents.PodRef(ns, ep.GetK8sPodName()), "FQDNNamespaceQuotaExceeded",
+					fmt.Sprintf("Namespace exceeds its quota of %d FQDN-derived IPs, DNS responses are no longer expanded into ToFQDNs policy", quota))
+				endMetric()
+				return nil
+			}
+		}
+
+		// Serialize with other responses naming the same IPs so that cache
+		// and policy updates are applied in order.
+		shards := d.dnsResponseLocks.shards(ep.GetID(), responseIPs)
+		d.dnsResponseLocks.Lock(shards)
+		defer d.dnsResponseLocks.Unlock(shards)
+
 		stat.DataplaneTime.Start()
 		// This must happen before the NameManager update below, to ensure that
 		// this data is included in the serialized Endpoint object.
@@ -534,7 +851,14 @@ func (d *Daemon) notifyOnDNSMsg(lookupTime time.Time, ep *endpoint.Endpoint, epI
 		// doesn't happen in the case, we play it safe and don't purge the zombie
 		// in case of races.
 		log.WithField(logfields.EndpointID, ep.ID).Debug("Recording DNS lookup in endpoint specific cache")
-		if updated := ep.DNSHistory.Update(lookupTime, qname, ip.MustAddrsFromIPs(responseIPs), int(TTL)); updated {
+		var updated bool
+		if clampedTTL, clamped := d.dnsNameManager.ClampTTL(qname, int(TTL)); clamped {
+			// Per-rule TTL clamps override the global minimum TTL.
+			updated = ep.DNSHistory.UpdateWithExactTTL(lookupTime, qname, ip.MustAddrsFromIPs(responseIPs), clampedTTL)
+		} else {
+			updated = ep.DNSHistory.Update(lookupTime, qname, ip.MustAddrsFromIPs(responseIPs), int(TTL))
+		}
+		if updated {
 			ep.DNSZombies.ForceExpireByNameIP(lookupTime, qname, responseIPs...)
 			ep.SyncEndpointHeaderFile()
 		}
@@ -544,46 +868,421 @@ func (d *Daemon) notifyOnDNSMsg(lookupTime time.Time, ep *endpoint.Endpoint, epI
 			"ips":   responseIPs,
 		}).Debug("Updating DNS name in cache from response to to query")
 
-		updateCtx, updateCancel := context.WithTimeout(context.TODO(), option.Config.FQDNProxyResponseMaxDelay)
-		defer updateCancel()
-		updateStart := time.Now()
+		// Share the lookup with the other agents when the cluster-wide
+		// FQDN cache is enabled.
+		d.publishFQDNMapping(lookupTime, qname, responseIPs, int(TTL))
+
+		failClosed := option.Config.FQDNIdentityAllocationFailureAction == option.FQDNIdentityAllocationFailureActionFailClosed
+
+		// Fail-closed forces the synchronous path so that the response can
+		// still be refused when the policy update fails. The asynchronous
+		// worker runs after the response has already been released.
+		if option.Config.ToFQDNsProxyAsyncPolicyUpdates && !failClosed {
+			update := &dnsPolicyUpdate{
+				lookupTime:   lookupTime,
+				epID:         ep.GetID(),
+				k8sNamespace: ep.GetK8sNamespace(),
+				k8sPodName:   ep.GetK8sPodName(),
+				qname:        qname,
+				responseIPs:  responseIPs,
+				ttl:          int(TTL),
+			}
+			select {
+			case d.dnsPolicyUpdateQueue <- update:
+			default:
+				// The queue is full. Fall back to updating on the response
+				// path rather than dropping the update, which would leave
+				// policy unplumbed for the IPs in this response.
+				if err := d.updateDNSPolicies(lookupTime, ep.GetID(), qname, responseIPs, int(TTL), stat); err != nil {
+					d.auditDNSPolicyUpdateFailure(ep.GetK8sNamespace(), ep.GetK8sPodName(), qname, err)
+				}
+			}
+		} else if err := d.updateDNSPolicies(lookupTime, ep.GetID(), qname, responseIPs, int(TTL), stat); err != nil {
+			if failClosed {
+				metrics.FQDNIdentityAllocationFailures.WithLabelValues(option.FQDNIdentityAllocationFailureActionFailClosed).Inc()
+				stat.Err = fmt.Errorf("Refused DNS response, policy could not be updated for its IPs: %w", err)
+				endMetric()
+				return stat.Err
+			}
+			d.auditDNSPolicyUpdateFailure(ep.GetK8sNamespace(), ep.GetK8sPodName(), qname, err)
+		}
 
-		wg, usedIdentities, newlyAllocatedIdentities, err := d.dnsNameManager.UpdateGenerateDNS(updateCtx, lookupTime, map[string]*fqdn.DNSIPRecords{
-			qname: {
-				IPs: responseIPs,
-				TTL: int(TTL),
-			}})
-		if err != nil {
-			log.WithError(err).Error("error updating internal DNS cache for rule generation")
+		endMetric()
+	} else if msg.Response && (msg.Rcode == dns.RcodeNameError || msg.Rcode == dns.RcodeServerFailure) {
+		// Record the negative response with its SOA minimum TTL so that the
+		// failing names are visible per endpoint via the fqdn/cache API and
+		// repeated failures for the same name need no further processing
+		// until the entry expires.
+		log.WithField(logfields.EndpointID, ep.ID).Debug("Recording negative DNS response in endpoint specific cache")
+		ep.DNSHistory.UpdateNegative(lookupTime, qname, msg.Rcode, int(dnsproxy.ExtractNegativeTTL(msg)))
+	}
+
+	stat.ProcessingTime.End(true)
+	return nil
+}
+
+// updateDNSPolicies expands a DNS response into ToFQDNs policy: it updates
+// the NameManager's cache, triggering CIDR identity allocation and policy
+// recomputation for the selectors matching qname, and waits up to
+// tofqdns-proxy-response-max-delay for the datapath updates to complete. The
+// returned error indicates the policy could not be updated for the response,
+// most commonly because identity allocation failed.
+func (d *Daemon) updateDNSPolicies(lookupTime time.Time, epID uint64, qname string, responseIPs []net.IP, ttl int, stat *dnsproxy.ProxyRequestContext) error {
+	return d.updateDNSPoliciesForRecords(lookupTime, map[string]*fqdn.DNSIPRecords{
+		qname: {
+			IPs: responseIPs,
+			TTL: ttl,
+		}}, stat)
+}
+
+// updateDNSPoliciesForRecords is updateDNSPolicies for a batch of DNS names,
+// expanding all of them with a single selector/identity update and a single
+// endpoint regeneration trigger.
+func (d *Daemon) updateDNSPoliciesForRecords(lookupTime time.Time, updatedDNSIPs map[string]*fqdn.DNSIPRecords, stat *dnsproxy.ProxyRequestContext) error {
+	updateCtx, updateCancel := context.WithTimeout(context.TODO(), option.Config.FQDNProxyResponseMaxDelay)
+	defer updateCancel()
+	updateStart := time.Now()
+
+	if stat != nil {
+		// Attach the request stats so that updateSelectors, reached via
+		// the NameManager, can time the identity allocation stage of
+		// this request.
+		updateCtx = context.WithValue(updateCtx, dnsProxyStatKey{}, stat)
+	}
+
+	wg, usedIdentities, newlyAllocatedIdentities, err := d.dnsNameManager.UpdateGenerateDNS(updateCtx, lookupTime, updatedDNSIPs)
+	if err != nil {
+		log.WithError(err).Error("error updating internal DNS cache for rule generation")
+	}
+
+	updateComplete := make(chan struct{})
+	go func(wg *sync.WaitGroup, done chan struct{}) {
+		wg.Wait()
+		close(updateComplete)
+	}(wg, updateComplete)
+
+	select {
+	case <-updateCtx.Done():
+		log.Error("Timed out waiting for datapath updates of FQDN IP information; returning response")
+		metrics.ProxyDatapathUpdateTimeout.Inc()
+	case <-updateComplete:
+	}
+
+	log.WithFields(logrus.Fields{
+		logfields.Duration: time.Since(updateStart),
+		"names":            len(updatedDNSIPs),
+	}).Debug("Waited for endpoints to regenerate due to a DNS response")
+
+	// Add new identities to the ipcache after the wait for the policy updates above
+	if stat != nil {
+		stat.IPCachePlumbingTime.Start()
+	}
+	d.ipcache.UpsertGeneratedIdentities(newlyAllocatedIdentities, usedIdentities)
+	if stat != nil {
+		stat.IPCachePlumbingTime.End(true)
+	}
+
+	return err
+}
+
+// fqdnGlobalCache wraps the shared store of DNS lookups observed by the
+// agents in the cluster. The store is joined asynchronously once the kvstore
+// is connected.
+type fqdnGlobalCache struct {
+	lock.RWMutex
+	store *store.SharedStore
+}
+
+func (c *fqdnGlobalCache) get() *store.SharedStore {
+	c.RLock()
+	defer c.RUnlock()
+	return c.store
+}
+
+func (c *fqdnGlobalCache) set(s *store.SharedStore) {
+	c.Lock()
+	c.store = s
+	c.Unlock()
+}
+
+// joinFQDNGlobalCache joins the cluster-wide FQDN cache in the kvstore.
+// Mappings observed by other agents are delivered to MappingUpdated.
+func (d *Daemon) joinFQDNGlobalCache() {
+	st, err := store.JoinSharedStore(store.Configuration{
+		Prefix:     fqdnstore.FQDNCacheStorePrefix,
+		KeyCreator: fqdnstore.KeyCreator,
+		Observer:   fqdnstore.NewMappingObserver(nodeTypes.GetName(), d),
+		Context:    d.ctx,
+	})
+	if err != nil {
+		log.WithError(err).Error("Unable to join the cluster-wide FQDN cache, DNS lookups are not shared with other agents")
+		return
+	}
+	d.fqdnGlobalCache.set(st)
+	log.Info("Joined cluster-wide FQDN cache")
+}
+
+// MappingUpdated implements fqdnstore.MappingHandler. It plumbs a DNS lookup
+// observed by another agent into the local name manager, so that ToFQDNs
+// policy covers the IPs before an endpoint on this node has observed the
+// lookup itself.
+func (d *Daemon) MappingUpdated(m *fqdnstore.Mapping) {
+	if err := d.updateDNSPolicies(m.LookupTime, 0, m.Name, m.IPs, m.TTL, nil); err != nil {
+		log.WithError(err).WithField("qname", m.Name).Warning("Cannot update ToFQDNs policy from cluster-wide FQDN cache")
+	}
+}
+
+// publishFQDNMapping shares a DNS lookup observed by the local DNS proxy
+// with the other agents through the kvstore. It is a no-op when the
+// cluster-wide FQDN cache is disabled or not joined yet.
+func (d *Daemon) publishFQDNMapping(lookupTime time.Time, qname string, ips []net.IP, ttl int) {
+	st := d.fqdnGlobalCache.get()
+	if st == nil {
+		return
+	}
+	m := &fqdnstore.Mapping{
+		Node:       nodeTypes.GetName(),
+		Name:       qname,
+		IPs:        ips,
+		TTL:        ttl,
+		LookupTime: lookupTime,
+	}
+	// Publish outside of the DNS response path, the response must not wait
+	// for the kvstore.
+	go func() {
+		if err := st.UpdateLocalKeySync(d.ctx, m); err != nil {
+			log.WithError(err).WithField("qname", qname).Debug("Unable to publish DNS lookup to the cluster-wide FQDN cache")
 		}
+	}()
+}
 
-		updateComplete := make(chan struct{})
-		go func(wg *sync.WaitGroup, done chan struct{}) {
-			wg.Wait()
-			close(updateComplete)
-		}(wg, updateComplete)
+// dnsPolicyUpdateQueueSize bounds the queue of DNS responses pending
+// asynchronous expansion into ToFQDNs policy. When the queue is full further
+// responses are expanded synchronously on the response path.
+const dnsPolicyUpdateQueueSize = 1024
+
+// dnsPolicyUpdate is a DNS response queued for asynchronous expansion into
+// ToFQDNs policy.
+type dnsPolicyUpdate struct {
+	lookupTime   time.Time
+	epID         uint64
+	k8sNamespace string
+	k8sPodName   string
+	qname        string
+	responseIPs  []net.IP
+	ttl          int
+}
+
+// processDNSPolicyUpdates expands queued DNS responses into ToFQDNs policy
+// until the daemon context is cancelled. With a batching window configured,
+// responses arriving within the window are coalesced into a single
+// selector/identity update and a single endpoint regeneration trigger.
+func (d *Daemon) processDNSPolicyUpdates() {
+	window := option.Config.ToFQDNsPolicyUpdateBatchWindow
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case update := <-d.dnsPolicyUpdateQueue:
+			batch := []*dnsPolicyUpdate{update}
+			if window > 0 {
+				batch = d.collectDNSPolicyUpdateBatch(batch, window)
+			}
+			d.processDNSPolicyUpdateBatch(batch)
+		}
+	}
+}
 
+// collectDNSPolicyUpdateBatch accumulates further queued DNS responses for up
+// to the batching window, so that bursts of responses during service startup
+// storms coalesce into one policy update.
+func (d *Daemon) collectDNSPolicyUpdateBatch(batch []*dnsPolicyUpdate, window time.Duration) []*dnsPolicyUpdate {
+	timer := time.NewTimer(window)
+	defer timer.Stop()
+	for len(batch) < dnsPolicyUpdateQueueSize {
 		select {
-		case <-updateCtx.Done():
-			log.Error("Timed out waiting for datapath updates of FQDN IP information; returning response")
-			metrics.ProxyDatapathUpdateTimeout.Inc()
-		case <-updateComplete:
+		case <-d.ctx.Done():
+			return batch
+		case update := <-d.dnsPolicyUpdateQueue:
+			batch = append(batch, update)
+		case <-timer.C:
+			return batch
 		}
+	}
+	return batch
+}
 
-		log.WithFields(logrus.Fields{
-			logfields.Duration:   time.Since(updateStart),
-			logfields.EndpointID: ep.GetID(),
-			"qname":              qname,
-		}).Debug("Waited for endpoints to regenerate due to a DNS response")
+// processDNSPolicyUpdateBatch expands a batch of queued DNS responses into
+// ToFQDNs policy with a single update, coalescing responses for the same
+// name.
+func (d *Daemon) processDNSPolicyUpdateBatch(batch []*dnsPolicyUpdate) {
+	lookupTime := batch[0].lookupTime
+	updatedDNSIPs := make(map[string]*fqdn.DNSIPRecords, len(batch))
+	for _, update := range batch {
+		// The earliest lookup time of the batch applies to all names, erring
+		// towards earlier expiry.
+		if update.lookupTime.Before(lookupTime) {
+			lookupTime = update.lookupTime
+		}
 
-		// Add new identities to the ipcache after the wait for the policy updates above
-		d.ipcache.UpsertGeneratedIdentities(newlyAllocatedIdentities, usedIdentities)
+		records, coalesce := updatedDNSIPs[update.qname]
+		if !coalesce {
+			updatedDNSIPs[update.qname] = &fqdn.DNSIPRecords{
+				IPs: update.responseIPs,
+				TTL: update.ttl,
+			}
+			continue
+		}
 
-		endMetric()
+		if update.ttl > records.TTL {
+			records.TTL = update.ttl
+		}
+	nextIP:
+		for _, responseIP := range update.responseIPs {
+			for _, known := range records.IPs {
+				if known.Equal(responseIP) {
+					continue nextIP
+				}
+			}
+			records.IPs = append(records.IPs, responseIP)
+		}
 	}
 
-	stat.ProcessingTime.End(true)
-	return nil
+	shards := d.dnsResponseLocks.shardsForUpdates(batch)
+	d.dnsResponseLocks.Lock(shards)
+	err := d.updateDNSPoliciesForRecords(lookupTime, updatedDNSIPs, nil)
+	d.dnsResponseLocks.Unlock(shards)
+	if err != nil {
+		for _, update := range batch {
+			d.auditDNSPolicyUpdateFailure(update.k8sNamespace, update.k8sPodName, update.qname, err)
+		}
+	}
+}
+
+// dnsResponseLocks serializes the handling of DNS responses, sharded by
+// response IP so that responses naming the same IPs are processed in order
+// while unrelated responses proceed in parallel. The shard count is set with
+// dnsproxy-lock-count.
+type dnsResponseLocks struct {
+	locks []lock.Mutex
+
+	// shardByEndpoint includes the endpoint ID in the shard hash, so that
+	// endpoints resolving the same names do not contend on the same shard.
+	// Ordering is then only maintained per endpoint, which is where it
+	// matters: each endpoint has its own DNS cache.
+	shardByEndpoint bool
+}
+
+func newDNSResponseLocks(count int, shardByEndpoint bool) *dnsResponseLocks {
+	return &dnsResponseLocks{
+		locks:           make([]lock.Mutex, count),
+		shardByEndpoint: shardByEndpoint,
+	}
+}
+
+// shards returns the distinct shard indices covering the IPs of a DNS
+// response, in ascending order so that acquiring several shards cannot
+// deadlock with a concurrent caller.
+func (l *dnsResponseLocks) shards(epID uint64, responseIPs []net.IP) []int {
+	set := make(map[int]struct{}, len(responseIPs))
+	for _, responseIP := range responseIPs {
+		h := fnv.New32a()
+		if l.shardByEndpoint {
+			var id [8]byte
+			binary.BigEndian.PutUint64(id[:], epID)
+			h.Write(id[:])
+		}
+		h.Write(responseIP)
+		set[int(h.Sum32()%uint32(len(l.locks)))] = struct{}{}
+	}
+	shards := make([]int, 0, len(set))
+	for shard := range set {
+		shards = append(shards, shard)
+	}
+	sort.Ints(shards)
+	return shards
+}
+
+// shardsForUpdates returns the distinct shard indices covering all responses
+// of a batch, in ascending order.
+func (l *dnsResponseLocks) shardsForUpdates(batch []*dnsPolicyUpdate) []int {
+	set := make(map[int]struct{}, len(batch))
+	for _, update := range batch {
+		for _, shard := range l.shards(update.epID, update.responseIPs) {
+			set[shard] = struct{}{}
+		}
+	}
+	shards := make([]int, 0, len(set))
+	for shard := range set {
+		shards = append(shards, shard)
+	}
+	sort.Ints(shards)
+	return shards
+}
+
+// Lock acquires the given shards, as returned by shards.
+func (l *dnsResponseLocks) Lock(shards []int) {
+	for _, shard := range shards {
+		l.locks[shard].Lock()
+	}
+}
+
+// Unlock releases the given shards.
+func (l *dnsResponseLocks) Unlock(shards []int) {
+	for _, shard := range shards {
+		l.locks[shard].Unlock()
+	}
+}
+
+// auditDNSPolicyUpdateFailure records a DNS response released to an endpoint
+// even though expanding it into ToFQDNs policy failed, as happens in the
+// default fail-open mode and on the asynchronous update path. The failure is
+// surfaced as a Kubernetes event on the pod so that it is visible outside of
+// the agent logs.
+func (d *Daemon) auditDNSPolicyUpdateFailure(namespace, podName, qname string, err error) {
+	metrics.FQDNIdentityAllocationFailures.WithLabelValues(option.FQDNIdentityAllocationFailureActionFailOpen).Inc()
+	if namespace != "" {
+		k8sEvents.Warning(k8sEvents.PodRef(namespace, podName), "FQDNPolicyUpdateFailed",
+			fmt.Sprintf("Updating ToFQDNs policy for %q failed, the DNS response was released without policy for its IPs: %s", qname, err))
+	}
+}
+
+// fqdnQuotaLogLimiter bounds the warnings logged for namespaces over their
+// FQDN IPs quota, which would otherwise repeat for every DNS response.
+var fqdnQuotaLogLimiter = logging.NewLimiter(30*time.Second, 1)
+
+// fqdnNamespaceQuota returns the maximum number of IPs that may be tracked
+// in the FQDN caches of the given namespace's endpoints, and whether a quota
+// applies to the namespace at all.
+func fqdnNamespaceQuota(namespace string) (int, bool) {
+	if v, ok := option.Config.ToFQDNsNamespaceIPsQuotaOverrides[namespace]; ok {
+		quota, err := strconv.Atoi(v)
+		if err != nil {
+			log.WithError(err).WithField(logfields.K8sNamespace, namespace).
+				Warning("Invalid FQDN IPs quota override, using the default quota")
+		} else {
+			return quota, quota > 0
+		}
+	}
+	quota := option.Config.ToFQDNsNamespaceIPsQuota
+	return quota, quota > 0
+}
+
+// fqdnNamespaceQuotaExceeded returns true if tracking numIPs additional IPs
+// for the given namespace would exceed quota. Usage is the sum of the IPs
+// currently tracked in the FQDN caches of the namespace's local endpoints,
+// which mirrors the identities and per-endpoint policy map entries the
+// namespace's ToFQDNs selectors can consume on this node.
+func (d *Daemon) fqdnNamespaceQuotaExceeded(namespace string, quota, numIPs int) bool {
+	usage := uint64(numIPs)
+	for _, ep := range d.endpointManager.GetEndpoints() {
+		if ep.GetK8sNamespace() != namespace || ep.DNSHistory == nil {
+			continue
+		}
+		_, ips := ep.DNSHistory.Count()
+		usage += ips
+	}
+	return usage > uint64(quota)
 }
 
 type getFqdnCache struct {
@@ -613,15 +1312,68 @@ func (h *getFqdnCache) Handle(params GetFqdnCacheParams) middleware.Responder {
 		source = *params.Source
 	}
 
-	lookups, err := extractDNSLookups(endpoints, CIDRStr, matchPatternStr, source)
-	switch {
-	case err != nil:
-		return api.Error(GetFqdnCacheBadRequestCode, err)
-	case len(lookups) == 0:
+	if len(params.Labels) > 0 || params.SourceIdentity != nil {
+		filterLabels := labels.NewLabelsFromModel(params.Labels)
+		filtered := make([]*endpoint.Endpoint, 0, len(endpoints))
+		for _, ep := range endpoints {
+			if params.SourceIdentity != nil && int64(ep.GetIdentity()) != *params.SourceIdentity {
+				continue
+			}
+			if len(filterLabels) > 0 && !ep.HasLabels(filterLabels) {
+				continue
+			}
+			filtered = append(filtered, ep)
+		}
+		endpoints = filtered
+	}
+
+	// Pages are split on endpoint boundaries so that the continue token can
+	// simply name the last endpoint included in the page. Sorting by
+	// endpoint ID makes the split stable across requests.
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].ID < endpoints[j].ID
+	})
+	if params.Continue != nil {
+		after, err := strconv.ParseUint(*params.Continue, 10, 16)
+		if err != nil {
+			return api.Error(GetFqdnCacheBadRequestCode,
+				fmt.Errorf("invalid continue token %q", *params.Continue))
+		}
+		for len(endpoints) > 0 && uint64(endpoints[0].ID) <= after {
+			endpoints = endpoints[1:]
+		}
+	}
+
+	var lookups []*models.DNSLookup
+	continueToken := ""
+	if params.Limit != nil && *params.Limit > 0 {
+		for i, ep := range endpoints {
+			epLookups, err := extractDNSLookups(
+				[]*endpoint.Endpoint{ep}, CIDRStr, matchPatternStr, source)
+			if err != nil {
+				return api.Error(GetFqdnCacheBadRequestCode, err)
+			}
+			lookups = append(lookups, epLookups...)
+			if int64(len(lookups)) >= *params.Limit && i < len(endpoints)-1 {
+				continueToken = strconv.FormatUint(uint64(ep.ID), 10)
+				break
+			}
+		}
+	} else {
+		var err error
+		lookups, err = extractDNSLookups(endpoints, CIDRStr, matchPatternStr, source)
+		if err != nil {
+			return api.Error(GetFqdnCacheBadRequestCode, err)
+		}
+	}
+
+	// An empty page of a continued dump is not an error, the client has
+	// simply reached the end of the data.
+	if len(lookups) == 0 && params.Continue == nil {
 		return NewGetFqdnCacheIDNotFound()
 	}
 
-	return NewGetFqdnCacheOK().WithPayload(lookups)
+	return NewGetFqdnCacheOK().WithPayload(lookups).WithXContinue(continueToken)
 }
 
 type deleteFqdnCache struct {
@@ -633,9 +1385,22 @@ func NewDeleteFqdnCacheHandler(d *Daemon) DeleteFqdnCacheHandler {
 }
 
 func (h *deleteFqdnCache) Handle(params DeleteFqdnCacheParams) middleware.Responder {
-	// endpoints we want to modify
 	endpoints := h.daemon.endpointManager.GetEndpoints()
 
+	// endpoints we want to modify
+	endpointsToClear := endpoints
+	if params.EndpointID != nil {
+		ep, err := h.daemon.endpointManager.Lookup(*params.EndpointID)
+		switch {
+		case err != nil:
+			return api.Error(DeleteFqdnCacheBadRequestCode, err)
+		case ep == nil:
+			return api.Error(DeleteFqdnCacheBadRequestCode, fmt.Errorf("Cannot find endpoint %s", *params.EndpointID))
+		default:
+			endpointsToClear = []*endpoint.Endpoint{ep}
+		}
+	}
+
 	matchPatternStr := ""
 	if params.Matchpattern != nil {
 		matchPatternStr = *params.Matchpattern
@@ -644,6 +1409,7 @@ func (h *deleteFqdnCache) Handle(params DeleteFqdnCacheParams) middleware.Respon
 	namesToRegen, err := deleteDNSLookups(
 		h.daemon.dnsNameManager.GetDNSCache(),
 		endpoints,
+		endpointsToClear,
 		time.Now(),
 		matchPatternStr)
 	if err != nil {
@@ -653,6 +1419,43 @@ func (h *deleteFqdnCache) Handle(params DeleteFqdnCacheParams) middleware.Respon
 	return NewDeleteFqdnCacheOK()
 }
 
+type getFqdnZombies struct {
+	daemon *Daemon
+}
+
+func NewGetFqdnZombiesHandler(d *Daemon) GetFqdnZombiesHandler {
+	return &getFqdnZombies{daemon: d}
+}
+
+func (h *getFqdnZombies) Handle(params GetFqdnZombiesParams) middleware.Responder {
+	var zombies []*models.DNSZombieMapping
+	for _, ep := range h.daemon.endpointManager.GetEndpoints() {
+		if ep.DNSZombies == nil {
+			continue
+		}
+		epZombies := ep.DNSZombies.GetModel()
+		for _, zombie := range epZombies {
+			zombie.EndpointID = int64(ep.ID)
+		}
+		zombies = append(zombies, epZombies...)
+	}
+
+	return NewGetFqdnZombiesOK().WithPayload(zombies)
+}
+
+type deleteFqdnZombies struct {
+	daemon *Daemon
+}
+
+func NewDeleteFqdnZombiesHandler(d *Daemon) DeleteFqdnZombiesHandler {
+	return &deleteFqdnZombies{daemon: d}
+}
+
+func (h *deleteFqdnZombies) Handle(params DeleteFqdnZombiesParams) middleware.Responder {
+	dnsGCControllerManager.TriggerController(dnsGCJobName)
+	return NewDeleteFqdnZombiesOK()
+}
+
 type getFqdnCacheID struct {
 	daemon *Daemon
 }
@@ -714,6 +1517,121 @@ func (h *getFqdnNamesHandler) Handle(params GetFqdnNamesParams) middleware.Respo
 	return NewGetFqdnNamesOK().WithPayload(payload)
 }
 
+type getFqdnExplainName struct {
+	daemon *Daemon
+}
+
+func NewGetFqdnExplainNameHandler(d *Daemon) GetFqdnExplainNameHandler {
+	return &getFqdnExplainName{daemon: d}
+}
+
+func (h *getFqdnExplainName) Handle(params GetFqdnExplainNameParams) middleware.Responder {
+	d := h.daemon
+	dnsName := fqdndns.FQDN(params.Name)
+	endpoints := d.endpointManager.GetEndpoints()
+
+	// The name is also a valid matchPattern, so the cached lookups for it can
+	// be collected the same way as for the fqdn/cache API.
+	lookups, err := extractDNSLookups(endpoints, "", params.Name, "")
+	if err != nil {
+		return api.Error(GetFqdnExplainNameBadRequestCode, err)
+	}
+
+	matching := d.dnsNameManager.GetSelectorsMatchingName(dnsName)
+	if len(matching) == 0 && len(lookups) == 0 {
+		return NewGetFqdnExplainNameNotFound()
+	}
+
+	// Identities currently programmed for each selector, keyed by the string
+	// representation the selector cache uses.
+	selectorIdentities := make(map[string][]int64)
+	for _, mapping := range d.policy.GetSelectorCache().GetModel() {
+		selectorIdentities[mapping.Selector] = mapping.Identities
+	}
+
+	report := &models.FQDNExplainReport{
+		Name:    dnsName,
+		Lookups: lookups,
+	}
+	for fqdnSel, fqdnRegex := range matching {
+		selModel := &models.FQDNExplainSelector{
+			Selector:    fqdnSel.String(),
+			RegexString: fqdnRegex.String(),
+			Identities:  selectorIdentities[fqdnSel.String()],
+		}
+
+		// Collect the rules containing this selector and the local endpoints
+		// selected by them.
+		selectedEPs := make(map[uint64]struct{})
+		d.policy.Iterate(func(rule *policyApi.Rule) {
+			if !ruleContainsFQDNSelector(rule, fqdnSel) {
+				return
+			}
+			selModel.Policies = append(selModel.Policies, rule.Labels.String())
+			for _, ep := range endpoints {
+				if _, selected := selectedEPs[ep.GetID()]; selected {
+					continue
+				}
+				secID, err := ep.GetSecurityIdentity()
+				if err != nil || secID == nil {
+					continue
+				}
+				if rule.EndpointSelector.Matches(secID.LabelArray) {
+					selectedEPs[ep.GetID()] = struct{}{}
+				}
+			}
+		})
+		for epID := range selectedEPs {
+			selModel.Endpoints = append(selModel.Endpoints, int64(epID))
+		}
+		sort.Slice(selModel.Endpoints, func(i, j int) bool {
+			return selModel.Endpoints[i] < selModel.Endpoints[j]
+		})
+		sort.Strings(selModel.Policies)
+		report.Selectors = append(report.Selectors, selModel)
+	}
+	sort.Slice(report.Selectors, func(i, j int) bool {
+		return report.Selectors[i].Selector < report.Selectors[j].Selector
+	})
+
+	return NewGetFqdnExplainNameOK().WithPayload(report)
+}
+
+type getFqdnProxyRules struct {
+	daemon *Daemon
+}
+
+func NewGetFqdnProxyRulesHandler(d *Daemon) GetFqdnProxyRulesHandler {
+	return &getFqdnProxyRules{daemon: d}
+}
+
+func (h *getFqdnProxyRules) Handle(params GetFqdnProxyRulesParams) middleware.Responder {
+	hits, misses, compileTime, entries := re.Stats()
+	report := &models.DNSProxyRulesReport{
+		CacheHits:        int64(hits),
+		CacheMisses:      int64(misses),
+		CacheEntries:     int64(entries),
+		CacheCompileTime: compileTime.String(),
+	}
+	if proxy.DefaultDNSProxy != nil {
+		report.Rules = proxy.DefaultDNSProxy.DumpAllowedRules()
+	}
+	return NewGetFqdnProxyRulesOK().WithPayload(report)
+}
+
+// ruleContainsFQDNSelector returns true if any egress rule of rule contains
+// the given ToFQDNs selector.
+func ruleContainsFQDNSelector(rule *policyApi.Rule, sel policyApi.FQDNSelector) bool {
+	for _, egressRule := range rule.Egress {
+		for _, toFQDN := range egressRule.ToFQDNs {
+			if toFQDN == sel {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // extractDNSLookups returns API models.DNSLookup copies of DNS data in each
 // endpoint's DNSHistory. These are filtered by CIDRStr and matchPatternStr if
 // they are non-empty.
@@ -768,6 +1686,26 @@ func extractDNSLookups(endpoints []*endpoint.Endpoint, CIDRStr, matchPatternStr,
 			})
 		}
 
+		// Negative responses carry no IPs and are elided when filtering by
+		// CIDR.
+		if CIDRStr == "" {
+			for _, lookup := range ep.DNSHistory.DumpNegative() {
+				if !nameMatcher(lookup.Name) {
+					continue
+				}
+
+				lookupSourceEntries = append(lookupSourceEntries, &models.DNSLookup{
+					Fqdn:           lookup.Name,
+					LookupTime:     strfmt.DateTime(lookup.LookupTime),
+					TTL:            int64(lookup.TTL),
+					ExpirationTime: strfmt.DateTime(lookup.ExpirationTime),
+					EndpointID:     int64(ep.ID),
+					Source:         dnsSourceLookup,
+					Rcode:          int64(lookup.Rcode),
+				})
+			}
+		}
+
 		for _, delete := range ep.DNSZombies.DumpAlive(cidrMatcher) {
 			for _, name := range delete.Names {
 				if !nameMatcher(name) {
@@ -800,7 +1738,7 @@ func extractDNSLookups(endpoints []*endpoint.Endpoint, CIDRStr, matchPatternStr,
 	return lookups, nil
 }
 
-func deleteDNSLookups(globalCache *fqdn.DNSCache, endpoints []*endpoint.Endpoint, expireLookupsBefore time.Time, matchPatternStr string) (namesToRegen []string, err error) {
+func deleteDNSLookups(globalCache *fqdn.DNSCache, endpoints, endpointsToClear []*endpoint.Endpoint, expireLookupsBefore time.Time, matchPatternStr string) (namesToRegen []string, err error) {
 	var nameMatcher *regexp.Regexp // nil matches all in our implementation
 	if matchPatternStr != "" {
 		nameMatcher, err = matchpattern.ValidateWithoutCache(matchPatternStr)
@@ -810,13 +1748,13 @@ func deleteDNSLookups(globalCache *fqdn.DNSCache, endpoints []*endpoint.Endpoint
 	}
 
 	// Clear any to-delete entries globally
-	// Clear any to-delete entries in each endpoint, then update globally to
-	// insert any entries that now should be in the global cache (because they
-	// provide an IP at the latest expiration time).
+	// Clear any to-delete entries in each endpoint to be cleared, then update
+	// globally from all endpoints to insert any entries that now should be in
+	// the global cache (because they provide an IP at the latest expiration
+	// time, or because another endpoint still has them in its history).
 	namesToRegen = append(namesToRegen, globalCache.ForceExpire(expireLookupsBefore, nameMatcher)...)
-	for _, ep := range endpoints {
+	for _, ep := range endpointsToClear {
 		namesToRegen = append(namesToRegen, ep.DNSHistory.ForceExpire(expireLookupsBefore, nameMatcher)...)
-		globalCache.UpdateFromCache(ep.DNSHistory, nil)
 
 		namesToRegen = append(namesToRegen, ep.DNSZombies.ForceExpire(expireLookupsBefore, nameMatcher)...)
 		activeConnections := fqdn.NewDNSCache(0)
@@ -830,10 +1768,28 @@ func deleteDNSLookups(globalCache *fqdn.DNSCache, endpoints []*endpoint.Endpoint
 		}
 		globalCache.UpdateFromCache(activeConnections, nil)
 	}
+	for _, ep := range endpoints {
+		globalCache.UpdateFromCache(ep.DNSHistory, nil)
+	}
 
 	return namesToRegen, nil
 }
 
+// persistDNSCache atomically writes the global DNS cache to path, so that it
+// can be restored on the next agent start.
+func (d *Daemon) persistDNSCache(path string) error {
+	data, err := d.dnsNameManager.GetDNSCache().MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("unable to serialize FQDN cache: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
 // readPreCache returns a fqdn.DNSCache object created from the json data at
 // preCachePath
 func readPreCache(preCachePath string) (cache *fqdn.DNSCache, err error) {
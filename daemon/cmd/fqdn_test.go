@@ -101,6 +101,16 @@ func (f *FakeRefcountingIdentityAllocator) IdentityReferenceCounter() counter.In
 	return f.identityCount
 }
 
+// LocalIdentityReferences returns the reference count of each currently
+// allocated identity.
+func (f *FakeRefcountingIdentityAllocator) LocalIdentityReferences() map[identity.NumericIdentity]int {
+	refs := make(map[identity.NumericIdentity]int, len(f.identityCount))
+	for id, count := range f.identityCount {
+		refs[identity.NumericIdentity(id)] = count
+	}
+	return refs
+}
+
 func (f *FakeRefcountingIdentityAllocator) Close() {
 }
 func (f *FakeRefcountingIdentityAllocator) InitIdentityAllocator(versioned.Interface, k8sCache.Store) <-chan struct{} {
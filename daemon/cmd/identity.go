@@ -96,6 +96,7 @@ type CachingIdentityAllocator interface {
 
 	InitIdentityAllocator(versioned.Interface, k8sCache.Store) <-chan struct{}
 	WatchRemoteIdentities(kvstore.BackendOperations) (*allocator.RemoteCache, error)
+	LocalIdentityReferences() map[identity.NumericIdentity]int
 	Close()
 }
 
@@ -49,25 +49,30 @@ func initMetrics() <-chan error {
 }
 
 type bootstrapStatistics struct {
-	overall         spanstat.SpanStat
-	earlyInit       spanstat.SpanStat
-	k8sInit         spanstat.SpanStat
-	restore         spanstat.SpanStat
-	healthCheck     spanstat.SpanStat
-	ingressIPAM     spanstat.SpanStat
-	initAPI         spanstat.SpanStat
-	initDaemon      spanstat.SpanStat
-	cleanup         spanstat.SpanStat
-	bpfBase         spanstat.SpanStat
-	clusterMeshInit spanstat.SpanStat
-	ipam            spanstat.SpanStat
-	daemonInit      spanstat.SpanStat
-	mapsInit        spanstat.SpanStat
-	workloadsInit   spanstat.SpanStat
-	proxyStart      spanstat.SpanStat
-	fqdn            spanstat.SpanStat
-	enableConntrack spanstat.SpanStat
-	kvstore         spanstat.SpanStat
+	overall   spanstat.SpanStat
+	earlyInit spanstat.SpanStat
+	k8sInit   spanstat.SpanStat
+	restore   spanstat.SpanStat
+	// restoreValidation and restoreRegeneration time the two phases of the
+	// restore: validating the endpoints read from disk and regenerating
+	// their datapath programs.
+	restoreValidation   spanstat.SpanStat
+	restoreRegeneration spanstat.SpanStat
+	healthCheck         spanstat.SpanStat
+	ingressIPAM         spanstat.SpanStat
+	initAPI             spanstat.SpanStat
+	initDaemon          spanstat.SpanStat
+	cleanup             spanstat.SpanStat
+	bpfBase             spanstat.SpanStat
+	clusterMeshInit     spanstat.SpanStat
+	ipam                spanstat.SpanStat
+	daemonInit          spanstat.SpanStat
+	mapsInit            spanstat.SpanStat
+	workloadsInit       spanstat.SpanStat
+	proxyStart          spanstat.SpanStat
+	fqdn                spanstat.SpanStat
+	enableConntrack     spanstat.SpanStat
+	kvstore             spanstat.SpanStat
 }
 
 func (b *bootstrapStatistics) updateMetrics() {
@@ -83,25 +88,27 @@ func (b *bootstrapStatistics) updateMetrics() {
 
 func (b *bootstrapStatistics) getMap() map[string]*spanstat.SpanStat {
 	return map[string]*spanstat.SpanStat{
-		"overall":         &b.overall,
-		"earlyInit":       &b.earlyInit,
-		"k8sInit":         &b.k8sInit,
-		"restore":         &b.restore,
-		"healthCheck":     &b.healthCheck,
-		"ingressIPAM":     &b.ingressIPAM,
-		"initAPI":         &b.initAPI,
-		"initDaemon":      &b.initDaemon,
-		"cleanup":         &b.cleanup,
-		"bpfBase":         &b.bpfBase,
-		"clusterMeshInit": &b.clusterMeshInit,
-		"ipam":            &b.ipam,
-		"daemonInit":      &b.daemonInit,
-		"mapsInit":        &b.mapsInit,
-		"workloadsInit":   &b.workloadsInit,
-		"proxyStart":      &b.proxyStart,
-		"fqdn":            &b.fqdn,
-		"enableConntrack": &b.enableConntrack,
-		"kvstore":         &b.kvstore,
+		"overall":             &b.overall,
+		"earlyInit":           &b.earlyInit,
+		"k8sInit":             &b.k8sInit,
+		"restore":             &b.restore,
+		"restoreValidation":   &b.restoreValidation,
+		"restoreRegeneration": &b.restoreRegeneration,
+		"healthCheck":         &b.healthCheck,
+		"ingressIPAM":         &b.ingressIPAM,
+		"initAPI":             &b.initAPI,
+		"initDaemon":          &b.initDaemon,
+		"cleanup":             &b.cleanup,
+		"bpfBase":             &b.bpfBase,
+		"clusterMeshInit":     &b.clusterMeshInit,
+		"ipam":                &b.ipam,
+		"daemonInit":          &b.daemonInit,
+		"mapsInit":            &b.mapsInit,
+		"workloadsInit":       &b.workloadsInit,
+		"proxyStart":          &b.proxyStart,
+		"fqdn":                &b.fqdn,
+		"enableConntrack":     &b.enableConntrack,
+		"kvstore":             &b.kvstore,
 	}
 }
 
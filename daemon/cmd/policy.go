@@ -28,6 +28,7 @@ import (
 	"github.com/cilium/cilium/pkg/identity/cache"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/dropcache"
 	bpfIPCache "github.com/cilium/cilium/pkg/maps/ipcache"
 	"github.com/cilium/cilium/pkg/metrics"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
@@ -69,9 +70,22 @@ func (d *Daemon) initPolicy(epMgr *endpointmanager.EndpointManager) error {
 // TriggerPolicyUpdates triggers policy updates by deferring to the
 // policy.Updater to handle them.
 func (d *Daemon) TriggerPolicyUpdates(force bool, reason string) {
+	d.invalidateDropVerdictCache()
 	d.policyUpdater.TriggerPolicyUpdates(force, reason)
 }
 
+// invalidateDropVerdictCache flushes the datapath drop verdict cache. It must
+// be called whenever the policy maps change, as any cached verdict may no
+// longer reflect the configured policy.
+func (d *Daemon) invalidateDropVerdictCache() {
+	if option.Config.DropVerdictCacheTTL == 0 {
+		return
+	}
+	if err := dropcache.Flush(); err != nil {
+		log.WithError(err).Warning("Unable to flush drop verdict cache")
+	}
+}
+
 // UpdateIdentities informs the policy package of all identity changes
 // and also triggers policy updates.
 //
@@ -460,6 +474,10 @@ func (r *PolicyReactionEvent) Handle(res chan interface{}) {
 func (d *Daemon) reactToRuleUpdates(epsToBumpRevision, epsToRegen *policy.EndpointSet, rev uint64, upsertIdentities map[netip.Prefix]*identity.Identity, releasePrefixes []netip.Prefix) {
 	var enqueueWaitGroup sync.WaitGroup
 
+	// The rule update invalidates any drop verdict the datapath may have
+	// cached.
+	d.invalidateDropVerdictCache()
+
 	// Release CIDR identities before regenerations have been started, if any. This makes sure
 	// the stale identities are not used in policy map classifications after we regenerate the
 	// endpoints below.
@@ -4,6 +4,13 @@
 package cmd
 
 import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/cilium/cilium/api/v1/models"
+	restapi "github.com/cilium/cilium/api/v1/server/restapi/daemon"
+	"github.com/cilium/cilium/pkg/api"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	"github.com/cilium/cilium/pkg/proxy/logger"
 )
@@ -12,3 +19,37 @@ import (
 func (d *Daemon) NewProxyLogRecord(l *logger.LogRecord) error {
 	return d.monitorAgent.SendEvent(monitorAPI.MessageTypeAccessLog, l.LogRecord)
 }
+
+type getProxyXds struct {
+	daemon *Daemon
+}
+
+// NewGetProxyXdsHandler returns the xDS resource dump endpoint handler for the agent
+func NewGetProxyXdsHandler(d *Daemon) restapi.GetProxyXdsHandler {
+	return &getProxyXds{daemon: d}
+}
+
+func (h *getProxyXds) Handle(params restapi.GetProxyXdsParams) middleware.Responder {
+	if h.daemon.l7Proxy == nil {
+		return api.Error(restapi.GetProxyXdsFailureCode, fmt.Errorf("L7 proxy is disabled"))
+	}
+
+	groups, err := h.daemon.l7Proxy.GetXDSResourceGroups(params.HTTPRequest.Context())
+	if err != nil {
+		return api.Error(restapi.GetProxyXdsFailureCode, err)
+	}
+
+	payload := make([]*models.XDSResourceGroup, 0, len(groups))
+	for _, group := range groups {
+		resources := make([]interface{}, 0, len(group.Resources))
+		for _, resource := range group.Resources {
+			resources = append(resources, resource)
+		}
+		payload = append(payload, &models.XDSResourceGroup{
+			TypeURL:   group.TypeURL,
+			Version:   int64(group.Version),
+			Resources: resources,
+		})
+	}
+	return restapi.NewGetProxyXdsOK().WithPayload(payload)
+}
@@ -79,6 +79,12 @@ func runApp(cmd *cobra.Command, args []string) {
 		os.Exit(0)
 	}
 
+	// Validate the configuration and exit before touching the system when
+	// running in preflight mode.
+	if v, _ := cmd.Flags().GetBool(option.ValidateConfig); v {
+		os.Exit(validateConfig())
+	}
+
 	// Initialize working directories and validate the configuration.
 	initEnv()
 
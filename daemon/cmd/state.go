@@ -13,6 +13,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sync/semaphore"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/cilium/cilium/pkg/controller"
@@ -160,6 +161,9 @@ func (d *Daemon) restoreOldEndpoints(state *endpointRestoreState, clean bool) er
 
 	log.Info("Restoring endpoints...")
 
+	bootstrapStats.restoreValidation.Start()
+	defer bootstrapStats.restoreValidation.End(true)
+
 	var (
 		existingEndpoints map[string]*lxcmap.EndpointInfo
 		err               error
@@ -280,18 +284,48 @@ func (d *Daemon) regenerateRestoredEndpoints(state *endpointRestoreState) (resto
 		}
 	}
 
-	for _, ep := range state.restored {
-		log.WithField(logfields.EndpointID, ep.ID).Info("Successfully restored endpoint. Scheduling regeneration")
-		go func(ep *endpoint.Endpoint, epRegenerated chan<- bool) {
-			if err := ep.RegenerateAfterRestore(); err != nil {
-				log.WithField(logfields.EndpointID, ep.ID).WithError(err).Debug("error regenerating during restore")
-				epRegenerated <- false
-				return
-			}
-			epRegenerated <- true
-		}(ep, epRegenerated)
+	// Regenerate the host endpoint before all others: the datapath programs
+	// of the remaining endpoints depend on the host datapath being in place.
+	restored := state.restored
+	for i, ep := range restored {
+		if ep.IsHost() {
+			restored[0], restored[i] = restored[i], restored[0]
+			break
+		}
+	}
+
+	// Bound the number of endpoints being restored in parallel. The heavy
+	// part of the regeneration is additionally subject to the endpoint
+	// build queue, but the restore path also performs identity resolution
+	// and endpoint exposure which should not run for all endpoints at once.
+	bootstrapStats.restoreRegeneration.Start()
+	regenSem := semaphore.NewWeighted(int64(numWorkerThreads()))
+	regenerate := func(ep *endpoint.Endpoint) {
+		if err := regenSem.Acquire(d.ctx, 1); err != nil {
+			epRegenerated <- false
+			return
+		}
+		defer regenSem.Release(1)
+		if err := ep.RegenerateAfterRestore(); err != nil {
+			log.WithField(logfields.EndpointID, ep.ID).WithError(err).Debug("error regenerating during restore")
+			epRegenerated <- false
+			return
+		}
+		epRegenerated <- true
 	}
 
+	go func() {
+		for i, ep := range restored {
+			log.WithField(logfields.EndpointID, ep.ID).Info("Successfully restored endpoint. Scheduling regeneration")
+			if i == 0 && ep.IsHost() {
+				// Wait for the host endpoint before scheduling the rest.
+				regenerate(ep)
+				continue
+			}
+			go regenerate(ep)
+		}
+	}()
+
 	var endpointCleanupCompleted sync.WaitGroup
 	for _, ep := range state.toClean {
 		endpointCleanupCompleted.Add(1)
@@ -324,6 +358,7 @@ func (d *Daemon) regenerateRestoredEndpoints(state *endpointRestoreState) (resto
 			}
 		}
 		close(epRegenerated)
+		bootstrapStats.restoreRegeneration.End(regenerated == total)
 
 		log.WithFields(logrus.Fields{
 			"regenerated": regenerated,
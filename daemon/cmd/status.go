@@ -6,6 +6,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -40,6 +41,7 @@ import (
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/rand"
 	"github.com/cilium/cilium/pkg/status"
+	"github.com/cilium/cilium/pkg/sysctl"
 	"github.com/cilium/cilium/pkg/version"
 )
 
@@ -103,9 +105,19 @@ func (d *Daemon) getK8sStatus() *models.K8sStatus {
 		version = k8sVersionCache.update(k8sVersion)
 	}
 
+	msg := version
+	if ips := d.k8sWatcher.KubeAPIServerIPs(); len(ips) > 0 {
+		mapped := make([]string, 0, len(ips))
+		for ip, updated := range ips {
+			mapped = append(mapped, fmt.Sprintf("%s (age %s)", ip.Addr(), time.Since(updated).Round(time.Second)))
+		}
+		sort.Strings(mapped)
+		msg = fmt.Sprintf("%s [kube-apiserver IPs: %s]", version, strings.Join(mapped, ", "))
+	}
+
 	k8sStatus := &models.K8sStatus{
 		State:          models.StatusStateOk,
-		Msg:            version,
+		Msg:            msg,
 		K8sAPIVersions: d.k8sWatcher.GetAPIGroups(),
 	}
 
@@ -692,6 +704,18 @@ func (d *Daemon) getStatus(brief bool) models.StatusResponse {
 			State: models.StatusStateFailure,
 			Msg:   fmt.Sprintf("%s    %s", ciliumVer, msg),
 		}
+	case d.datapathConfigDrifted():
+		msg := "Datapath configuration drift detected, datapath reload required"
+		sr.Cilium = &models.Status{
+			State: models.StatusStateWarning,
+			Msg:   fmt.Sprintf("%s    %s", ciliumVer, msg),
+		}
+	case len(sysctl.Drifted()) > 0:
+		msg := fmt.Sprintf("Sysctl settings reverted externally and re-applied: %s", strings.Join(sysctl.Drifted(), ", "))
+		sr.Cilium = &models.Status{
+			State: models.StatusStateWarning,
+			Msg:   fmt.Sprintf("%s    %s", ciliumVer, msg),
+		}
 	default:
 		sr.Cilium = &models.Status{
 			State: models.StatusStateOk,
@@ -982,7 +1006,10 @@ func (d *Daemon) startStatusCollector(cleaner *daemonCleanup) {
 					}, nil
 				case option.Config.EnableWireguard:
 					var msg string
-					status, err := d.datapath.WireguardAgent().Status(false)
+					// Include the peers so that API consumers can tell
+					// per remote node whether traffic is currently being
+					// encrypted (handshake age, bytes transferred).
+					status, err := d.datapath.WireguardAgent().Status(true)
 					if err != nil {
 						msg = err.Error()
 					}
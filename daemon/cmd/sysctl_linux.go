@@ -9,16 +9,13 @@ import (
 )
 
 func enableIPForwarding() error {
-	if err := sysctl.Enable("net.ipv4.ip_forward"); err != nil {
-		return err
-	}
-	if err := sysctl.Enable("net.ipv4.conf.all.forwarding"); err != nil {
-		return err
+	sysSettings := []sysctl.Setting{
+		{Name: "net.ipv4.ip_forward", Val: "1", IgnoreErr: false},
+		{Name: "net.ipv4.conf.all.forwarding", Val: "1", IgnoreErr: false},
 	}
 	if option.Config.EnableIPv6 {
-		if err := sysctl.Enable("net.ipv6.conf.all.forwarding"); err != nil {
-			return err
-		}
+		sysSettings = append(sysSettings,
+			sysctl.Setting{Name: "net.ipv6.conf.all.forwarding", Val: "1", IgnoreErr: false})
 	}
-	return nil
+	return sysctl.RegisterSettings(sysSettings)
 }
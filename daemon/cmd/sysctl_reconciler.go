@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/sysctl"
+)
+
+const (
+	// sysctlReconcilerControllerName is the name of the controller
+	// periodically re-applying the sysctl settings required by Cilium when
+	// they are reverted externally.
+	sysctlReconcilerControllerName = "sysctl-reconciler"
+
+	// sysctlReconcileInterval is the interval in which the registered
+	// sysctl settings are checked for external reversion.
+	sysctlReconcileInterval = 1 * time.Minute
+)
+
+// startSysctlReconciler starts the controller periodically re-applying the
+// sysctl settings registered during datapath initialization. The controller
+// backoff takes care of retrying with increasing intervals when re-applying
+// fails persistently.
+func (d *Daemon) startSysctlReconciler() {
+	controller.NewManager().UpdateController(sysctlReconcilerControllerName,
+		controller.ControllerParams{
+			DoFunc: func(ctx context.Context) error {
+				return sysctl.ReapplyRevertedSettings()
+			},
+			RunInterval: sysctlReconcileInterval,
+			Context:     d.ctx,
+		})
+}
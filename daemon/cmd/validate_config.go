@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cilium/cilium/pkg/datapath/linux/probes"
+	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/kvstore"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// configCheckOK indicates that the check passed.
+	configCheckOK = "ok"
+
+	// configCheckFailed indicates that the check failed.
+	configCheckFailed = "failed"
+
+	// configCheckSkipped indicates that the check does not apply to the
+	// given configuration.
+	configCheckSkipped = "skipped"
+
+	// kvstoreProbeTimeout bounds the kvstore connectivity check so that an
+	// unreachable kvstore does not stall the validation indefinitely.
+	kvstoreProbeTimeout = 30 * time.Second
+)
+
+// configCheck is the result of a single validation step.
+type configCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// configValidationReport is the structured report printed by
+// --validate-config.
+type configValidationReport struct {
+	Valid  bool          `json:"valid"`
+	Checks []configCheck `json:"checks"`
+}
+
+func (r *configValidationReport) record(name string, err error) {
+	check := configCheck{Name: name, Status: configCheckOK}
+	if err != nil {
+		check.Status = configCheckFailed
+		check.Message = err.Error()
+		r.Valid = false
+	}
+	r.Checks = append(r.Checks, check)
+}
+
+func (r *configValidationReport) skip(name, msg string) {
+	r.Checks = append(r.Checks, configCheck{Name: name, Status: configCheckSkipped, Message: msg})
+}
+
+// validateConfig runs the agent configuration through the same validation
+// the agent performs on startup, resolves the hive dependency graph, probes
+// the kernel configuration and checks connectivity to the configured
+// Kubernetes apiserver and kvstore, without starting the agent or touching
+// any datapath state. The report is printed to stdout as JSON and the
+// returned exit code is zero only if all applicable checks passed.
+func validateConfig() int {
+	report := configValidationReport{Valid: true}
+
+	report.record("daemon-configuration", option.Config.Validate(Vp))
+	report.record("hive-configuration", agentHive.Populate())
+	report.record("kernel-configuration", probes.NewProbeManager().SystemConfigProbes())
+
+	validateK8sConnectivity(&report)
+	validateKVStoreConnectivity(&report)
+
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to marshal validation report: %s\n", err)
+		return 1
+	}
+	fmt.Println(string(out))
+
+	if !report.Valid {
+		return 1
+	}
+	return 0
+}
+
+// validateK8sConnectivity establishes a connection to the Kubernetes
+// apiserver with the configured client options and verifies that the
+// minimal supported version is met.
+func validateK8sConnectivity(report *configValidationReport) {
+	const name = "kubernetes-connectivity"
+
+	cfg := k8sClient.Config{
+		K8sAPIServer:          Vp.GetString(option.K8sAPIServer),
+		K8sKubeConfigPath:     Vp.GetString(option.K8sKubeConfigPath),
+		K8sClientQPS:          float32(Vp.GetFloat64(option.K8sClientQPSLimit)),
+		K8sClientBurst:        Vp.GetInt(option.K8sClientBurst),
+		K8sHeartbeatTimeout:   Vp.GetDuration(option.K8sHeartbeatTimeout),
+		EnableK8sAPIDiscovery: Vp.GetBool(option.K8sEnableAPIDiscovery),
+	}
+
+	clientset, err := k8sClient.NewStandaloneClientset(cfg)
+	switch {
+	case err != nil:
+		report.record(name, err)
+	case !clientset.IsEnabled():
+		report.skip(name, "Kubernetes support is not enabled")
+	default:
+		report.record(name, nil)
+	}
+}
+
+// validateKVStoreConnectivity sets up the configured kvstore backend and
+// queries its status.
+func validateKVStoreConnectivity(report *configValidationReport) {
+	const name = "kvstore-connectivity"
+
+	if option.Config.KVStore == "" {
+		report.skip(name, "no kvstore configured")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), kvstoreProbeTimeout)
+	defer cancel()
+
+	if err := kvstore.Setup(ctx, option.Config.KVStore, option.Config.KVStoreOpt, nil); err != nil {
+		report.record(name, err)
+		return
+	}
+
+	_, err := kvstore.Client().Status()
+	report.record(name, err)
+}
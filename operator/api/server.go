@@ -37,6 +37,7 @@ type Server struct {
 	allSystemsGo   <-chan struct{}
 
 	checkStatus func() error
+	isLeader    func() bool
 
 	// This is the /healthz handler outside of the open-api spec.
 	healthzHandler *getHealthz
@@ -70,6 +71,7 @@ func NewServer(shutdownSignal <-chan struct{}, allSystemsGo <-chan struct{}, add
 		allSystemsGo:   allSystemsGo,
 
 		checkStatus: noOpFunc,
+		isLeader:    func() bool { return false },
 	}
 
 	server.healthzHandler = &getHealthz{Server: server}
@@ -90,6 +92,13 @@ func (s *Server) WithStatusCheckFunc(f func() error) *Server {
 	return s
 }
 
+// WithLeaderCheckFunc returns the server configuring the function used to
+// report whether this operator instance holds the leader election lease.
+func (s *Server) WithLeaderCheckFunc(f func() bool) *Server {
+	s.isLeader = f
+	return s
+}
+
 // StartServer starts the HTTP listeners for the apiserver.
 func (s *Server) StartServer() error {
 	errs := make(chan error, 1)
@@ -115,6 +124,8 @@ func (s *Server) StartServer() error {
 			resp := s.healthzHandler.Handle(operator.GetHealthzParams{})
 			resp.WriteResponse(rw, runtime.TextProducer())
 		})
+		// Detailed per-controller status, also outside of the open-api spec.
+		mux.HandleFunc("/status", s.handleStatus)
 
 		srv := &http.Server{
 			Addr:    addr,
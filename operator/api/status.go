@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// ModuleStatus describes the state of an operator subsystem that is not
+// driven by the controller manager, e.g. the CiliumEndpointSlice controller
+// and its work queue.
+type ModuleStatus struct {
+	// Name identifies the subsystem.
+	Name string `json:"name"`
+
+	// Msg optionally describes the current state of the subsystem in
+	// human readable form.
+	Msg string `json:"msg,omitempty"`
+
+	// WorkQueueDepth is the number of items currently waiting in the
+	// subsystem's work queue, or zero if it does not maintain one.
+	WorkQueueDepth int `json:"work-queue-depth"`
+}
+
+// StatusResponse is the payload served on the /status endpoint of the
+// operator apiserver.
+type StatusResponse struct {
+	// Leader is true if this operator instance currently holds the leader
+	// election lease.
+	Leader bool `json:"leader"`
+
+	// Controllers holds the status of all controllers run by this operator
+	// instance, including success and failure counts and the last error
+	// observed. This covers among others IPAM node synchronization and
+	// identity garbage collection.
+	Controllers models.ControllerStatuses `json:"controllers"`
+
+	// Modules holds the status of the subsystems registered via
+	// RegisterStatusProvider.
+	Modules []ModuleStatus `json:"modules,omitempty"`
+}
+
+var (
+	statusProvidersMutex lock.RWMutex
+	statusProviders      = map[string]func() ModuleStatus{}
+)
+
+// RegisterStatusProvider registers fn to be invoked whenever the operator
+// status is queried. Registering a provider again under the same name
+// replaces the earlier provider.
+func RegisterStatusProvider(name string, fn func() ModuleStatus) {
+	statusProvidersMutex.Lock()
+	defer statusProvidersMutex.Unlock()
+	statusProviders[name] = fn
+}
+
+func (s *Server) getStatusResponse() StatusResponse {
+	resp := StatusResponse{
+		Leader:      s.isLeader(),
+		Controllers: controller.GetGlobalStatus(),
+	}
+
+	statusProvidersMutex.RLock()
+	for _, fn := range statusProviders {
+		resp.Modules = append(resp.Modules, fn())
+	}
+	statusProvidersMutex.RUnlock()
+
+	sort.Slice(resp.Modules, func(i, j int) bool {
+		return resp.Modules[i].Name < resp.Modules[j].Name
+	})
+
+	return resp
+}
+
+// handleStatus serves the operator status as JSON. Like /healthz, this
+// handler lives outside of the open-api spec.
+func (s *Server) handleStatus(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(s.getStatusResponse()); err != nil {
+		log.WithError(err).Error("Failed to encode operator status")
+	}
+}
@@ -183,6 +183,9 @@ func init() {
 	flags.Duration(operatorOption.IdentityHeartbeatTimeout, 2*defaults.KVstoreLeaseTTL, "Timeout after which identity expires on lack of heartbeat")
 	option.BindEnv(Vp, operatorOption.IdentityHeartbeatTimeout)
 
+	flags.Duration(operatorOption.IdentityUsageReportInterval, 10*time.Minute, "Interval in which the identity usage report is computed and exported via metrics (0 to disable)")
+	option.BindEnv(Vp, operatorOption.IdentityUsageReportInterval)
+
 	flags.Bool(option.EnableIPv4Name, defaults.EnableIPv4, "Enable IPv4 support")
 	option.BindEnv(Vp, option.EnableIPv4Name)
 
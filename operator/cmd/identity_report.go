@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/operator/metrics"
+	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/identity"
+	k8sConst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// identityChurnWindow is the sliding window over which identity churn is
+// aggregated for the identity usage report.
+const identityChurnWindow = 24 * time.Hour
+
+// identity types reported by the identity usage report
+const (
+	identityTypePod      = "pod"
+	identityTypeCIDR     = "cidr"
+	identityTypeReserved = "reserved"
+	identityTypeOther    = "other"
+)
+
+// identityChurnTracker tracks identity creation and deletion timestamps over
+// a sliding window to compute the churn rate for the identity usage report.
+type identityChurnTracker struct {
+	mutex     lock.Mutex
+	creations []time.Time
+	deletions []time.Time
+}
+
+var identityChurn identityChurnTracker
+
+func (t *identityChurnTracker) recordCreation() {
+	t.mutex.Lock()
+	t.creations = append(t.creations, time.Now())
+	t.mutex.Unlock()
+}
+
+func (t *identityChurnTracker) recordDeletion() {
+	t.mutex.Lock()
+	t.deletions = append(t.deletions, time.Now())
+	t.mutex.Unlock()
+}
+
+// counts prunes entries which have fallen out of the churn window and returns
+// the number of creations and deletions within the window.
+func (t *identityChurnTracker) counts(now time.Time) (created, deleted int) {
+	deadline := now.Add(-identityChurnWindow)
+	prune := func(ts []time.Time) []time.Time {
+		for len(ts) > 0 && ts[0].Before(deadline) {
+			ts = ts[1:]
+		}
+		return ts
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.creations = prune(t.creations)
+	t.deletions = prune(t.deletions)
+	return len(t.creations), len(t.deletions)
+}
+
+// identityType classifies an identity for the usage report based on the
+// sources of its security labels.
+func identityType(ciliumIdentity *v2.CiliumIdentity) string {
+	reservedOnly := len(ciliumIdentity.SecurityLabels) > 0
+	for key := range ciliumIdentity.SecurityLabels {
+		switch {
+		case strings.HasPrefix(key, labels.LabelSourceCIDR+":"):
+			return identityTypeCIDR
+		case strings.HasPrefix(key, labels.LabelSourceK8s+":"+k8sConst.PodNamespaceLabel):
+			return identityTypePod
+		case !strings.HasPrefix(key, labels.LabelSourceReserved+":"):
+			reservedOnly = false
+		}
+	}
+	if reservedOnly {
+		return identityTypeReserved
+	}
+	return identityTypeOther
+}
+
+// identityUsageReport computes a report of the identity space utilization
+// from the identity store and exports it via metrics and the operator log.
+func identityUsageReport(ctx context.Context) {
+	if identityStore == nil {
+		log.Debug("Identity store cache is not ready yet")
+		return
+	}
+
+	byNamespace := map[string]int{}
+	byType := map[string]int{}
+	total := 0
+
+	nsLabelKey := labels.LabelSourceK8s + ":" + k8sConst.PodNamespaceLabel
+	for _, identityObject := range identityStore.List() {
+		ciliumIdentity, ok := identityObject.(*v2.CiliumIdentity)
+		if !ok {
+			continue
+		}
+		total++
+		byType[identityType(ciliumIdentity)]++
+		if ns, ok := ciliumIdentity.SecurityLabels[nsLabelKey]; ok {
+			byNamespace[ns]++
+		}
+	}
+
+	created, deleted := identityChurn.counts(time.Now())
+
+	// Project when the cluster-scoped identity space runs out, assuming the
+	// net allocation rate observed over the churn window stays constant.
+	capacity := int(identity.MaximumAllocationIdentity - identity.MinimalAllocationIdentity + 1)
+	remaining := capacity - total
+	if remaining < 0 {
+		remaining = 0
+	}
+	var exhaustion time.Duration
+	if netRate := float64(created-deleted) / identityChurnWindow.Seconds(); netRate > 0 {
+		exhaustion = time.Duration(float64(remaining)/netRate) * time.Second
+	}
+
+	metrics.IdentityCount.Set(float64(total))
+	metrics.IdentityNamespaceCount.Reset()
+	for ns, count := range byNamespace {
+		metrics.IdentityNamespaceCount.WithLabelValues(ns).Set(float64(count))
+	}
+	metrics.IdentityTypeCount.Reset()
+	for typ, count := range byType {
+		metrics.IdentityTypeCount.WithLabelValues(typ).Set(float64(count))
+	}
+	metrics.IdentityChurn.WithLabelValues(metrics.LabelValueIdentityCreate).Set(float64(created))
+	metrics.IdentityChurn.WithLabelValues(metrics.LabelValueIdentityDelete).Set(float64(deleted))
+	metrics.IdentityProjectedExhaustionSeconds.Set(exhaustion.Seconds())
+
+	scopedLog := log.WithFields(logrus.Fields{
+		"identities": total,
+		"capacity":   capacity,
+		"created24h": created,
+		"deleted24h": deleted,
+		"podCount":   byType[identityTypePod],
+		"cidrCount":  byType[identityTypeCIDR],
+		"otherCount": byType[identityTypeReserved] + byType[identityTypeOther],
+		"namespaces": len(byNamespace),
+	})
+	if exhaustion != 0 {
+		scopedLog = scopedLog.WithField("projectedExhaustion", exhaustion)
+	}
+	scopedLog.Info("Identity usage report")
+}
+
+// startIdentityUsageReport periodically exports a report of the identity
+// space utilization via operator metrics.
+func startIdentityUsageReport() {
+	identity.InitMinMaxIdentityAllocation(option.Config)
+
+	log.WithField("interval", operatorOption.Config.IdentityUsageReportInterval).
+		Info("Starting identity usage reporting")
+
+	controller.NewManager().UpdateController("identity-usage-report",
+		controller.ControllerParams{
+			RunInterval: operatorOption.Config.IdentityUsageReportInterval,
+			DoFunc: func(ctx context.Context) error {
+				identityUsageReport(ctx)
+				return ctx.Err()
+			},
+		})
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+)
+
+func Test_identityType(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   string
+	}{
+		{
+			name: "pod identity",
+			labels: map[string]string{
+				"k8s:io.kubernetes.pod.namespace": "default",
+				"k8s:app":                         "foo",
+			},
+			want: identityTypePod,
+		},
+		{
+			name: "cidr identity",
+			labels: map[string]string{
+				"cidr:10.0.0.0/8": "",
+				"reserved:world":  "",
+			},
+			want: identityTypeCIDR,
+		},
+		{
+			name: "reserved identity",
+			labels: map[string]string{
+				"reserved:health": "",
+			},
+			want: identityTypeReserved,
+		},
+		{
+			name: "other identity",
+			labels: map[string]string{
+				"unspec:foo": "bar",
+			},
+			want: identityTypeOther,
+		},
+		{
+			name:   "no labels",
+			labels: nil,
+			want:   identityTypeOther,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ciliumIdentity := &v2.CiliumIdentity{SecurityLabels: tt.labels}
+			if got := identityType(ciliumIdentity); got != tt.want {
+				t.Errorf("identityType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_identityChurnTracker_counts(t *testing.T) {
+	tracker := identityChurnTracker{}
+	tracker.recordCreation()
+	tracker.recordCreation()
+	tracker.recordDeletion()
+
+	created, deleted := tracker.counts(time.Now())
+	if created != 2 || deleted != 1 {
+		t.Errorf("counts() = (%d, %d), want (2, 1)", created, deleted)
+	}
+
+	// Entries must be pruned once they fall out of the churn window
+	created, deleted = tracker.counts(time.Now().Add(identityChurnWindow + time.Minute))
+	if created != 0 || deleted != 0 {
+		t.Errorf("counts() = (%d, %d), want (0, 0)", created, deleted)
+	}
+}
@@ -182,7 +182,8 @@ func startManagingK8sIdentities(clientset k8sClient.Clientset) {
 	identityHeartbeat = identity.NewIdentityHeartbeatStore(operatorOption.Config.IdentityHeartbeatTimeout)
 
 	identityStore = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
-	identityInformer := informer.NewInformerWithStore(
+	var identityInformer cache.Controller
+	identityInformer = informer.NewInformerWithStore(
 		utils.ListerWatcherFromTyped[*v2.CiliumIdentityList](clientset.CiliumV2().CiliumIdentities()),
 		&v2.CiliumIdentity{},
 		0,
@@ -191,6 +192,11 @@ func startManagingK8sIdentities(clientset k8sClient.Clientset) {
 				if identity, ok := obj.(*v2.CiliumIdentity); ok {
 					// A new identity is always alive
 					identityHeartbeat.MarkAlive(identity.Name, time.Now())
+					// Do not count the initial replay of existing
+					// identities as churn
+					if identityInformer != nil && identityInformer.HasSynced() {
+						identityChurn.recordCreation()
+					}
 				}
 			},
 			UpdateFunc: func(oldObj, newObj interface{}) {
@@ -221,6 +227,7 @@ func startManagingK8sIdentities(clientset k8sClient.Clientset) {
 				// longer than the CiliumIdentity. See
 				// identityHeartbeat.GC()
 				identityHeartbeat.Delete(identity.Name)
+				identityChurn.recordDeletion()
 			},
 		},
 		nil,
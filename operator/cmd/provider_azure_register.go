@@ -9,6 +9,7 @@ import (
 	// These dependencies should be included only when this file is included in the build.
 	allocatorAzure "github.com/cilium/cilium/pkg/ipam/allocator/azure" // Azure allocator task.
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
+	_ "github.com/cilium/cilium/pkg/policy/groups/azure" // Register Azure policy group provider.
 )
 
 func init() {
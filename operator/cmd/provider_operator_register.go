@@ -9,6 +9,9 @@ import (
 	// These dependencies should be included only when this file is included in the build.
 	"github.com/cilium/cilium/pkg/ipam/allocator/clusterpool"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
+	// GKE clusters run the cluster-pool IPAM operator, so the GCP policy
+	// group provider is registered alongside it.
+	_ "github.com/cilium/cilium/pkg/policy/groups/gcp" // Register GCP policy group provider.
 )
 
 func init() {
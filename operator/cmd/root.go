@@ -242,7 +242,12 @@ func runOperator(lc *LeaderLifecycle, clientset k8sClient.Clientset, shutdowner
 
 	if operatorOption.Config.EnableK8s {
 		go func() {
-			err = srv.WithStatusCheckFunc(func() error { return checkStatus(clientset) }).StartServer()
+			err = srv.WithStatusCheckFunc(func() error { return checkStatus(clientset) }).
+				WithLeaderCheckFunc(func() bool {
+					leader, ok := IsLeader.Load().(bool)
+					return ok && leader
+				}).
+				StartServer()
 			if err != nil {
 				log.WithError(err).Fatalf("Unable to start operator apiserver")
 			}
@@ -596,6 +601,10 @@ func (legacy *legacyOnLeader) onStart(_ hive.HookContext) error {
 		if operatorOption.Config.IdentityGCInterval != 0 {
 			go startCRDIdentityGC(legacy.clientset)
 		}
+
+		if operatorOption.Config.EnableMetrics && operatorOption.Config.IdentityUsageReportInterval != 0 {
+			startIdentityUsageReport()
+		}
 	case option.IdentityAllocationModeKVstore:
 		if operatorOption.Config.IdentityGCInterval != 0 {
 			startKvstoreIdentityGC()
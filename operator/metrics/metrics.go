@@ -104,6 +104,23 @@ var (
 	// CiliumEndpointSliceQueueDelay measures the time spent by CES's in the workqueue. This measures time difference between
 	// CES insert in the workqueue and removal from workqueue.
 	CiliumEndpointSliceQueueDelay prometheus.Histogram
+
+	// IdentityCount is the total number of identities allocated in the cluster
+	IdentityCount prometheus.Gauge
+
+	// IdentityNamespaceCount is the number of identities per Kubernetes namespace
+	IdentityNamespaceCount *prometheus.GaugeVec
+
+	// IdentityTypeCount is the number of identities per identity type
+	IdentityTypeCount *prometheus.GaugeVec
+
+	// IdentityChurn records the number of identity creations and deletions
+	// observed over the churn reporting window
+	IdentityChurn *prometheus.GaugeVec
+
+	// IdentityProjectedExhaustionSeconds is the projected time until the
+	// identity space is exhausted at the observed net allocation rate
+	IdentityProjectedExhaustionSeconds prometheus.Gauge
 )
 
 const (
@@ -116,6 +133,12 @@ const (
 	// LabelOpcode indicates the kind of CES metric, could be CEP insert or remove
 	LabelOpcode = "opcode"
 
+	// LabelNamespace marks the Kubernetes namespace a metric applies to
+	LabelNamespace = "namespace"
+
+	// LabelType marks the type of a resource, e.g. the identity type
+	LabelType = "type"
+
 	// Label values
 
 	// LabelValueOutcomeSuccess is used as a successful outcome of an operation
@@ -135,6 +158,12 @@ const (
 
 	// LabelValueCEPRemove is used to indicate the number of CEPs removed from a CES
 	LabelValueCEPRemove = "cepremoved"
+
+	// LabelValueIdentityCreate is used to indicate identity creations
+	LabelValueIdentityCreate = "create"
+
+	// LabelValueIdentityDelete is used to indicate identity deletions
+	LabelValueIdentityDelete = "delete"
 )
 
 func registerMetrics() []prometheus.Collector {
@@ -193,6 +222,41 @@ func registerMetrics() []prometheus.Collector {
 	})
 	collectors = append(collectors, CiliumEndpointSliceQueueDelay)
 
+	IdentityCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "identity_count",
+		Help:      "The total number of identities allocated in the cluster",
+	})
+	collectors = append(collectors, IdentityCount)
+
+	IdentityNamespaceCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "identity_namespace_count",
+		Help:      "The number of identities per Kubernetes namespace",
+	}, []string{LabelNamespace})
+	collectors = append(collectors, IdentityNamespaceCount)
+
+	IdentityTypeCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "identity_type_count",
+		Help:      "The number of identities per identity type (pod, cidr, reserved or other)",
+	}, []string{LabelType})
+	collectors = append(collectors, IdentityTypeCount)
+
+	IdentityChurn = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "identity_churn_24h",
+		Help:      "The number of identity creations and deletions observed over the last 24 hours",
+	}, []string{LabelOpcode})
+	collectors = append(collectors, IdentityChurn)
+
+	IdentityProjectedExhaustionSeconds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "identity_projected_exhaustion_seconds",
+		Help:      "Projected time in seconds until the identity space is exhausted at the observed net allocation rate, zero if no exhaustion is projected",
+	})
+	collectors = append(collectors, IdentityProjectedExhaustionSeconds)
+
 	Registry.MustRegister(collectors...)
 
 	return collectors
@@ -78,6 +78,10 @@ const (
 	// IdentityHeartbeatTimeout is the timeout used to GC identities from k8s
 	IdentityHeartbeatTimeout = "identity-heartbeat-timeout"
 
+	// IdentityUsageReportInterval is the interval in which the identity
+	// usage report is computed and exported via metrics
+	IdentityUsageReportInterval = "identity-usage-report-interval"
+
 	// NodesGCInterval is the duration for which the cilium nodes are GC.
 	NodesGCInterval = "nodes-gc-interval"
 
@@ -328,6 +332,10 @@ type OperatorConfig struct {
 	// IdentityHeartbeatTimeout is the timeout used to GC identities from k8s
 	IdentityHeartbeatTimeout time.Duration
 
+	// IdentityUsageReportInterval is the interval in which the identity
+	// usage report is computed and exported via metrics
+	IdentityUsageReportInterval time.Duration
+
 	OperatorAPIServeAddr        string
 	OperatorPrometheusServeAddr string
 
@@ -551,6 +559,7 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 	c.IdentityGCRateInterval = vp.GetDuration(IdentityGCRateInterval)
 	c.IdentityGCRateLimit = vp.GetInt64(IdentityGCRateLimit)
 	c.IdentityHeartbeatTimeout = vp.GetDuration(IdentityHeartbeatTimeout)
+	c.IdentityUsageReportInterval = vp.GetDuration(IdentityUsageReportInterval)
 	c.OperatorAPIServeAddr = vp.GetString(OperatorAPIServeAddr)
 	c.OperatorPrometheusServeAddr = vp.GetString(OperatorPrometheusServeAddr)
 	c.PProf = vp.GetBool(PProf)
@@ -13,6 +13,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
+	operatorApi "github.com/cilium/cilium/operator/api"
 	"github.com/cilium/cilium/operator/metrics"
 	operatorOption "github.com/cilium/cilium/operator/option"
 	"github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
@@ -133,6 +134,15 @@ func NewCESController(clientset k8sClient.Clientset,
 	// This situation ends up having duplicate CEPs in different CESs. Hence, we need
 	// to sync existing CESs before starting a CEP watcher.
 	syncCESsInLocalCache(cesStore, manager)
+
+	// Expose the depth of the CES work queue on the operator status endpoint.
+	operatorApi.RegisterStatusProvider("ciliumendpointslice-controller", func() operatorApi.ModuleStatus {
+		return operatorApi.ModuleStatus{
+			Name:           "ciliumendpointslice-controller",
+			WorkQueueDepth: rlQueue.Len(),
+		}
+	})
+
 	return &CiliumEndpointSliceController{
 		clientV2:                 clientset.CiliumV2(),
 		clientV2a1:               clientset.CiliumV2alpha1(),
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package doublewrite
+
+import (
+	"context"
+
+	"github.com/cilium/cilium/pkg/allocator"
+	"github.com/cilium/cilium/pkg/idpool"
+	"github.com/cilium/cilium/pkg/kvstore"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/rate"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "doublewrite-allocator")
+
+// backend is an allocator.Backend which mirrors every write to two underlying
+// backends while reads are served from a single preferred backend. It is used
+// to migrate between identity allocation modes online: while all agents
+// dual-write, the read preference can be flipped without identity churn, and
+// the old backend can be decommissioned once drained.
+type backend struct {
+	// readBackend serves reads and drives ListAndWatch. Its errors are
+	// authoritative.
+	readBackend allocator.Backend
+
+	// mirrorBackend receives a copy of every write. Its errors are only
+	// logged, so that an outage of the backend being migrated away from
+	// does not impact identity allocation.
+	mirrorBackend allocator.Backend
+}
+
+// NewDoubleWriteBackend returns an allocator.Backend mirroring all writes to
+// both provided backends. Reads, ListAndWatch and GC are served exclusively
+// by readBackend.
+func NewDoubleWriteBackend(readBackend, mirrorBackend allocator.Backend) allocator.Backend {
+	return &backend{
+		readBackend:   readBackend,
+		mirrorBackend: mirrorBackend,
+	}
+}
+
+func (b *backend) mirrorError(op string, err error) {
+	if err != nil {
+		log.WithError(err).WithField("op", op).Warning("Mirror backend write failed during identity allocation mode migration")
+	}
+}
+
+// DeleteAllKeys will delete all keys from both backends. It is used in tests.
+func (b *backend) DeleteAllKeys(ctx context.Context) {
+	b.readBackend.DeleteAllKeys(ctx)
+	b.mirrorBackend.DeleteAllKeys(ctx)
+}
+
+// Encode encodes a key string as required by the read backend.
+func (b *backend) Encode(v string) string {
+	return b.readBackend.Encode(v)
+}
+
+// AllocateID creates the key->ID association in both backends.
+func (b *backend) AllocateID(ctx context.Context, id idpool.ID, key allocator.AllocatorKey) error {
+	if err := b.readBackend.AllocateID(ctx, id, key); err != nil {
+		return err
+	}
+	b.mirrorError("AllocateID", b.mirrorBackend.AllocateID(ctx, id, key))
+	return nil
+}
+
+// AllocateIDIfLocked behaves like AllocateID. The lock is only honored by the
+// read backend as the mirror backend may not share a locking implementation.
+func (b *backend) AllocateIDIfLocked(ctx context.Context, id idpool.ID, key allocator.AllocatorKey, lock kvstore.KVLocker) error {
+	if err := b.readBackend.AllocateIDIfLocked(ctx, id, key, lock); err != nil {
+		return err
+	}
+	b.mirrorError("AllocateID", b.mirrorBackend.AllocateID(ctx, id, key))
+	return nil
+}
+
+// AcquireReference records this node's use of the key->ID mapping in both
+// backends.
+func (b *backend) AcquireReference(ctx context.Context, id idpool.ID, key allocator.AllocatorKey, lock kvstore.KVLocker) error {
+	if err := b.readBackend.AcquireReference(ctx, id, key, lock); err != nil {
+		return err
+	}
+	// The lock is scoped to the read backend, recreate the reference in
+	// the mirror backend through the unlocked update path instead.
+	b.mirrorError("AcquireReference", b.mirrorBackend.UpdateKey(ctx, id, key, true))
+	return nil
+}
+
+// Release releases the use of the ID in both backends.
+func (b *backend) Release(ctx context.Context, id idpool.ID, key allocator.AllocatorKey) error {
+	err := b.readBackend.Release(ctx, id, key)
+	b.mirrorError("Release", b.mirrorBackend.Release(ctx, id, key))
+	return err
+}
+
+// UpdateKey refreshes the key->ID record in both backends.
+func (b *backend) UpdateKey(ctx context.Context, id idpool.ID, key allocator.AllocatorKey, reliablyMissing bool) error {
+	if err := b.readBackend.UpdateKey(ctx, id, key, reliablyMissing); err != nil {
+		return err
+	}
+	// The mirror backend may have never seen this key, recreate it if
+	// missing so both backends converge.
+	b.mirrorError("UpdateKey", b.mirrorBackend.UpdateKey(ctx, id, key, true))
+	return nil
+}
+
+// UpdateKeyIfLocked behaves like UpdateKey. The lock is only honored by the
+// read backend.
+func (b *backend) UpdateKeyIfLocked(ctx context.Context, id idpool.ID, key allocator.AllocatorKey, reliablyMissing bool, lock kvstore.KVLocker) error {
+	if err := b.readBackend.UpdateKeyIfLocked(ctx, id, key, reliablyMissing, lock); err != nil {
+		return err
+	}
+	b.mirrorError("UpdateKey", b.mirrorBackend.UpdateKey(ctx, id, key, true))
+	return nil
+}
+
+// Get returns the allocated ID for this key as seen by the read backend.
+func (b *backend) Get(ctx context.Context, key allocator.AllocatorKey) (idpool.ID, error) {
+	return b.readBackend.Get(ctx, key)
+}
+
+// GetIfLocked behaves like Get against the read backend.
+func (b *backend) GetIfLocked(ctx context.Context, key allocator.AllocatorKey, lock kvstore.KVLocker) (idpool.ID, error) {
+	return b.readBackend.GetIfLocked(ctx, key, lock)
+}
+
+// GetByID returns the key associated with this ID as seen by the read
+// backend.
+func (b *backend) GetByID(ctx context.Context, id idpool.ID) (allocator.AllocatorKey, error) {
+	return b.readBackend.GetByID(ctx, id)
+}
+
+// Lock locks the key in the read backend.
+func (b *backend) Lock(ctx context.Context, key allocator.AllocatorKey) (kvstore.KVLocker, error) {
+	return b.readBackend.Lock(ctx, key)
+}
+
+// ListAndWatch synchronizes the cache from the read backend.
+func (b *backend) ListAndWatch(ctx context.Context, handler allocator.CacheMutations, stopChan chan struct{}) {
+	b.readBackend.ListAndWatch(ctx, handler, stopChan)
+}
+
+// RunGC garbage collects the read backend. The mirror backend is left to the
+// garbage collector of the allocation mode being migrated to.
+func (b *backend) RunGC(ctx context.Context, rateLimit *rate.Limiter, staleKeysPrevRound map[string]uint64, minID idpool.ID, maxID idpool.ID) (map[string]uint64, *allocator.GCStats, error) {
+	return b.readBackend.RunGC(ctx, rateLimit, staleKeysPrevRound, minID, maxID)
+}
+
+// RunLocksGC garbage collects stale locks of the read backend.
+func (b *backend) RunLocksGC(ctx context.Context, staleKeysPrevRound map[string]kvstore.Value) (map[string]kvstore.Value, error) {
+	return b.readBackend.RunLocksGC(ctx, staleKeysPrevRound)
+}
+
+// Status returns the status of the read backend.
+func (b *backend) Status() (string, error) {
+	return b.readBackend.Status()
+}
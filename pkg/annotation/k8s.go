@@ -12,6 +12,17 @@ const (
 	// rules should be applied to.
 	Name = Prefix + ".name"
 
+	// PolicyExpiresAt is an optional annotation to CiliumNetworkPolicy
+	// resources carrying an RFC3339 timestamp. Once the timestamp has
+	// passed, the agent treats the policy as absent and emits a warning.
+	PolicyExpiresAt = Prefix + ".policy.expires-at"
+
+	// DNSProxyUpstream is an optional annotation to CiliumNetworkPolicy
+	// resources naming the upstream resolver (IP with optional port,
+	// defaulting to 53) the DNS proxy forwards queries of the policy's
+	// namespace to, instead of the destination in the original packet.
+	DNSProxyUpstream = Prefix + ".dns.proxy-upstream"
+
 	// V4CIDRName is the annotation name used to store the IPv4
 	// pod CIDR in the node's annotations.
 	V4CIDRName = Prefix + ".network.ipv4-pod-cidr"
@@ -66,12 +77,47 @@ const (
 	//		no preference. Default behavior if this annotation does not exist
 	ServiceAffinity = Prefix + "/service-affinity"
 
+	// NoHostNSAccess if set to true, makes the ClusterIP of a service
+	// unreachable from the host network namespace via the socket-based
+	// load balancer.
+	NoHostNSAccess = Prefix + "/no-host-ns-access"
+
+	// PreserveClientIdentity if set to true, requests that intra-cluster
+	// traffic to the NodePort or LoadBalancer IPs of the service keeps the
+	// client's source address instead of being SNATed to the node address,
+	// so that network policy on the backend still sees the client identity.
+	// Only effective when the agent runs with --node-port-mode=hybrid, where
+	// it forces DSR dispatch also for protocols the hybrid mode would SNAT.
+	PreserveClientIdentity = Prefix + "/preserve-client-identity"
+
+	// LBAlgorithm selects the backend selection algorithm of a service,
+	// overriding the global --node-port-algorithm setting.
+	// Allowed values:
+	//  - random
+	//  - maglev
+	// Only effective when the global algorithm is maglev: services annotated
+	// with random then skip Maglev LUT provisioning and the datapath falls
+	// back to random selection for them.
+	LBAlgorithm = Prefix + "/lb-algorithm"
+
+	// HealthCheckPath sets the HTTP path on which the healthcheck nodePort
+	// server responds for a service. Requests to other paths are answered
+	// with 404 Not Found. If unset, the server responds on all paths.
+	HealthCheckPath = Prefix + "/health-check-path"
+
 	// ProxyVisibility is the annotation name used to indicate whether proxy
 	// visibility should be enabled for a given pod (i.e., all traffic for the
 	// pod is redirected to the proxy for the given port / protocol in the
 	// annotation
 	ProxyVisibility = Prefix + ".proxy-visibility"
 
+	// DNSVisibility is the annotation name used to indicate that egress DNS
+	// traffic of a pod should be redirected to the DNS proxy for visibility
+	// only, without requiring any ToFQDNs policy to be present. Set to
+	// "true" to enable; it is equivalent to a ProxyVisibility annotation of
+	// "<Egress/53/UDP/DNS>,<Egress/53/TCP/DNS>".
+	DNSVisibility = Prefix + ".dns-visibility"
+
 	// NoTrack is the annotation name used to store the port and protocol
 	// that we should bypass kernel conntrack for a given pod. This applies for
 	// both TCP and UDP connection. Current use case is NodeLocalDNS.
@@ -330,6 +330,33 @@ func exportPodCIDRReconciler(ctx context.Context, _ *BGPRouterManager, sc *Serve
 		return nil
 	}
 
+	// resolve the next hops to advertise, an empty next hop lets the BgpServer
+	// resolve one from the peering subnet.
+	var nextHopV4, nextHopV6 string
+	if newc.NextHopSelf {
+		if cstate.IPv4 != nil && !cstate.IPv4.IsUnspecified() {
+			nextHopV4 = cstate.IPv4.String()
+		}
+		if cstate.IPv6 != nil && !cstate.IPv6.IsUnspecified() {
+			nextHopV6 = cstate.IPv6.String()
+		}
+	}
+
+	// if the NextHopSelf setting changed, withdraw all current advertisements
+	// so they are re-advertised below with the desired next hop.
+	if sc.Config != nil && sc.Config.NextHopSelf != newc.NextHopSelf {
+		l.Infof("NextHopSelf changed for virtual router with local ASN %v, re-advertising pod CIDRs", newc.LocalASN)
+
+		for _, advrt := range sc.PodCIDRAnnouncements {
+			l.Debugf("Withdrawing pod CIDR advertisement %v for local ASN %v", advrt.Net.String(), newc.LocalASN)
+			if err := sc.WithdrawPath(ctx, advrt); err != nil {
+				return err
+			}
+		}
+
+		sc.PodCIDRAnnouncements = sc.PodCIDRAnnouncements[:0]
+	}
+
 	// an aset member which book keeps which universe it exists in
 	type member struct {
 		a     bool
@@ -405,7 +432,7 @@ func exportPodCIDRReconciler(ctx context.Context, _ *BGPRouterManager, sc *Serve
 	// create new adverts
 	for _, advrt := range toAdvertise {
 		l.Debugf("Advertising pod CIDR %v for policy with local ASN: %v", advrt.Net.String(), newc.LocalASN)
-		advrt, err := sc.AdvertisePath(ctx, advrt.Net)
+		advrt, err := sc.AdvertisePath(ctx, advrt.Net, nextHopV4, nextHopV6)
 		if err != nil {
 			return fmt.Errorf("failed to advertise pod cidr prefix %v: %w", advrt.Net, err)
 		}
@@ -401,7 +401,7 @@ func TestExportPodCIDRReconciler(t *testing.T) {
 			}
 			testSC.Config = oldc
 			for _, cidr := range tt.advertised {
-				advrt, err := testSC.AdvertisePath(context.Background(), cidr)
+				advrt, err := testSC.AdvertisePath(context.Background(), cidr, "", "")
 				if err != nil {
 					t.Fatalf("failed to advertise initial pod cidr routes: %v", err)
 				}
@@ -15,6 +15,13 @@ import (
 	v2alpha1api "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
 )
 
+const (
+	// DefaultGracefulRestartTimeSeconds is the restart time advertised to
+	// peers when graceful restart is enabled without an explicit restart
+	// time.
+	DefaultGracefulRestartTimeSeconds = 120
+)
+
 var (
 	// GoBGPIPv6Family is a read-only pointer to a gobgp.Family structure
 	// representing IPv6 address family.
@@ -133,6 +140,24 @@ func (sc *ServerWithConfig) AddNeighbor(ctx context.Context, n *v2alpha1api.Cili
 			},
 		},
 	}
+	if n.GracefulRestart.Enabled {
+		restartTime := uint32(n.GracefulRestart.RestartTimeSeconds)
+		if restartTime == 0 {
+			restartTime = DefaultGracefulRestartTimeSeconds
+		}
+		peerReq.Peer.GracefulRestart = &gobgp.GracefulRestart{
+			Enabled:             true,
+			RestartTime:         restartTime,
+			NotificationEnabled: true,
+		}
+		for _, afiSafi := range peerReq.Peer.AfiSafis {
+			afiSafi.MpGracefulRestart = &gobgp.MpGracefulRestart{
+				Config: &gobgp.MpGracefulRestartConfig{
+					Enabled: true,
+				},
+			}
+		}
+	}
 	if err = sc.Server.AddPeer(ctx, peerReq); err != nil {
 		return fmt.Errorf("failed while adding peer %v %v: %w", n.PeerAddress, n.PeerASN, err)
 	}
@@ -167,19 +192,32 @@ func (sc *ServerWithConfig) RemoveNeighbor(ctx context.Context, n *v2alpha1api.C
 // It is an error to advertise an IPv6 path when no IPv6 address is configured
 // on this Cilium node, selfsame for IPv4.
 //
-// Nexthop of the path will always set to "0.0.0.0" in IPv4 and "::" in IPv6, so
-// that GoBGP selects appropriate actual nexthop address and advertise it.
+// Nexthop of the path is set to "0.0.0.0" in IPv4 and "::" in IPv6 when the
+// corresponding nextHopV4 or nextHopV6 argument is empty, so that GoBGP
+// selects an appropriate actual nexthop address and advertises it. A non-empty
+// next hop is advertised verbatim, e.g. to implement next-hop-self.
+//
+// The path is always advertised with an explicit IGP origin and a zero
+// multi-exit discriminator so that all nodes exporting the same prefix
+// produce identical path attributes, keeping the paths eligible for ECMP on
+// the receiving routers.
 //
 // An Advertisement is returned which may be passed to WithdrawPath to remove
 // this Advertisement.
-func (sc *ServerWithConfig) AdvertisePath(ctx context.Context, ip *net.IPNet) (Advertisement, error) {
+func (sc *ServerWithConfig) AdvertisePath(ctx context.Context, ip *net.IPNet, nextHopV4, nextHopV6 string) (Advertisement, error) {
 	var err error
 	var path *gobgp.Path
 	origin, _ := apb.New(&gobgp.OriginAttribute{
 		Origin: 0,
 	})
+	med, _ := apb.New(&gobgp.MultiExitDiscAttribute{
+		Med: 0,
+	})
 	switch {
 	case ip.IP.To4() != nil:
+		if nextHopV4 == "" {
+			nextHopV4 = "0.0.0.0"
+		}
 		prefixLen, _ := ip.Mask.Size()
 		nlri, _ := apb.New(&gobgp.IPAddressPrefix{
 			PrefixLen: uint32(prefixLen),
@@ -201,17 +239,20 @@ func (sc *ServerWithConfig) AdvertisePath(ctx context.Context, ip *net.IPNet) (A
 		// - RFC4271 Section 5.1.3 (NEXT_HOP)
 		// - RFC4760 Section 3 (Multiprotocol Reachable NLRI - MP_REACH_NLRI (Type Code 14))
 		nextHop, _ := apb.New(&gobgp.NextHopAttribute{
-			NextHop: "0.0.0.0",
+			NextHop: nextHopV4,
 		})
 		path = &gobgp.Path{
 			Family: GoBGPIPv4Family,
 			Nlri:   nlri,
-			Pattrs: []*apb.Any{nextHop, origin},
+			Pattrs: []*apb.Any{nextHop, origin, med},
 		}
 		_, err = sc.Server.AddPath(ctx, &gobgp.AddPathRequest{
 			Path: path,
 		})
 	case ip.IP.To16() != nil:
+		if nextHopV6 == "" {
+			nextHopV6 = "::"
+		}
 		prefixLen, _ := ip.Mask.Size()
 		nlri, _ := apb.New(&gobgp.IPAddressPrefix{
 			PrefixLen: uint32(prefixLen),
@@ -220,13 +261,13 @@ func (sc *ServerWithConfig) AdvertisePath(ctx context.Context, ip *net.IPNet) (A
 		nlriAttrs, _ := apb.New(&gobgp.MpReachNLRIAttribute{ // MP BGP NLRI
 			Family: GoBGPIPv6Family,
 			// See the above explanation for IPv4
-			NextHops: []string{"::"},
+			NextHops: []string{nextHopV6},
 			Nlris:    []*apb.Any{nlri},
 		})
 		path = &gobgp.Path{
 			Family: GoBGPIPv6Family,
 			Nlri:   nlri,
-			Pattrs: []*apb.Any{nlriAttrs, origin},
+			Pattrs: []*apb.Any{nlriAttrs, origin, med},
 		}
 		_, err = sc.Server.AddPath(ctx, &gobgp.AddPathRequest{
 			Path: path,
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+//go:build linux
+
+package bpf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"github.com/cilium/cilium/pkg/byteorder"
+)
+
+const (
+	// snapshotMagic identifies serialized BPF map snapshot files.
+	snapshotMagic = "cilium-bpf-map-snapshot"
+
+	// snapshotVersion is the version of the snapshot format written by
+	// WriteSnapshot. It must be bumped whenever the format changes.
+	snapshotVersion = uint32(1)
+
+	// snapshotHeaderLen is the length of the snapshot header following the
+	// magic, consisting of the format version, key size and value size.
+	snapshotHeaderLen = 12
+
+	// SnapshotSuffix is the file name suffix of the per-map snapshot files
+	// inside a snapshot directory.
+	SnapshotSuffix = ".snapshot"
+)
+
+// SnapshotPath returns the path of the snapshot file of this map inside the
+// given snapshot directory.
+func (m *Map) SnapshotPath(dir string) string {
+	return filepath.Join(dir, m.name+SnapshotSuffix)
+}
+
+// WriteSnapshot serializes the current contents of the map to w. The
+// resulting snapshot can be read back with the DumpSnapshot family of
+// functions, also on another machine, to inspect the datapath state offline.
+// Snapshots are written in the native byte order of the node they are taken
+// on.
+func (m *Map) WriteSnapshot(w io.Writer) error {
+	if _, err := io.WriteString(w, snapshotMagic); err != nil {
+		return err
+	}
+
+	header := make([]byte, snapshotHeaderLen)
+	byteorder.Native.PutUint32(header[0:4], snapshotVersion)
+	byteorder.Native.PutUint32(header[4:8], m.KeySize)
+	byteorder.Native.PutUint32(header[8:12], m.ReadValueSize)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	var writeErr error
+	err := m.DumpWithCallback(func(key MapKey, value MapValue) {
+		if writeErr != nil {
+			return
+		}
+		if _, err := w.Write(unsafe.Slice((*byte)(key.GetKeyPtr()), int(m.KeySize))); err != nil {
+			writeErr = err
+			return
+		}
+		if _, err := w.Write(unsafe.Slice((*byte)(value.GetValuePtr()), int(m.ReadValueSize))); err != nil {
+			writeErr = err
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return writeErr
+}
+
+// WriteSnapshotToDir writes the snapshot of this map to its well-known file
+// name inside dir, see SnapshotPath.
+func (m *Map) WriteSnapshotToDir(dir string) error {
+	f, err := os.Create(m.SnapshotPath(dir))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if err := m.WriteSnapshot(w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// ReadSnapshotWithCallback reads a snapshot produced by WriteSnapshot from r
+// and calls cb for each entry found in it. Unlike DumpWithCallback, no BPF
+// map needs to be present on the system.
+func (m *Map) ReadSnapshotWithCallback(r io.Reader, cb DumpCallback) error {
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return fmt.Errorf("failed to read snapshot magic: %w", err)
+	}
+	if string(magic) != snapshotMagic {
+		return fmt.Errorf("not a BPF map snapshot")
+	}
+
+	header := make([]byte, snapshotHeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return fmt.Errorf("failed to read snapshot header: %w", err)
+	}
+	if version := byteorder.Native.Uint32(header[0:4]); version != snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d", version)
+	}
+	if keySize := byteorder.Native.Uint32(header[4:8]); keySize != m.KeySize {
+		return fmt.Errorf("snapshot key size %d does not match key size %d of map %s",
+			keySize, m.KeySize, m.name)
+	}
+	if valueSize := byteorder.Native.Uint32(header[8:12]); valueSize != m.ReadValueSize {
+		return fmt.Errorf("snapshot value size %d does not match value size %d of map %s",
+			valueSize, m.ReadValueSize, m.name)
+	}
+
+	key := make([]byte, m.KeySize)
+	value := make([]byte, m.ReadValueSize)
+	mk := m.MapKey.DeepCopyMapKey()
+	mv := m.MapValue.DeepCopyMapValue()
+
+	for {
+		if _, err := io.ReadFull(r, key); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("truncated snapshot entry: %w", err)
+		}
+		if _, err := io.ReadFull(r, value); err != nil {
+			return fmt.Errorf("truncated snapshot entry: %w", err)
+		}
+
+		var err error
+		mk, mv, err = m.DumpParser(key, value, mk, mv)
+		if err != nil {
+			return err
+		}
+
+		if cb != nil {
+			cb(mk, mv)
+		}
+	}
+}
+
+// DumpSnapshotWithCallback reads this map's snapshot file from dir and calls
+// cb for each entry found in it.
+func (m *Map) DumpSnapshotWithCallback(dir string, cb DumpCallback) error {
+	f, err := os.Open(m.SnapshotPath(dir))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return m.ReadSnapshotWithCallback(bufio.NewReader(f), cb)
+}
+
+// DumpSnapshotWithCallbackIfExists is similar to DumpSnapshotWithCallback,
+// but returns earlier if no snapshot of the map is present in dir, e.g.
+// because the map was not in use on the node the snapshot was taken on.
+func (m *Map) DumpSnapshotWithCallbackIfExists(dir string, cb DumpCallback) error {
+	err := m.DumpSnapshotWithCallback(dir, cb)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// DumpSnapshot reads this map's snapshot file from dir and dumps its
+// contents into hash, in the same format as Dump.
+func (m *Map) DumpSnapshot(dir string, hash map[string][]string) error {
+	return m.DumpSnapshotWithCallback(dir, func(key MapKey, value MapValue) {
+		// No need to deep copy since we are creating strings.
+		hash[key.String()] = append(hash[key.String()], value.String())
+	})
+}
+
+// DumpSnapshotIfExists dumps the contents of the map's snapshot file in dir
+// into hash via DumpSnapshot() if the snapshot file exists.
+func (m *Map) DumpSnapshotIfExists(dir string, hash map[string][]string) error {
+	err := m.DumpSnapshot(dir, hash)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
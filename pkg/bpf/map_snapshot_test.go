@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package bpf
+
+import (
+	"bytes"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/byteorder"
+)
+
+func snapshotTestMap() *Map {
+	return NewMap("cilium_test_snapshot", MapTypeHash, &BenchKey{}, 4,
+		&BenchValue{}, 4, 32, 0, 0, ConvertKeyValue)
+}
+
+func snapshotTestHeader(keySize, valueSize uint32) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(snapshotMagic)
+	header := make([]byte, snapshotHeaderLen)
+	byteorder.Native.PutUint32(header[0:4], snapshotVersion)
+	byteorder.Native.PutUint32(header[4:8], keySize)
+	byteorder.Native.PutUint32(header[8:12], valueSize)
+	buf.Write(header)
+	return buf.Bytes()
+}
+
+func (s *BPFTestSuite) TestReadSnapshotWithCallback(c *C) {
+	m := snapshotTestMap()
+
+	var buf bytes.Buffer
+	buf.Write(snapshotTestHeader(4, 4))
+	entry := make([]byte, 8)
+	byteorder.Native.PutUint32(entry[0:4], 42)
+	byteorder.Native.PutUint32(entry[4:8], 1337)
+	buf.Write(entry)
+	byteorder.Native.PutUint32(entry[0:4], 43)
+	byteorder.Native.PutUint32(entry[4:8], 1338)
+	buf.Write(entry)
+
+	var keys, values []uint32
+	err := m.ReadSnapshotWithCallback(&buf, func(k MapKey, v MapValue) {
+		keys = append(keys, k.(*BenchKey).Key)
+		values = append(values, v.(*BenchValue).Value)
+	})
+	c.Assert(err, IsNil)
+	c.Assert(keys, DeepEquals, []uint32{42, 43})
+	c.Assert(values, DeepEquals, []uint32{1337, 1338})
+}
+
+func (s *BPFTestSuite) TestReadSnapshotErrors(c *C) {
+	m := snapshotTestMap()
+
+	// not a snapshot at all
+	err := m.ReadSnapshotWithCallback(bytes.NewBufferString("bogus"), nil)
+	c.Assert(err, NotNil)
+
+	// key size of the snapshot does not match the map
+	err = m.ReadSnapshotWithCallback(bytes.NewBuffer(snapshotTestHeader(8, 4)), nil)
+	c.Assert(err, NotNil)
+
+	// truncated entry
+	var buf bytes.Buffer
+	buf.Write(snapshotTestHeader(4, 4))
+	buf.Write([]byte{1, 2, 3, 4, 5})
+	err = m.ReadSnapshotWithCallback(&buf, nil)
+	c.Assert(err, NotNil)
+}
@@ -135,12 +135,16 @@ type Controller struct {
 	lastError         error
 	lastErrorStamp    time.Time
 	lastDuration      time.Duration
-	uuid              string
-	stop              chan struct{}
-	update            chan struct{}
-	trigger           chan struct{}
-	ctxDoFunc         context.Context
-	cancelDoFunc      context.CancelFunc
+	// runStartStamp is the time the currently running DoFunc was invoked,
+	// or the zero value if no DoFunc is running. It is inspected by the
+	// watchdog to detect stuck controllers.
+	runStartStamp time.Time
+	uuid          string
+	stop          chan struct{}
+	update        chan struct{}
+	trigger       chan struct{}
+	ctxDoFunc     context.Context
+	cancelDoFunc  context.CancelFunc
 
 	// terminated is closed after the controller has been terminated
 	terminated chan struct{}
@@ -203,10 +207,15 @@ func (c *Controller) runController() {
 			interval = params.RunInterval
 
 			start := time.Now()
+			c.mutex.Lock()
+			c.runStartStamp = start
+			c.mutex.Unlock()
+
 			err = params.DoFunc(c.ctxDoFunc)
 			duration := time.Since(start)
 
 			c.mutex.Lock()
+			c.runStartStamp = time.Time{}
 			c.lastDuration = duration
 			c.getLogger().Debug("Controller func execution time: ", c.lastDuration)
 
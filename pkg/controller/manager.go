@@ -39,6 +39,30 @@ func GetGlobalStatus() models.ControllerStatuses {
 	return globalStatus.GetStatusModel()
 }
 
+// TriggerGlobalController triggers the controller with the specified name,
+// regardless of which manager it is owned by. Returns false if no controller
+// with that name exists. The global status map is keyed by controller UUID,
+// so the lookup has to scan for the name.
+func TriggerGlobalController(name string) bool {
+	var controller *Controller
+
+	globalStatus.mutex.RLock()
+	for _, c := range globalStatus.controllers {
+		if c.name == name {
+			controller = c
+			break
+		}
+	}
+	globalStatus.mutex.RUnlock()
+
+	if controller == nil {
+		return false
+	}
+
+	controller.Trigger()
+	return true
+}
+
 // UpdateController installs or updates a controller in the manager. A
 // controller is identified by its name. If a controller with the name already
 // exists, the controller will be shut down and replaced with the provided
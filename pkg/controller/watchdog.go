@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"time"
+
+	"github.com/cilium/cilium/pkg/inctimer"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// watchdogInterval is how often the watchdog scans all controllers.
+	watchdogInterval = time.Minute
+
+	// watchdogStuckFactor is the multiple of a controller's RunInterval
+	// after which a still running DoFunc is considered stuck.
+	watchdogStuckFactor = 4
+
+	// watchdogMinTimeout is the minimum time a DoFunc may run before the
+	// watchdog considers it stuck, regardless of the RunInterval. This
+	// avoids false positives for controllers with short intervals whose
+	// runs are legitimately slower than the interval.
+	watchdogMinTimeout = 5 * time.Minute
+)
+
+// StartWatchdog spawns a watchdog which periodically scans all controllers
+// for runs that have exceeded their expected interval by a large factor,
+// e.g. because the DoFunc is stuck on a lock. A stuck controller is
+// surfaced in its status, counted in the cilium_controllers_stuck metric,
+// and triggers a one-time goroutine dump to the state directory to allow
+// diagnosing the hang after the fact. The watchdog stops when ctx is
+// cancelled.
+func StartWatchdog(ctx context.Context) {
+	go runWatchdog(ctx)
+}
+
+func runWatchdog(ctx context.Context) {
+	// stuck tracks the UUIDs of controllers already reported as stuck so
+	// that each episode is only reported once.
+	stuck := map[string]struct{}{}
+	timer, timerDone := inctimer.New()
+	defer timerDone()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.After(watchdogInterval):
+		}
+		checkStuckControllers(stuck)
+	}
+}
+
+func checkStuckControllers(stuck map[string]struct{}) {
+	now := time.Now()
+
+	globalStatus.mutex.RLock()
+	controllers := make([]*Controller, 0, len(globalStatus.controllers))
+	for _, c := range globalStatus.controllers {
+		controllers = append(controllers, c)
+	}
+	globalStatus.mutex.RUnlock()
+
+	seen := make(map[string]struct{}, len(stuck))
+	newlyStuck := false
+	for _, c := range controllers {
+		running, over := c.overrun(now)
+		if !over {
+			delete(stuck, c.uuid)
+			continue
+		}
+		seen[c.uuid] = struct{}{}
+		if _, reported := stuck[c.uuid]; reported {
+			continue
+		}
+		stuck[c.uuid] = struct{}{}
+		newlyStuck = true
+		c.markStuck(running)
+		c.getLogger().WithField("runningFor", running.Round(time.Second)).
+			Warn("Controller run has exceeded its expected interval, it may be stuck")
+	}
+
+	// Forget controllers that have been removed in the meantime.
+	for uuid := range stuck {
+		if _, ok := seen[uuid]; !ok {
+			delete(stuck, uuid)
+		}
+	}
+
+	metrics.ControllersStuck.Set(float64(len(stuck)))
+	if newlyStuck {
+		dumpGoroutines()
+	}
+}
+
+// overrun returns how long the controller's DoFunc has been running and
+// whether that exceeds the watchdog timeout derived from its RunInterval.
+func (c *Controller) overrun(now time.Time) (time.Duration, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if c.runStartStamp.IsZero() {
+		return 0, false
+	}
+
+	timeout := watchdogStuckFactor * c.params.RunInterval
+	if timeout < watchdogMinTimeout {
+		timeout = watchdogMinTimeout
+	}
+
+	running := now.Sub(c.runStartStamp)
+	return running, running > timeout
+}
+
+// markStuck records the overrun in the controller's status so that it is
+// reported as failing via the status API and /healthz. The error is
+// overwritten by the regular bookkeeping once the run eventually returns.
+func (c *Controller) markStuck(running time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// The run may have returned between the watchdog scan and now.
+	if c.runStartStamp.IsZero() {
+		return
+	}
+
+	c.lastError = fmt.Errorf("watchdog: run has not returned for %s", running.Round(time.Second))
+	c.lastErrorStamp = time.Now()
+	c.consecutiveErrors++
+}
+
+// dumpGoroutines writes a goroutine dump to the state directory, capturing
+// the stack the stuck controller is blocked on while it is still blocked.
+func dumpGoroutines() {
+	path := filepath.Join(option.Config.StateDir, fmt.Sprintf("goroutine-dump-%d.txt", time.Now().Unix()))
+	f, err := os.Create(path)
+	if err != nil {
+		log.WithError(err).WithField(logfields.Path, path).Warn("Unable to create goroutine dump")
+		return
+	}
+	defer f.Close()
+
+	if err := pprof.Lookup("goroutine").WriteTo(f, 2); err != nil {
+		log.WithError(err).WithField(logfields.Path, path).Warn("Unable to write goroutine dump")
+		return
+	}
+
+	log.WithField(logfields.Path, path).Info("Wrote goroutine dump for stuck controller")
+}
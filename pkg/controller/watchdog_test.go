@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package controller
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (b *ControllerSuite) TestWatchdogOverrun(c *C) {
+	ctrl := &Controller{params: ControllerParams{RunInterval: time.Minute}}
+
+	// No DoFunc running.
+	_, over := ctrl.overrun(time.Now())
+	c.Assert(over, Equals, false)
+
+	// Running, but still within the minimum timeout.
+	ctrl.runStartStamp = time.Now().Add(-2 * time.Minute)
+	_, over = ctrl.overrun(time.Now())
+	c.Assert(over, Equals, false)
+
+	// Running past the timeout.
+	ctrl.runStartStamp = time.Now().Add(-2 * watchdogMinTimeout)
+	running, over := ctrl.overrun(time.Now())
+	c.Assert(over, Equals, true)
+
+	ctrl.markStuck(running)
+	status := ctrl.GetStatusModel()
+	c.Assert(status.Status.ConsecutiveFailureCount, Equals, int64(1))
+	c.Assert(status.Status.LastFailureMsg, Not(Equals), "")
+}
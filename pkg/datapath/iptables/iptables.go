@@ -647,6 +647,50 @@ func (m *IptablesManager) installStaticProxyRules() error {
 		}
 	}
 
+	return m.installDoHSteeringRules()
+}
+
+// installDoHSteeringRules steers DNS-over-HTTPS traffic destined to the
+// configured well-known resolver IPs into the DoH listener of the DNS proxy,
+// so that ToFQDNs policy keeps working when applications use DoH clients.
+// Unlike the other TPROXY rules there is no datapath mark to match on, as the
+// datapath only redirects traffic on DNS ports, so the match is on the
+// resolver IP and the HTTPS port instead.
+func (m *IptablesManager) installDoHSteeringRules() error {
+	if option.Config.DNSProxyDoHListenPort == 0 {
+		return nil
+	}
+
+	mark := fmt.Sprintf("%#08x", linux_defaults.MagicMarkIsToProxy)
+	port := fmt.Sprintf("%d", option.Config.DNSProxyDoHListenPort)
+	for _, resolver := range option.Config.DNSProxyDoHResolverIPs {
+		ip := net.ParseIP(resolver)
+		if ip == nil {
+			return fmt.Errorf("invalid DoH resolver IP %q", resolver)
+		}
+		prog := ip4tables
+		if ip.To4() == nil {
+			if !option.Config.EnableIPv6 {
+				continue
+			}
+			prog = ip6tables
+		} else if !option.Config.EnableIPv4 {
+			continue
+		}
+		if err := prog.runProg([]string{
+			"-t", "mangle",
+			"-A", ciliumPreMangleChain,
+			"-p", "tcp",
+			"-d", resolver,
+			"--dport", "443",
+			"-m", "comment", "--comment", "cilium: TPROXY to host doh proxy",
+			"-j", "TPROXY",
+			"--tproxy-mark", mark,
+			"--on-port", port}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
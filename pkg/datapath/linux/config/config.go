@@ -33,6 +33,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/bwmap"
 	"github.com/cilium/cilium/pkg/maps/callsmap"
 	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/maps/dropcache"
 	"github.com/cilium/cilium/pkg/maps/egressmap"
 	"github.com/cilium/cilium/pkg/maps/encrypt"
 	"github.com/cilium/cilium/pkg/maps/eppolicymap"
@@ -46,6 +47,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/nat"
 	"github.com/cilium/cilium/pkg/maps/neighborsmap"
 	"github.com/cilium/cilium/pkg/maps/policymap"
+	"github.com/cilium/cilium/pkg/maps/qosmap"
 	"github.com/cilium/cilium/pkg/maps/recorder"
 	"github.com/cilium/cilium/pkg/maps/signalmap"
 	"github.com/cilium/cilium/pkg/maps/sockmap"
@@ -260,6 +262,19 @@ func (h *HeaderfileWriter) WriteNodeConfig(w io.Writer, cfg *datapath.LocalNodeC
 		cDefinesMap["ENABLE_EGRESS_GATEWAY"] = "1"
 	}
 
+	if len(option.Config.QoSDSCPPolicies) > 0 {
+		cDefinesMap["ENABLE_QOS_DSCP"] = "1"
+		cDefinesMap["QOS_POLICY_MAP"] = qosmap.PolicyMapName
+		cDefinesMap["QOS_POLICY_MAP_SIZE"] = fmt.Sprintf("%d", qosmap.MaxPolicyEntries)
+	}
+
+	if option.Config.DropVerdictCacheTTL > 0 {
+		cDefinesMap["ENABLE_DROP_CACHE"] = "1"
+		cDefinesMap["DROP_CACHE_MAP"] = dropcache.MapName
+		cDefinesMap["DROP_CACHE_MAP_SIZE"] = fmt.Sprintf("%d", dropcache.MaxEntries)
+		cDefinesMap["DROP_CACHE_TTL"] = fmt.Sprintf("%d", option.Config.DropVerdictCacheTTL.Nanoseconds())
+	}
+
 	if option.Config.EnableEndpointRoutes {
 		cDefinesMap["ENABLE_ENDPOINT_ROUTES"] = "1"
 	}
@@ -594,6 +609,10 @@ func (h *HeaderfileWriter) WriteNodeConfig(w io.Writer, cfg *datapath.LocalNodeC
 	}
 	cDefinesMap["VLAN_FILTER(ifindex, vlan_id)"] = vlanFilter
 
+	if option.Config.EnableIPSec || option.Config.EnableWireguard {
+		cDefinesMap["ENCRYPTION_BYPASS(id)"] = encryptionBypassMacros()
+	}
+
 	if option.Config.EnableICMPRules {
 		cDefinesMap["ENABLE_ICMP_RULE"] = "1"
 	}
@@ -704,6 +723,29 @@ return false;`))
 	}
 }
 
+// encryptionBypassMacros generates the ENCRYPTION_BYPASS macro which is
+// written to node_config.h when node-to-node encryption is enabled. It
+// matches the security identities listed in the
+// --encryption-bypass-identities option, whose traffic is sent in plain text.
+func encryptionBypassMacros() string {
+	ids := make([]int, len(option.Config.EncryptionBypassIdentities))
+	copy(ids, option.Config.EncryptionBypassIdentities)
+	sort.Ints(ids)
+
+	if len(ids) == 0 {
+		return "return false;"
+	}
+
+	var bypassMacro bytes.Buffer
+	bypassMacro.WriteString("switch (id) { \\\n")
+	for _, id := range ids {
+		fmt.Fprintf(&bypassMacro, "case %d: \\\n", id)
+	}
+	bypassMacro.WriteString("return true; \\\n} \\\nreturn false;")
+
+	return bypassMacro.String()
+}
+
 // devMacros generates NATIVE_DEV_MAC_BY_IFINDEX and IS_L3_DEV macros which
 // are written to node_config.h.
 func devMacros() (string, string, error) {
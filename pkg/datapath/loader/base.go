@@ -395,7 +395,7 @@ func (l *Loader) Reinitialize(ctx context.Context, o datapath.BaseProgramOwner,
 		}
 	}
 
-	sysctl.ApplySettings(sysSettings)
+	sysctl.RegisterSettings(sysSettings)
 
 	// Datapath initialization
 	hostDev1, hostDev2, err := SetupBaseDevice(deviceMTU)
@@ -20,6 +20,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/lbmap"
 	"github.com/cilium/cilium/pkg/maps/policymap"
 	"github.com/cilium/cilium/pkg/maps/recorder"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
 )
 
@@ -44,6 +45,10 @@ type endpointManager interface {
 // exists.
 type MapSweeper struct {
 	endpointManager
+
+	// DryRun makes the sweeper report the paths it would remove without
+	// removing them.
+	DryRun bool
 }
 
 // NewMapSweeper creates an object that walks map paths and garbage-collects
@@ -54,6 +59,17 @@ func NewMapSweeper(g endpointManager) *MapSweeper {
 	}
 }
 
+// removeMapPath removes the given map path unless the sweeper runs in
+// dry-run mode, in which case the path is only reported.
+func (ms *MapSweeper) removeMapPath(path string) {
+	if ms.DryRun {
+		log.WithField(logfields.Path, path).Info("Dry-run: would remove orphaned BPF map")
+		return
+	}
+	ms.RemoveMapPath(path)
+	metrics.BPFMapsSweeperReclaimedTotal.Inc()
+}
+
 // deleteMapIfStale uses the endpointManager implementation to determine for
 // the given path whether it should be deleted, and if so deletes the path.
 func (ms *MapSweeper) deleteMapIfStale(path string, filename string, endpointID string) {
@@ -62,14 +78,16 @@ func (ms *MapSweeper) deleteMapIfStale(path string, filename string, endpointID
 		if ms.EndpointExists(epID) {
 			prefix := strings.TrimSuffix(filename, endpointID)
 			if filename != bpf.LocalMapName(prefix, epID) {
-				ms.RemoveMapPath(path)
+				ms.removeMapPath(path)
 			}
 		} else {
-			err2 := ms.RemoveDatapathMapping(epID)
-			if err2 != nil {
-				log.WithError(err2).Debugf("Failed to remove ID %d from global policy map", tmp)
+			if !ms.DryRun {
+				err2 := ms.RemoveDatapathMapping(epID)
+				if err2 != nil {
+					log.WithError(err2).Debugf("Failed to remove ID %d from global policy map", tmp)
+				}
 			}
-			ms.RemoveMapPath(path)
+			ms.removeMapPath(path)
 		}
 	}
 }
@@ -79,7 +97,7 @@ func (ms *MapSweeper) checkStaleGlobalMap(path string, filename string) {
 		!option.Config.InstallIptRules && option.Config.MasqueradingEnabled()
 
 	if !globalCTinUse && ctmap.NameIsGlobal(filename) {
-		ms.RemoveMapPath(path)
+		ms.removeMapPath(path)
 	}
 }
 
@@ -219,7 +237,7 @@ func (ms *MapSweeper) RemoveDisabledMaps() {
 	for _, m := range maps {
 		p := path.Join(bpf.MapPrefixPath(), m)
 		if _, err := os.Stat(p); !os.IsNotExist(err) {
-			ms.RemoveMapPath(p)
+			ms.removeMapPath(p)
 		}
 	}
 }
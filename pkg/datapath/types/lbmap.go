@@ -22,11 +22,13 @@ type LBMap interface {
 	DeleteBackendByID(loadbalancer.BackendID) error
 	AddAffinityMatch(uint16, loadbalancer.BackendID) error
 	DeleteAffinityMatch(uint16, loadbalancer.BackendID) error
+	DeleteRevNat(uint16, bool) error
 	UpdateSourceRanges(uint16, []*cidr.CIDR, []*cidr.CIDR, bool) error
 	DumpServiceMaps() ([]*loadbalancer.SVC, []error)
 	DumpBackendMaps() ([]*loadbalancer.Backend, error)
 	DumpAffinityMatches() (BackendIDByServiceIDSet, error)
 	DumpSourceRanges(bool) (SourceRangeSetByServiceID, error)
+	DumpRevNatMaps(bool) ([]uint16, error)
 }
 
 type UpsertServiceParams struct {
@@ -52,6 +54,8 @@ type UpsertServiceParams struct {
 	L7LBProxyPort             uint16                   // Non-zero for L7 LB services
 	Name                      loadbalancer.ServiceName // Fully qualified name of the service
 	LoopbackHostport          bool
+	NoHostNSAccess            bool // ClusterIP not reachable from the host netns
+	PreserveClientIdentity    bool // Keep the client source address for NodePort/LB traffic
 }
 
 // GetOrderedBackends returns an ordered list of backends with all the sorted
@@ -148,6 +148,10 @@ const (
 	// endpoints that are larger than 512 Bytes or the EDNS0 option, if present.
 	ToFQDNsEnableDNSCompression = true
 
+	// DNSProxyLockCount is the default number of mutexes serializing the
+	// handling of DNS responses in the daemon, sharded by response IP.
+	DNSProxyLockCount = 128
+
 	// IdentityChangeGracePeriod is the default value for
 	// option.IdentityChangeGracePeriod
 	IdentityChangeGracePeriod = 5 * time.Second
@@ -495,6 +499,11 @@ const (
 	// EnableICMPRules enables ICMP-based rule support for Cilium Network Policies.
 	EnableICMPRules = true
 
+	// EnableNodeSelectorLabels is the default value for whether node labels
+	// are ingested into node identities, allowing FromNodes/ToNodes rules
+	// in Cilium Network Policies.
+	EnableNodeSelectorLabels = false
+
 	// TunnelPortVXLAN is the default VXLAN port
 	TunnelPortVXLAN = 8472
 	// TunnelPortGeneve is the default Geneve port
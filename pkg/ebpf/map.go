@@ -21,6 +21,7 @@ type MapSpec = ciliumebpf.MapSpec
 const (
 	Hash       = ciliumebpf.Hash
 	PerCPUHash = ciliumebpf.PerCPUHash
+	LRUHash    = ciliumebpf.LRUHash
 	Array      = ciliumebpf.Array
 	HashOfMaps = ciliumebpf.HashOfMaps
 	LPMTrie    = ciliumebpf.LPMTrie
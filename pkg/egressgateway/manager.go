@@ -22,6 +22,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maps/egressmap"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
 )
@@ -34,6 +35,12 @@ type k8sCacheSyncedChecker interface {
 	K8sCacheIsSynced() bool
 }
 
+// monitorNotify is used to send egress gateway policy entry notifications to
+// the monitor
+type monitorNotify interface {
+	SendNotification(msg monitorAPI.AgentNotifyMessage) error
+}
+
 // The egressgateway manager stores the internal data tracking the node, policy,
 // endpoint, and lease mappings. It also hooks up all the callbacks to update
 // egress bpf policy map accordingly.
@@ -58,16 +65,21 @@ type Manager struct {
 
 	// identityAllocator is used to fetch identity labels for endpoint updates
 	identityAllocator identityCache.IdentityAllocator
+
+	// monitorNotify is used to send egress gateway policy entry
+	// notifications to the monitor
+	monitorNotify monitorNotify
 }
 
 // NewEgressGatewayManager returns a new Egress Gateway Manager.
-func NewEgressGatewayManager(k8sCacheSyncedChecker k8sCacheSyncedChecker, identityAlocator identityCache.IdentityAllocator) *Manager {
+func NewEgressGatewayManager(k8sCacheSyncedChecker k8sCacheSyncedChecker, identityAlocator identityCache.IdentityAllocator, monitorNotify monitorNotify) *Manager {
 	manager := &Manager{
 		k8sCacheSyncedChecker: k8sCacheSyncedChecker,
 		nodeDataStore:         make(map[string]nodeTypes.Node),
 		policyConfigs:         make(map[policyID]*PolicyConfig),
 		epDataStore:           make(map[endpointID]*endpointMetadata),
 		identityAllocator:     identityAlocator,
+		monitorNotify:         monitorNotify,
 	}
 
 	manager.runReconciliationAfterK8sSync()
@@ -91,6 +103,15 @@ func (manager *Manager) getIdentityLabels(securityIdentity uint32) (labels.Label
 	return identity.Labels, nil
 }
 
+// sendNotification sends an agent notification to the monitor, if the manager
+// is wired up to one.
+func (manager *Manager) sendNotification(msg monitorAPI.AgentNotifyMessage) {
+	if manager.monitorNotify == nil {
+		return
+	}
+	manager.monitorNotify.SendNotification(msg)
+}
+
 // runReconciliationAfterK8sSync spawns a goroutine that waits for the agent to
 // sync with k8s and then runs the first reconciliation.
 func (manager *Manager) runReconciliationAfterK8sSync() {
@@ -355,6 +376,8 @@ func (manager *Manager) addMissingEgressRules() {
 			logger.WithError(err).Error("Error applying egress gateway policy")
 		} else {
 			logger.Debug("Egress gateway policy applied")
+			manager.sendNotification(monitorAPI.EgressGatewayPolicyAppliedMessage(
+				endpointIP.String(), dstCIDR.String(), gwc.egressIP.IP.String(), gwc.gatewayIP.String()))
 		}
 	}
 
@@ -395,6 +418,9 @@ nextPolicyKey:
 			logger.WithError(err).Error("Error removing egress gateway policy")
 		} else {
 			logger.Debug("Egress gateway policy removed")
+			manager.sendNotification(monitorAPI.EgressGatewayPolicyDeletedMessage(
+				policyKey.GetSourceIP().String(), policyKey.GetDestCIDR().String(),
+				policyVal.GetEgressIP().String(), policyVal.GetGatewayIP().String()))
 		}
 	}
 }
@@ -71,6 +71,7 @@ func NewEndpointFromChangeModel(ctx context.Context, owner regeneration.Owner, p
 	ep.dockerEndpointID = base.DockerEndpointID
 	ep.K8sPodName = base.K8sPodName
 	ep.K8sNamespace = base.K8sNamespace
+	ep.netNSPath = base.NetworkNamespace
 
 	if base.Mac != "" {
 		m, err := mac.ParseMAC(base.Mac)
@@ -209,12 +210,14 @@ func (e *Endpoint) GetModelRLocked() *models.Endpoint {
 			ExternalIdentifiers: e.getModelEndpointIdentitiersRLocked(),
 			// FIXME GH-3280 When we begin returning endpoint revisions this should
 			// change to return the configured and in-datapath policies.
-			Policy:      e.GetPolicyModel(),
-			Log:         statusLog,
-			Controllers: controllerMdl,
-			State:       e.getModelCurrentStateRLocked(), // TODO: Validate
-			Health:      e.getHealthModel(),
-			NamedPorts:  e.getNamedPortsModel(),
+			Policy:       e.GetPolicyModel(),
+			Regeneration: e.lastRegenerationStats,
+			Log:          statusLog,
+			Controllers:  controllerMdl,
+			State:        e.getModelCurrentStateRLocked(), // TODO: Validate
+			Health:       e.getHealthModel(),
+			NamedPorts:   e.getNamedPortsModel(),
+			DNSStats:     e.DNSStats.GetModel(),
 		},
 	}
 
@@ -370,9 +373,12 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 
 	var (
 		realizedL4Policy *policy.L4Policy
+		realizedStats    *models.PolicyMapStatistics
 	)
 	if e.realizedPolicy != nil {
 		realizedL4Policy = e.realizedPolicy.L4Policy
+		realizedStats = e.realizedPolicy.PolicyMapState.GetStatisticsModel()
+		realizedStats.Revision = int64(e.policyRevision)
 	}
 
 	mdl := &models.EndpointPolicy{
@@ -386,13 +392,17 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 		DeniedEgressIdentities:   realizedDenyEgressIdentities,
 		L4:                       realizedL4Policy.GetModel(),
 		PolicyEnabled:            policyEnabled,
+		Statistics:               realizedStats,
 	}
 
 	var (
 		desiredL4Policy *policy.L4Policy
+		desiredStats    *models.PolicyMapStatistics
 	)
 	if e.desiredPolicy != nil {
 		desiredL4Policy = e.desiredPolicy.L4Policy
+		desiredStats = e.desiredPolicy.PolicyMapState.GetStatisticsModel()
+		desiredStats.Revision = int64(e.nextPolicyRevision)
 	}
 
 	desiredMdl := &models.EndpointPolicy{
@@ -406,6 +416,7 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 		DeniedEgressIdentities:   desiredDenyEgressIdentities,
 		L4:                       desiredL4Policy.GetModel(),
 		PolicyEnabled:            policyEnabled,
+		Statistics:               desiredStats,
 	}
 	// FIXME GH-3280 Once we start returning revisions Realized should be the
 	// policy implemented in the data path
@@ -26,6 +26,7 @@ import (
 	"github.com/cilium/cilium/pkg/completion"
 	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/endpoint/regeneration"
+	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/loadinfo"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maps/bwmap"
@@ -34,6 +35,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/lxcmap"
 	"github.com/cilium/cilium/pkg/maps/policymap"
 	"github.com/cilium/cilium/pkg/metrics"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/policy/trafficdirection"
@@ -261,13 +263,17 @@ func (e *Endpoint) addNewRedirectsFromDesiredPolicy(ingress bool, desiredRedirec
 				if e.realizedRedirects == nil {
 					e.realizedRedirects = make(map[string]uint16)
 				}
-				if _, found := e.realizedRedirects[proxyID]; !found {
+				prevPort, found := e.realizedRedirects[proxyID]
+				if !found {
 					revertStack.Push(func() error {
 						delete(e.realizedRedirects, proxyID)
 						return nil
 					})
 				}
 				e.realizedRedirects[proxyID] = redirectPort
+				if !found || prevPort != redirectPort {
+					e.LogStatusOKLocked(Policy, fmt.Sprintf("Installed proxy redirect %s on port %d", proxyID, redirectPort))
+				}
 
 				desiredRedirects[proxyID] = true
 
@@ -387,13 +393,17 @@ func (e *Endpoint) addVisibilityRedirects(ingress bool, desiredRedirects map[str
 		if e.realizedRedirects == nil {
 			e.realizedRedirects = make(map[string]uint16)
 		}
-		if _, found := e.realizedRedirects[proxyID]; !found {
+		prevPort, found := e.realizedRedirects[proxyID]
+		if !found {
 			revertStack.Push(func() error {
 				delete(e.realizedRedirects, proxyID)
 				return nil
 			})
 		}
 		e.realizedRedirects[proxyID] = redirectPort
+		if !found || prevPort != redirectPort {
+			e.LogStatusOKLocked(Policy, fmt.Sprintf("Installed visibility proxy redirect %s on port %d", proxyID, redirectPort))
+		}
 
 		desiredRedirects[proxyID] = true
 
@@ -508,6 +518,7 @@ func (e *Endpoint) removeOldRedirects(desiredRedirects map[string]bool, proxyWai
 
 		delete(e.realizedRedirects, id)
 		removedRedirects[id] = redirectPort
+		e.LogStatusOKLocked(Policy, fmt.Sprintf("Removed proxy redirect %s from port %d", id, redirectPort))
 
 		// Update the endpoint API model to report that no redirect is
 		// active or known for that port anymore. We never delete stats
@@ -707,6 +718,10 @@ func (e *Endpoint) realizeBPFState(regenContext *regenerationContext) (compilati
 		}
 
 		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				e.emitK8sEvent("DatapathLoadFailed",
+					fmt.Sprintf("Failed to load the endpoint datapath program: %s", err))
+			}
 			return compilationExecuted, err
 		}
 		e.bpfHeaderfileHash = datapathRegenCtxt.bpfHeaderfilesHash
@@ -995,8 +1010,10 @@ func (e *Endpoint) deleteMaps() []error {
 // garbageCollectConntrack will run the ctmap.GC() on either the endpoint's
 // local conntrack table or the global conntrack table.
 //
+// It returns how many entries were deleted.
+//
 // The endpoint lock must be held
-func (e *Endpoint) garbageCollectConntrack(filter *ctmap.GCFilter) {
+func (e *Endpoint) garbageCollectConntrack(filter *ctmap.GCFilter) (deleted int) {
 	var maps []*ctmap.Map
 
 	if e.ConntrackLocalLocked() {
@@ -1017,8 +1034,10 @@ func (e *Endpoint) garbageCollectConntrack(filter *ctmap.GCFilter) {
 		}
 		defer m.Close()
 
-		ctmap.GC(m, filter)
+		deleted += ctmap.GC(m, filter)
 	}
+
+	return deleted
 }
 
 func (e *Endpoint) scrubIPsInConntrackTableLocked() {
@@ -1036,6 +1055,72 @@ func (e *Endpoint) scrubIPsInConntrackTable() {
 	e.unlock()
 }
 
+// flushDeniedConntrackEntries removes the conntrack and NAT entries of
+// established connections between this endpoint and peers covered by the
+// given policy map keys, so that a newly added deny policy or removed allow
+// takes effect without waiting for the entries to expire.
+//
+// The endpoint lock must be held.
+func (e *Endpoint) flushDeniedConntrackEntries(denied []policy.Key) {
+	if len(denied) == 0 {
+		return
+	}
+
+	epIPs := make(map[netip.Addr]struct{}, 2)
+	if e.IPv4.IsValid() {
+		epIPs[e.IPv4] = struct{}{}
+	}
+	if e.IPv6.IsValid() {
+		epIPs[e.IPv6] = struct{}{}
+	}
+	if len(epIPs) == 0 {
+		return
+	}
+
+	flushAll := false
+	peerIPs := make(map[netip.Addr]struct{})
+	for _, key := range denied {
+		// A wildcard identity covers all peers, flush all of the
+		// endpoint's entries.
+		if key.Identity == 0 {
+			flushAll = true
+			break
+		}
+		for _, ipStr := range e.namedPortsGetter.LookupByIdentity(identity.NumericIdentity(key.Identity)) {
+			// CIDR identities map to prefixes which cannot be matched
+			// against individual conntrack entries and are skipped.
+			if addr, err := netip.ParseAddr(ipStr); err == nil {
+				peerIPs[addr] = struct{}{}
+			}
+		}
+	}
+
+	filter := &ctmap.GCFilter{MatchIPs: epIPs}
+	if !flushAll {
+		if len(peerIPs) == 0 {
+			return
+		}
+		filter.MatchPeerIPs = peerIPs
+	}
+
+	if deleted := e.garbageCollectConntrack(filter); deleted > 0 {
+		e.LogStatusOKLocked(Policy, fmt.Sprintf("Policy change flushed %d conntrack entries of no longer allowed connections", deleted))
+		e.notifyConnectionsTerminated(deleted, monitorAPI.ConnectionsTerminatedReasonPolicy)
+	}
+}
+
+// notifyConnectionsTerminated emits a monitor agent event for established
+// connections this endpoint had that were actively terminated by the agent,
+// so that policy kills can be told apart from application failures.
+//
+// The endpoint lock must be held to read the policy revision.
+func (e *Endpoint) notifyConnectionsTerminated(count int, reason string) {
+	err := e.owner.SendNotification(monitorAPI.ConnectionsTerminatedMessage(e.GetID(), count, reason, e.policyRevision))
+	if err != nil {
+		e.getLogger().WithError(err).Warn("Notifying monitor about terminated connections failed")
+	}
+}
+
 // SkipStateClean can be called on a endpoint before its first build to skip
 // the cleaning of state such as the conntrack table. This is useful when an
 // endpoint is being restored from state and the datapath state should not be
@@ -1126,15 +1211,17 @@ func (e *Endpoint) addPolicyKey(keyToAdd policy.Key, entry policy.MapStateEntry,
 
 	var err error
 	if entry.IsDeny {
-		err = e.policyMap.DenyKey(policymapKey)
+		err = e.policyMap.DenyKey(policymapKey, entry.IsAudit)
 	} else {
-		err = e.policyMap.AllowKey(policymapKey, entry.ProxyPort)
+		err = e.policyMap.AllowKey(policymapKey, entry.ProxyPort, entry.IsAudit)
 	}
 	if err != nil {
 		e.getLogger().WithError(err).WithFields(logrus.Fields{
 			logfields.BPFMapKey: policymapKey,
 			logfields.Port:      entry.ProxyPort,
 		}).Error("Failed to add PolicyMap key")
+		e.emitK8sEvent("PolicyMapUpdateFailed",
+			fmt.Sprintf("Failed to update the endpoint policy map, policy enforcement may be incomplete: %s", err))
 		return false
 	}
 
@@ -1289,9 +1376,16 @@ func (e *Endpoint) syncPolicyMap() error {
 func (e *Endpoint) syncPolicyMapsWith(realized policy.MapState, withDiffs bool) (diffCount int, diffs []policy.MapChange, err error) {
 	errors := 0
 
+	// Collect the keys of connections that are no longer allowed so that
+	// their conntrack entries can be flushed below.
+	var denied []policy.Key
+
 	// Add policy map entries before deleting to avoid transient drops
 	for keyToAdd, entry := range e.desiredPolicy.PolicyMapState {
 		if oldEntry, ok := realized[keyToAdd]; !ok || !oldEntry.DatapathEqual(&entry) {
+			if option.Config.EnablePolicyConntrackFlush && entry.IsDeny && (!ok || !oldEntry.IsDeny) {
+				denied = append(denied, keyToAdd)
+			}
 			// Redirect entries currently come in with a dummy redirect port ("1"), replace it with
 			// the actual proxy port number. This is due to the fact that proxies may not yet have
 			// bound to a specific port when a proxy policy is first instantiated.
@@ -1310,9 +1404,12 @@ func (e *Endpoint) syncPolicyMapsWith(realized policy.MapState, withDiffs bool)
 	}
 
 	// Delete policy keys present in the realized state, but not present in the desired state
-	for keyToDelete := range realized {
+	for keyToDelete, realizedEntry := range realized {
 		// If key that is in realized state is not in desired state, just remove it.
 		if entry, ok := e.desiredPolicy.PolicyMapState[keyToDelete]; !ok {
+			if option.Config.EnablePolicyConntrackFlush && !realizedEntry.IsDeny {
+				denied = append(denied, keyToDelete)
+			}
 			if !e.deletePolicyKey(keyToDelete, false, nil) {
 				errors++
 			}
@@ -1323,6 +1420,8 @@ func (e *Endpoint) syncPolicyMapsWith(realized policy.MapState, withDiffs bool)
 		}
 	}
 
+	e.flushDeniedConntrackEntries(denied)
+
 	if errors > 0 {
 		err = fmt.Errorf("syncPolicyMap failed")
 	}
@@ -1356,6 +1455,48 @@ func (e *Endpoint) dumpPolicyMapToMapState() (policy.MapState, error) {
 	return currentMap, err
 }
 
+// GetNetworkStats sums up the traffic counters of the endpoint's BPF policy
+// map into per-direction byte, packet and drop counts. Allow entries account
+// for forwarded traffic, deny entries for dropped traffic. The counters are
+// accumulated since the endpoint's policy map was last created.
+func (e *Endpoint) GetNetworkStats() (*models.EndpointNetworkStats, error) {
+	if err := e.rlockAlive(); err != nil {
+		return nil, err
+	}
+	defer e.runlock()
+
+	if e.policyMap == nil {
+		return nil, fmt.Errorf("policy map of endpoint %d not loaded", e.ID)
+	}
+
+	stats := &models.EndpointNetworkStats{}
+	cb := func(key bpf.MapKey, value bpf.MapValue) {
+		policymapKey := key.(*policymap.PolicyKey)
+		entry := value.(*policymap.PolicyEntry).ToHost()
+		isDeny := policymap.PolicyEntryFlags(entry.GetFlags()).IsDeny()
+		if policymapKey.TrafficDirection == trafficdirection.Ingress.Uint8() {
+			if isDeny {
+				stats.RxDrops += int64(entry.Packets)
+			} else {
+				stats.RxBytes += int64(entry.Bytes)
+				stats.RxPackets += int64(entry.Packets)
+			}
+		} else {
+			if isDeny {
+				stats.TxDrops += int64(entry.Packets)
+			} else {
+				stats.TxBytes += int64(entry.Bytes)
+				stats.TxPackets += int64(entry.Packets)
+			}
+		}
+	}
+	if err := e.policyMap.DumpWithCallback(cb); err != nil {
+		return nil, fmt.Errorf("unable to dump policy map of endpoint %d: %w", e.ID, err)
+	}
+
+	return stats, nil
+}
+
 // syncPolicyMapWithDump attempts to synchronize the PolicyMap for this endpoint to
 // contain the set of PolicyKeys represented by the endpoint's desiredMapState.
 // It checks the current contents of the endpoint's PolicyMap and deletes any
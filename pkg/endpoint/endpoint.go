@@ -38,6 +38,7 @@ import (
 	"github.com/cilium/cilium/pkg/identity/cache"
 	"github.com/cilium/cilium/pkg/identity/identitymanager"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
+	k8sEvents "github.com/cilium/cilium/pkg/k8s/events"
 	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/labelsfilter"
@@ -51,6 +52,7 @@ import (
 	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/monitor/notifications"
 	"github.com/cilium/cilium/pkg/node"
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/policy/trafficdirection"
@@ -131,6 +133,10 @@ type Endpoint struct {
 	// containerID is the container ID that docker has assigned to the endpoint
 	containerID string
 
+	// netNSPath is the path to the network namespace of the endpoint, when
+	// provided at endpoint creation. It is not persisted across restarts.
+	netNSPath string
+
 	// dockerNetworkID is the network ID of the libnetwork network if the
 	// endpoint is a docker managed container which uses libnetwork
 	dockerNetworkID string
@@ -196,6 +202,11 @@ type Endpoint struct {
 	// status contains the last n state transitions this endpoint went through
 	status *EndpointStatus
 
+	// lastRegenerationStats describes the most recent completed
+	// regeneration of this endpoint, attributing it to the triggering
+	// reason and breaking its duration down by phase.
+	lastRegenerationStats *models.EndpointRegenerationStatistics
+
 	// DNSRules is the collection of current endpoint-specific DNS proxy
 	// rules. These can be restored during Cilium restart.
 	DNSRules restore.DNSRules
@@ -209,6 +220,10 @@ type Endpoint struct {
 	// confirm that no existing connection is using them.
 	DNSZombies *fqdn.DNSZombieMappings
 
+	// DNSStats aggregates DNS resolution statistics of this endpoint as
+	// observed by the DNS proxy. It is not persisted across restarts.
+	DNSStats *fqdn.DNSProxyStats
+
 	// dnsHistoryTrigger is the trigger to write down the ep_config.h to make
 	// sure that restores when DNS policy is in there are correct
 	dnsHistoryTrigger *trigger.Trigger
@@ -342,6 +357,7 @@ type Endpoint struct {
 
 type namedPortsGetter interface {
 	GetNamedPorts() (npm policy.NamedPortMultiMap)
+	LookupByIdentity(id identity.NumericIdentity) []string
 }
 
 type policyRepoGetter interface {
@@ -452,8 +468,9 @@ func createEndpoint(owner regeneration.Owner, policyGetter policyRepoGetter, nam
 		ifName:           ifName,
 		OpLabels:         labels.NewOpLabels(),
 		DNSRules:         nil,
-		DNSHistory:       fqdn.NewDNSCacheWithLimit(option.Config.ToFQDNsMinTTL, option.Config.ToFQDNsMaxIPsPerHost),
+		DNSHistory:       fqdn.NewDNSCacheWithLimits(option.Config.ToFQDNsMinTTL, option.Config.ToFQDNsMaxIPsPerHost, option.Config.ToFQDNsEndpointMaxEntries, option.Config.ToFQDNsCacheEvictionPolicy),
 		DNSZombies:       fqdn.NewDNSZombieMappings(option.Config.ToFQDNsMaxDeferredConnectionDeletes, option.Config.ToFQDNsMaxIPsPerHost),
+		DNSStats:         fqdn.NewDNSProxyStats(),
 		state:            "",
 		status:           NewEndpointStatus(),
 		hasBPFProgram:    make(chan struct{}, 0),
@@ -816,6 +833,9 @@ func parseEndpoint(ctx context.Context, owner regeneration.Owner, policyGetter p
 		return nil, fmt.Errorf("failed to parse restored endpoint: %s", err)
 	}
 
+	// DNS statistics are not persisted, restored endpoints start over.
+	ep.DNSStats = fqdn.NewDNSProxyStats()
+
 	ep.initDNSHistoryTrigger()
 
 	// Validate the options that were parsed
@@ -982,7 +1002,21 @@ func (e *Endpoint) Update(cfg *models.EndpointConfigurationSpec) error {
 		regenCtx.RegenerationLevel = regeneration.RegenerateWithDatapathRewrite
 	}
 
-	if regenCtx.RegenerationLevel > regeneration.RegenerateWithoutDatapath {
+	// An explicitly requested regeneration level overrides the level derived
+	// above and always triggers a regeneration.
+	regenerate := regenCtx.RegenerationLevel > regeneration.RegenerateWithoutDatapath
+	switch cfg.RegenerationLevel {
+	case models.EndpointConfigurationSpecRegenerationLevelPolicyOnly:
+		regenCtx.RegenerationLevel = regeneration.RegenerateWithoutDatapath
+		regenCtx.Reason = "endpoint policy was manually regenerated via API"
+		regenerate = true
+	case models.EndpointConfigurationSpecRegenerationLevelDatapathRebuild:
+		regenCtx.RegenerationLevel = regeneration.RegenerateWithDatapathRebuild
+		regenCtx.Reason = "endpoint was manually regenerated via API"
+		regenerate = true
+	}
+
+	if regenerate {
 		e.getLogger().Debug("need to regenerate endpoint; checking state before" +
 			" attempting to regenerate")
 
@@ -1175,6 +1209,26 @@ func (e *Endpoint) GetK8sNamespace() string {
 	return ns
 }
 
+// GetNetNSPath returns the path to the network namespace of the endpoint, or
+// an empty string if it was not provided at endpoint creation.
+func (e *Endpoint) GetNetNSPath() string {
+	e.unconditionalRLock()
+	path := e.netNSPath
+	e.runlock()
+	return path
+}
+
+// emitK8sEvent attaches a warning event to the pod backing the endpoint, or
+// to the local node for endpoints which do not represent a pod, such as the
+// host endpoint. The event is discarded when Kubernetes support is disabled.
+func (e *Endpoint) emitK8sEvent(reason, message string) {
+	if ns, pod := e.GetK8sNamespace(), e.GetK8sPodName(); ns != "" && pod != "" {
+		k8sEvents.Warning(k8sEvents.PodRef(ns, pod), reason, message)
+		return
+	}
+	k8sEvents.Warning(k8sEvents.NodeRef(nodeTypes.GetName()), reason, message)
+}
+
 // SetPod sets the pod related to this endpoint.
 func (e *Endpoint) SetPod(pod *slim_corev1.Pod) {
 	e.unconditionalLock()
@@ -1611,7 +1665,9 @@ func (e *Endpoint) RunMetadataResolver(resolveMetadata MetadataResolverCB) {
 					if err != nil {
 						return "", err
 					}
-					return annotations[annotation.ProxyVisibility], nil
+					return policy.MergeVisibilityAnnotations(
+						annotations[annotation.ProxyVisibility],
+						annotations[annotation.DNSVisibility]), nil
 				})
 				e.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress string, err error) {
 					_, _, _, _, annotations, err := resolveMetadata(ns, podName)
@@ -1874,6 +1930,8 @@ func (e *Endpoint) identityLabelsChanged(ctx context.Context, myChangeRev int) (
 	if err != nil {
 		err = fmt.Errorf("unable to resolve identity: %s", err)
 		e.LogStatus(Other, Warning, fmt.Sprintf("%s (will retry)", err.Error()))
+		e.emitK8sEvent("IdentityAllocationFailed",
+			fmt.Sprintf("Failed to allocate a security identity for the endpoint: %s", err))
 		return false, err
 	}
 
@@ -2010,6 +2068,7 @@ func (e *Endpoint) setPolicyRevision(rev uint64) {
 	e.UpdateLogger(map[string]interface{}{
 		logfields.DatapathPolicyRevision: e.policyRevision,
 	})
+	e.LogStatusOKLocked(Policy, fmt.Sprintf("Policy revision updated to %d", rev))
 	for ps := range e.policyRevisionSignals {
 		select {
 		case <-ps.ctx.Done():
@@ -16,6 +16,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/completion"
 	"github.com/cilium/cilium/pkg/controller"
 	endpointid "github.com/cilium/cilium/pkg/endpoint/id"
@@ -473,6 +474,18 @@ func (e *Endpoint) updateRegenerationStatistics(ctx *regenerationContext, err er
 	e.runlock()
 	stats.SendMetrics()
 
+	statsMap := stats.GetMap()
+	regenStats := &models.EndpointRegenerationStatistics{
+		Reason:         ctx.Reason,
+		PhaseDurations: make(map[string]float64, len(statsMap)),
+	}
+	for field, stat := range statsMap {
+		regenStats.PhaseDurations[field] = stat.Total().Seconds()
+	}
+	e.mutex.Lock()
+	e.lastRegenerationStats = regenStats
+	e.mutex.Unlock()
+
 	fields := logrus.Fields{
 		logfields.Reason: ctx.Reason,
 	}
@@ -492,7 +492,7 @@ func (ep *Endpoint) UnmarshalJSON(raw []byte) error {
 	// translation from serializableEndpoint --> Endpoint.
 	restoredEp := &serializableEndpoint{
 		OpLabels:   labels.NewOpLabels(),
-		DNSHistory: fqdn.NewDNSCacheWithLimit(option.Config.ToFQDNsMinTTL, option.Config.ToFQDNsMaxIPsPerHost),
+		DNSHistory: fqdn.NewDNSCacheWithLimits(option.Config.ToFQDNsMinTTL, option.Config.ToFQDNsMaxIPsPerHost, option.Config.ToFQDNsEndpointMaxEntries, option.Config.ToFQDNsCacheEvictionPolicy),
 		DNSZombies: fqdn.NewDNSZombieMappings(option.Config.ToFQDNsMaxDeferredConnectionDeletes, option.Config.ToFQDNsMaxIPsPerHost),
 	}
 	if err := json.Unmarshal(raw, restoredEp); err != nil {
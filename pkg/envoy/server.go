@@ -5,6 +5,7 @@ package envoy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
@@ -29,6 +30,7 @@ import (
 	envoy_config_upstream "github.com/cilium/proxy/go/envoy/extensions/upstreams/http/v3"
 	envoy_type_matcher "github.com/cilium/proxy/go/envoy/type/matcher/v3"
 	"golang.org/x/sys/unix"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -79,6 +81,11 @@ type Listener struct {
 	// must hold the XDSServer.mutex when accessing 'count'
 	count uint
 
+	// conf regenerates the listener configuration, so that it can be
+	// re-published when listener-level settings change.
+	// must hold the XDSServer.mutex when accessing 'conf'
+	conf func() *envoy_config_listener.Listener
+
 	// mutex is needed when accessing the fields below.
 	// XDSServer.mutex is not needed, but if taken it must be taken before 'mutex'
 	mutex   lock.RWMutex
@@ -119,12 +126,23 @@ type XDSServer struct {
 	// Manages it's own locking
 	secretMutator xds.AckingResourceMutator
 
+	// resourceCaches maps each xDS type URL to the cache holding the
+	// resources currently published on that channel. Only read by
+	// GetXDSResourceGroups.
+	resourceCaches map[string]*xds.Cache
+
 	// listeners is the set of names of listeners that have been added by
 	// calling AddListener.
 	// mutex must be held when accessing this.
 	// Value holds the number of redirects using the listener named by the key.
 	listeners map[string]*Listener
 
+	// listenerHTTPRetryPolicies are the per-listener overrides of the
+	// agent-wide HTTP retry settings, configured through the retry field
+	// of L7 HTTP policy rules.
+	// mutex must be held when accessing this.
+	listenerHTTPRetryPolicies map[string]*api.HTTPRetryPolicy
+
 	// proxyListeners is the count of redirection proxy listeners in 'listeners'.
 	// When this is zero, cilium should not wait for NACKs/ACKs from envoy.
 	// This value is different from len(listeners) due to non-proxy listeners
@@ -239,14 +257,23 @@ func StartXDSServer(ipcache IPCacheEventSource, stateDir string) *XDSServer {
 	}, 5*time.Second)
 
 	return &XDSServer{
-		socketPath:             xdsPath,
-		accessLogPath:          getAccessLogPath(stateDir),
-		listenerMutator:        ldsMutator,
-		listeners:              make(map[string]*Listener),
-		routeMutator:           rdsMutator,
-		clusterMutator:         cdsMutator,
-		endpointMutator:        edsMutator,
-		secretMutator:          sdsMutator,
+		socketPath:                xdsPath,
+		accessLogPath:             getAccessLogPath(stateDir),
+		listenerMutator:           ldsMutator,
+		listeners:                 make(map[string]*Listener),
+		listenerHTTPRetryPolicies: make(map[string]*api.HTTPRetryPolicy),
+		routeMutator:              rdsMutator,
+		clusterMutator:            cdsMutator,
+		endpointMutator:           edsMutator,
+		secretMutator:             sdsMutator,
+		resourceCaches: map[string]*xds.Cache{
+			ListenerTypeURL:      ldsCache,
+			RouteTypeURL:         rdsCache,
+			ClusterTypeURL:       cdsCache,
+			EndpointTypeURL:      edsCache,
+			SecretTypeURL:        sdsCache,
+			NetworkPolicyTypeURL: npdsCache,
+		},
 		networkPolicyCache:     npdsCache,
 		NetworkPolicyMutator:   npdsMutator,
 		networkPolicyEndpoints: make(map[string]logger.EndpointUpdater),
@@ -266,12 +293,35 @@ func getCiliumHttpFilter() *envoy_config_http.HttpFilter {
 	}
 }
 
-func (s *XDSServer) getHttpFilterChainProto(clusterName string, tls bool) *envoy_config_listener.FilterChain {
+// getHTTPRetryPolicy returns the retry policy for the HTTP routes of a proxy
+// listener, built from the given per-listener policy override. Fields not set
+// in the override fall back to the agent-wide defaults.
+func getHTTPRetryPolicy(retry *api.HTTPRetryPolicy) *envoy_config_route.RetryPolicy {
+	retryOn := "5xx"
+	numRetries := uint32(option.Config.HTTPRetryCount)
+	retryTimeout := int64(option.Config.HTTPRetryTimeout) // seconds
+	if retry != nil {
+		if retry.RetryOn != "" {
+			retryOn = retry.RetryOn
+		}
+		if retry.NumRetries != 0 {
+			numRetries = retry.NumRetries
+		}
+		if retry.PerTryTimeout != 0 {
+			retryTimeout = int64(retry.PerTryTimeout)
+		}
+	}
+	return &envoy_config_route.RetryPolicy{
+		RetryOn:       retryOn,
+		NumRetries:    &wrapperspb.UInt32Value{Value: numRetries},
+		PerTryTimeout: &durationpb.Duration{Seconds: retryTimeout},
+	}
+}
+
+func (s *XDSServer) getHttpFilterChainProto(clusterName string, tls bool, retry *api.HTTPRetryPolicy) *envoy_config_listener.FilterChain {
 	requestTimeout := int64(option.Config.HTTPRequestTimeout) // seconds
 	idleTimeout := int64(option.Config.HTTPIdleTimeout)       // seconds
 	maxGRPCTimeout := int64(option.Config.HTTPMaxGRPCTimeout) // seconds
-	numRetries := uint32(option.Config.HTTPRetryCount)
-	retryTimeout := int64(option.Config.HTTPRetryTimeout) //seconds
 
 	hcmConfig := &envoy_config_http.HttpConnectionManager{
 		StatPrefix: "proxy",
@@ -301,11 +351,7 @@ func (s *XDSServer) getHttpFilterChainProto(clusterName string, tls bool) *envoy
 								MaxStreamDuration: &envoy_config_route.RouteAction_MaxStreamDuration{
 									GrpcTimeoutHeaderMax: &durationpb.Duration{Seconds: maxGRPCTimeout},
 								},
-								RetryPolicy: &envoy_config_route.RetryPolicy{
-									RetryOn:       "5xx",
-									NumRetries:    &wrapperspb.UInt32Value{Value: numRetries},
-									PerTryTimeout: &durationpb.Duration{Seconds: retryTimeout},
-								},
+								RetryPolicy: getHTTPRetryPolicy(retry),
 							},
 						},
 					}, {
@@ -319,11 +365,7 @@ func (s *XDSServer) getHttpFilterChainProto(clusterName string, tls bool) *envoy
 								},
 								Timeout: &durationpb.Duration{Seconds: requestTimeout},
 								//IdleTimeout: &durationpb.Duration{Seconds: idleTimeout},
-								RetryPolicy: &envoy_config_route.RetryPolicy{
-									RetryOn:       "5xx",
-									NumRetries:    &wrapperspb.UInt32Value{Value: numRetries},
-									PerTryTimeout: &durationpb.Duration{Seconds: retryTimeout},
-								},
+								RetryPolicy: getHTTPRetryPolicy(retry),
 							},
 						},
 					}},
@@ -533,6 +575,7 @@ func (s *XDSServer) addListener(name string, listenerConf func() *envoy_config_l
 			s.proxyListeners++
 		}
 	}
+	listener.conf = listenerConf
 	listener.count++
 	listener.mutex.Lock() // needed for other than 'count'
 	if listener.count > 1 && !listener.nacked {
@@ -743,14 +786,16 @@ func (s *XDSServer) getListenerConf(name string, kind policy.L7ParserType, port
 			Name: "envoy.filters.listener.tls_inspector",
 		}}, listenerConf.ListenerFilters...)
 
-		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getHttpFilterChainProto(clusterName, false))
+		retry := s.listenerHTTPRetryPolicies[name]
+
+		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getHttpFilterChainProto(clusterName, false, retry))
 
 		// Add a TLS variant
 		tlsClusterName := egressTLSClusterName
 		if isIngress {
 			tlsClusterName = ingressTLSClusterName
 		}
-		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getHttpFilterChainProto(tlsClusterName, true))
+		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getHttpFilterChainProto(tlsClusterName, true, retry))
 	} else {
 		// Default TCP chain, takes care of all parsers in proxylib
 		listenerConf.FilterChains = append(listenerConf.FilterChains, s.getTcpFilterChainProto(clusterName, "", nil))
@@ -780,6 +825,45 @@ func (s *XDSServer) AddListener(name string, kind policy.L7ParserType, port uint
 	}, wg, nil, true)
 }
 
+// SetListenerHTTPRetryPolicy overrides the retry policy of the HTTP routes
+// generated for the proxy listener with the given name. A nil retry reverts
+// the listener to the agent-wide retry defaults. The listener configuration
+// is re-published when the effective settings change.
+func (s *XDSServer) SetListenerHTTPRetryPolicy(name string, retry *api.HTTPRetryPolicy, wg *completion.WaitGroup) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	old, hadOverride := s.listenerHTTPRetryPolicies[name]
+	if retry == nil {
+		if !hadOverride {
+			return
+		}
+		delete(s.listenerHTTPRetryPolicies, name)
+	} else {
+		if hadOverride && old.DeepEqual(retry) {
+			return
+		}
+		s.listenerHTTPRetryPolicies[name] = retry
+	}
+
+	listener := s.listeners[name]
+	if listener == nil || listener.conf == nil {
+		return
+	}
+
+	listenerConfig := listener.conf()
+	if option.Config.EnableBPFTProxy {
+		// See addListener for why SO_REUSEPORT must stay disabled.
+		listenerConfig.EnableReusePort = &wrapperspb.BoolValue{Value: false}
+	}
+	if err := listenerConfig.Validate(); err != nil {
+		log.Errorf("Envoy: Could not validate Listener (%s): %s", err, listenerConfig.String())
+		return
+	}
+
+	s.listenerMutator.Upsert(ListenerTypeURL, name, listenerConfig, []string{"127.0.0.1"}, wg, nil)
+}
+
 // RemoveListener removes an existing Envoy Listener.
 func (s *XDSServer) RemoveListener(name string, wg *completion.WaitGroup) xds.AckingResourceMutatorRevertFunc {
 	return s.removeListener(name, wg, true)
@@ -800,6 +884,7 @@ func (s *XDSServer) removeListener(name string, wg *completion.WaitGroup, isProx
 				s.proxyListeners--
 			}
 			delete(s.listeners, name)
+			delete(s.listenerHTTPRetryPolicies, name)
 			listenerRevertFunc = s.listenerMutator.Delete(ListenerTypeURL, name, []string{"127.0.0.1"}, wg, nil)
 		}
 	} else {
@@ -1764,6 +1849,93 @@ func (s *XDSServer) GetNetworkPolicies(resourceNames []string) (map[string]*cili
 	return networkPolicies, nil
 }
 
+// XDSResourceGroup contains the JSON representation of the resources of a
+// single xDS type, together with the cache version they were read at.
+type XDSResourceGroup struct {
+	TypeURL   string
+	Version   uint64
+	Resources []map[string]interface{}
+}
+
+// xdsResourceGroupTypeURLs is the list of xDS type URLs included in the
+// resource dump, in the order they are reported.
+var xdsResourceGroupTypeURLs = []string{
+	ListenerTypeURL,
+	RouteTypeURL,
+	ClusterTypeURL,
+	EndpointTypeURL,
+	SecretTypeURL,
+	NetworkPolicyTypeURL,
+}
+
+// redactedXDSKeys are the JSON keys whose values are replaced in the resource
+// dump, as they may carry key material or credentials inlined into non-secret
+// resources.
+var redactedXDSKeys = map[string]struct{}{
+	"inlineBytes":  {},
+	"inlineString": {},
+	"password":     {},
+	"privateKey":   {},
+}
+
+// redactXDSResource recursively replaces the values of sensitive keys in the
+// decoded JSON representation of an xDS resource.
+func redactXDSResource(resource interface{}) {
+	switch v := resource.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, redact := redactedXDSKeys[key]; redact {
+				v[key] = "[redacted]"
+				continue
+			}
+			redactXDSResource(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			redactXDSResource(value)
+		}
+	}
+}
+
+// GetXDSResourceGroups returns the resources currently published over each
+// xDS channel, decoded into their JSON representation. Secret resources are
+// reduced to their names and sensitive fields inlined into other resources
+// are redacted.
+func (s *XDSServer) GetXDSResourceGroups(ctx context.Context) ([]*XDSResourceGroup, error) {
+	groups := make([]*XDSResourceGroup, 0, len(xdsResourceGroupTypeURLs))
+	for _, typeURL := range xdsResourceGroupTypeURLs {
+		resources, err := s.resourceCaches[typeURL].GetResources(ctx, typeURL, 0, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s resources: %w", typeURL, err)
+		}
+		group := &XDSResourceGroup{
+			TypeURL:   typeURL,
+			Version:   resources.Version,
+			Resources: make([]map[string]interface{}, 0, len(resources.Resources)),
+		}
+		for i, res := range resources.Resources {
+			if typeURL == SecretTypeURL {
+				// Secret contents must never leave the agent, report the
+				// name only.
+				group.Resources = append(group.Resources, map[string]interface{}{"name": resources.ResourceNames[i]})
+				continue
+			}
+			msg, err := protojson.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal %s resource %s: %w", typeURL, resources.ResourceNames[i], err)
+			}
+			var decoded map[string]interface{}
+			if err := json.Unmarshal(msg, &decoded); err != nil {
+				return nil, fmt.Errorf("failed to decode %s resource %s: %w", typeURL, resources.ResourceNames[i], err)
+			}
+			redactXDSResource(decoded)
+			group.Resources = append(group.Resources, decoded)
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
 // getLocalEndpoint returns the endpoint info for the local endpoint on which
 // the network policy of the given name if enforced, or nil if not found.
 func (s *XDSServer) getLocalEndpoint(endpointIP string) logger.EndpointUpdater {
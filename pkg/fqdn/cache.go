@@ -12,13 +12,27 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/go-openapi/strfmt"
+
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/fqdn/matchpattern"
 	"github.com/cilium/cilium/pkg/fqdn/re"
 	ippkg "github.com/cilium/cilium/pkg/ip"
 	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
 )
 
+const (
+	// CacheEvictionPolicyLRU evicts the names with the oldest lookups first
+	// when the cache exceeds its entry limit.
+	CacheEvictionPolicyLRU = "lru"
+
+	// CacheEvictionPolicyTTL evicts the names whose entries expire soonest
+	// first when the cache exceeds its entry limit.
+	CacheEvictionPolicyTTL = "ttl"
+)
+
 // cacheEntry objects hold data passed in via DNSCache.Update, nominally
 // equating to a DNS lookup. They are internal to DNSCache and should not be
 // returned.
@@ -45,6 +59,10 @@ type cacheEntry struct {
 
 	// IPs are the IPs associated with Name for this cacheEntry.
 	IPs []netip.Addr `json:"ips,omitempty"`
+
+	// Rcode is the DNS response code for negative entries, e.g. NXDOMAIN or
+	// SERVFAIL. It is zero (RcodeSuccess) for regular lookups.
+	Rcode int `json:"rcode,omitempty"`
 }
 
 // isExpiredBy returns true if entry is no longer valid at pointInTime
@@ -104,6 +122,11 @@ type DNSCache struct {
 	// expired in forward, should also be added/removed in reverse.
 	reverse map[netip.Addr]nameEntries
 
+	// negative maps a DNS name to the latest-expiring negative response (e.g.
+	// NXDOMAIN or SERVFAIL) received for it. Entries expire like positive
+	// data and are removed outright when a lookup for the name succeeds.
+	negative nameEntries
+
 	// LastCleanup is the latest time for which entries have been expired. It is
 	// used as "now" when doing lookups and advanced by calls to .GC
 	// When an entry is added with an expiration time before lastCleanup, it is
@@ -132,6 +155,22 @@ type DNSCache struct {
 	// perHostLimit is the number of maximum number of IP per host.
 	perHostLimit int
 
+	// maxEntries caps the number of DNS names retained in the cache. Names
+	// beyond the cap are evicted on GC according to evictionPolicy. 0
+	// disables the cap.
+	maxEntries int
+
+	// evictionPolicy selects which names are evicted first when the cache
+	// exceeds maxEntries, either CacheEvictionPolicyLRU or
+	// CacheEvictionPolicyTTL.
+	evictionPolicy string
+
+	// overTotalLimit is set when an update pushed the number of names in the
+	// cache beyond maxEntries. The excess names are evicted when
+	// cleanupTotalLimitEntries is called, but will continue to be returned
+	// by Lookup until then.
+	overTotalLimit bool
+
 	// minTTL is the minimun TTL value that a cache entry can have, if the TTL
 	// sent in the Update is lower, the TTL will be owerwritten to this value.
 	// Due is only read-only is not protected by the mutex.
@@ -141,8 +180,9 @@ type DNSCache struct {
 // NewDNSCache returns an initialized DNSCache
 func NewDNSCache(minTTL int) *DNSCache {
 	c := &DNSCache{
-		forward: make(map[string]ipEntries),
-		reverse: make(map[netip.Addr]nameEntries),
+		forward:  make(map[string]ipEntries),
+		reverse:  make(map[netip.Addr]nameEntries),
+		negative: make(nameEntries),
 		// lastCleanup is populated on the first insert
 		cleanup:      map[int64][]string{},
 		overLimit:    map[string]bool{},
@@ -160,6 +200,17 @@ func NewDNSCacheWithLimit(minTTL int, limit int) *DNSCache {
 	return c
 }
 
+// NewDNSCacheWithLimits returns an initialized DNSCache with a maximum number
+// of IPs per host and a cap on the total number of names retained. When
+// maxEntries is exceeded, whole names are evicted on GC according to
+// evictionPolicy, either CacheEvictionPolicyLRU or CacheEvictionPolicyTTL.
+func NewDNSCacheWithLimits(minTTL, perHostLimit, maxEntries int, evictionPolicy string) *DNSCache {
+	c := NewDNSCacheWithLimit(minTTL, perHostLimit)
+	c.maxEntries = maxEntries
+	c.evictionPolicy = evictionPolicy
+	return c
+}
+
 func (c *DNSCache) DisableCleanupTrack() {
 	c.Lock()
 	defer c.Unlock()
@@ -180,6 +231,13 @@ func (c *DNSCache) Update(lookupTime time.Time, name string, ips []netip.Addr, t
 		ttl = c.minTTL
 	}
 
+	return c.UpdateWithExactTTL(lookupTime, name, ips, ttl)
+}
+
+// UpdateWithExactTTL is like Update but uses ttl as-is, without raising it to
+// the cache's minimum TTL. It is used when policy clamps the TTL per ToFQDNs
+// rule, overriding the global minimum.
+func (c *DNSCache) UpdateWithExactTTL(lookupTime time.Time, name string, ips []netip.Addr, ttl int) bool {
 	entry := &cacheEntry{
 		Name:           name,
 		LookupTime:     lookupTime,
@@ -193,11 +251,70 @@ func (c *DNSCache) Update(lookupTime time.Time, name string, ips []netip.Addr, t
 	return c.updateWithEntry(entry)
 }
 
+// UpdateNegative inserts a negative response (e.g. NXDOMAIN or SERVFAIL) for
+// name into the cache. ttl should be the SOA minimum TTL of the response, per
+// RFC 2308, and is subject to the same configured minimum TTL as positive
+// data. The latest-expiring negative response is retained per name, and it is
+// removed when a lookup for the name succeeds.
+func (c *DNSCache) UpdateNegative(lookupTime time.Time, name string, rcode, ttl int) bool {
+	if c.minTTL > ttl {
+		ttl = c.minTTL
+	}
+
+	entry := &cacheEntry{
+		Name:           name,
+		LookupTime:     lookupTime,
+		ExpirationTime: lookupTime.Add(time.Duration(ttl) * time.Second),
+		TTL:            ttl,
+		Rcode:          rcode,
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if old, exists := c.negative[name]; exists && entry.ExpirationTime.Before(old.ExpirationTime) {
+		return false
+	}
+	c.negative[name] = entry
+	c.addNameToCleanup(entry)
+	return true
+}
+
+// LookupNegative returns the DNS response code of the unexpired negative
+// response cached for name, if any exists.
+func (c *DNSCache) LookupNegative(name string) (rcode int, exists bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	entry, exists := c.negative[name]
+	if !exists || entry.isExpiredBy(c.lastCleanup) {
+		return 0, false
+	}
+	return entry.Rcode, true
+}
+
+// DumpNegative returns the unexpired negative entries in the cache. These
+// objects should not be modified.
+func (c *DNSCache) DumpNegative() (lookups []*cacheEntry) {
+	c.RLock()
+	defer c.RUnlock()
+
+	for _, entry := range c.negative {
+		if !entry.isExpiredBy(c.lastCleanup) {
+			lookups = append(lookups, entry)
+		}
+	}
+	return lookups
+}
+
 // updateWithEntry implements the insertion of a cacheEntry. It is used by
 // DNSCache.Update and DNSCache.UpdateWithEntry.
 // This needs a write lock
 func (c *DNSCache) updateWithEntry(entry *cacheEntry) bool {
 	changed := false
+
+	// A successful lookup replaces any cached negative response for the name.
+	delete(c.negative, entry.Name)
 	entries, exists := c.forward[entry.Name]
 	if !exists {
 		changed = true
@@ -212,6 +329,10 @@ func (c *DNSCache) updateWithEntry(entry *cacheEntry) bool {
 	if c.perHostLimit > 0 && len(entries) > c.perHostLimit {
 		c.overLimit[entry.Name] = true
 	}
+
+	if c.maxEntries > 0 && len(c.forward) > c.maxEntries {
+		c.overTotalLimit = true
+	}
 	return changed
 }
 
@@ -261,6 +382,9 @@ func (c *DNSCache) cleanupExpiredEntries(expires time.Time) (affectedNames []str
 				removed[ip] = append(removed[ip], entry)
 			}
 		}
+		if entry, exists := c.negative[name]; exists && entry.isExpiredBy(c.lastCleanup) {
+			delete(c.negative, name)
+		}
 	}
 
 	return affectedNames, removed
@@ -305,12 +429,79 @@ func (c *DNSCache) cleanupOverLimitEntries() (affectedNames []string, removed ma
 			c.remove(key.ip, key.entry)
 			removed[key.ip] = append(removed[key.ip], key.entry)
 		}
+		metrics.FQDNCacheEvictionsTotal.WithLabelValues(cacheEvictionPerHostLimit).Add(float64(overlimit))
 		affectedNames = append(affectedNames, dnsName)
 	}
 	c.overLimit = map[string]bool{}
 	return affectedNames, removed
 }
 
+// cacheEvictionPerHostLimit is the metrics label value accounting IPs evicted
+// by the per-host IP limit, next to the CacheEvictionPolicy* values used for
+// names evicted by the cache entry limit.
+const cacheEvictionPerHostLimit = "per-host-limit"
+
+// cleanupTotalLimitEntries evicts whole names when the cache retains more
+// than maxEntries names, according to the configured eviction policy. It
+// returns the evicted names and their removed entries, keyed by IP.
+func (c *DNSCache) cleanupTotalLimitEntries() (affectedNames []string, removed map[netip.Addr][]*cacheEntry) {
+	if c.maxEntries == 0 || !c.overTotalLimit {
+		return nil, nil
+	}
+	c.overTotalLimit = false
+
+	overlimit := len(c.forward) - c.maxEntries
+	if overlimit <= 0 {
+		return nil, nil
+	}
+
+	// Rank each name by the newest lookup (LRU) or latest expiration (TTL)
+	// among its entries, evicting the lowest-ranked names first.
+	type nameRank struct {
+		name string
+		rank time.Time
+	}
+	ranked := make([]nameRank, 0, len(c.forward))
+	for name, entries := range c.forward {
+		var rank time.Time
+		for _, entry := range entries {
+			switch c.evictionPolicy {
+			case CacheEvictionPolicyTTL:
+				if entry.ExpirationTime.After(rank) {
+					rank = entry.ExpirationTime
+				}
+			default:
+				if entry.LookupTime.After(rank) {
+					rank = entry.LookupTime
+				}
+			}
+		}
+		ranked = append(ranked, nameRank{name, rank})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		return ranked[i].rank.Before(ranked[j].rank)
+	})
+
+	removed = make(map[netip.Addr][]*cacheEntry)
+	for i := 0; i < overlimit; i++ {
+		name := ranked[i].name
+		for ip, entry := range c.forward[name] {
+			c.remove(ip, entry)
+			removed[ip] = append(removed[ip], entry)
+		}
+		delete(c.forward, name)
+		delete(c.overLimit, name)
+		affectedNames = append(affectedNames, name)
+	}
+
+	policy := c.evictionPolicy
+	if policy == "" {
+		policy = CacheEvictionPolicyLRU
+	}
+	metrics.FQDNCacheEvictionsTotal.WithLabelValues(policy).Add(float64(len(affectedNames)))
+	return affectedNames, removed
+}
+
 // GC cleans TTL expired entries up to now, and overlimit entries, returning
 // both sets.
 // If zombies is passed in, expired IPs are inserted into it. GC and
@@ -321,13 +512,15 @@ func (c *DNSCache) GC(now time.Time, zombies *DNSZombieMappings) (affectedNames
 	c.Lock()
 	expiredNames, expiredEntries := c.cleanupExpiredEntries(now)
 	overLimitNames, overLimitEntries := c.cleanupOverLimitEntries()
+	evictedNames, evictedEntries := c.cleanupTotalLimitEntries()
 	c.Unlock()
 
 	if zombies != nil {
-		// Iterate over 2 maps
+		// Iterate over 3 maps
 		for _, m := range []map[netip.Addr][]*cacheEntry{
 			expiredEntries,
 			overLimitEntries,
+			evictedEntries,
 		} {
 			for ip, entries := range m {
 				for _, entry := range entries {
@@ -337,7 +530,7 @@ func (c *DNSCache) GC(now time.Time, zombies *DNSZombieMappings) (affectedNames
 		}
 	}
 
-	return KeepUniqueNames(append(expiredNames, overLimitNames...))
+	return KeepUniqueNames(append(expiredNames, append(overLimitNames, evictedNames...)...))
 }
 
 // UpdateFromCache is a utility function that allows updating a DNSCache
@@ -690,6 +883,7 @@ func (c *DNSCache) UnmarshalJSON(raw []byte) error {
 
 	c.forward = make(map[string]ipEntries)
 	c.reverse = make(map[netip.Addr]nameEntries)
+	c.negative = make(nameEntries)
 
 	for _, newLookup := range lookups {
 		c.updateWithEntry(newLookup)
@@ -1096,6 +1290,61 @@ func (zombies *DNSZombieMappings) DumpAlive(cidrMatcher CIDRMatcherFunc) (alive
 	return alive
 }
 
+// Reasons why a zombie entry is still retained, as reported via the API.
+const (
+	// zombieReasonConnectionAlive marks zombies whose connection was marked
+	// in-use by the last connection tracking GC run.
+	zombieReasonConnectionAlive = "connection-alive"
+
+	// zombieReasonSharedNameAlive marks zombies sharing a DNS name with a
+	// zombie whose connection is still alive.
+	zombieReasonSharedNameAlive = "shared-name-alive"
+
+	// zombieReasonPendingCTGC marks zombies which have not yet been visited
+	// by a connection tracking GC run since their eviction.
+	zombieReasonPendingCTGC = "pending-connection-tracking-gc"
+
+	// zombieReasonDead marks zombies which will be removed by the next DNS
+	// garbage collector run.
+	zombieReasonDead = "dead-awaiting-gc"
+)
+
+// GetModel returns the API representation of all zombie entries, annotating
+// each with the reason it is still retained.
+func (zombies *DNSZombieMappings) GetModel() []*models.DNSZombieMapping {
+	zombies.Lock()
+	defer zombies.Unlock()
+
+	aliveNames := zombies.getAliveNames()
+	mappings := make([]*models.DNSZombieMapping, 0, len(zombies.deletes))
+	for _, zombie := range zombies.deletes {
+		var reason string
+		alive, _ := zombies.isZombieAlive(zombie, aliveNames)
+		switch {
+		case zombies.isConnectionAlive(zombie) && zombies.lastCTGCUpdate.Before(zombie.AliveAt):
+			reason = zombieReasonConnectionAlive
+		case zombies.isConnectionAlive(zombie):
+			reason = zombieReasonPendingCTGC
+		case alive:
+			reason = zombieReasonSharedNameAlive
+		default:
+			reason = zombieReasonDead
+		}
+		mappings = append(mappings, &models.DNSZombieMapping{
+			AliveAt:         strfmt.DateTime(zombie.AliveAt),
+			DeletePendingAt: strfmt.DateTime(zombie.DeletePendingAt),
+			IP:              zombie.IP.String(),
+			Names:           append([]string{}, zombie.Names...),
+			Reason:          reason,
+		})
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].IP < mappings[j].IP
+	})
+
+	return mappings
+}
+
 // MarshalJSON encodes DNSZombieMappings into JSON. Only the DNSZombieMapping
 // entries are encoded.
 func (zombies *DNSZombieMappings) MarshalJSON() ([]byte, error) {
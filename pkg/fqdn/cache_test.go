@@ -78,6 +78,42 @@ func (ds *DNSCacheTestSuite) TestUpdateLookup(c *C) {
 	}
 }
 
+// TestUpdateNegative tests that negative responses are cached with their TTL,
+// expire on GC and are replaced by successful lookups.
+func (ds *DNSCacheTestSuite) TestUpdateNegative(c *C) {
+	name := "test.com"
+	now := time.Now()
+	cache := NewDNSCache(0)
+
+	const rcodeNameError = 3 // NXDOMAIN
+
+	c.Assert(cache.UpdateNegative(now, name, rcodeNameError, 5), Equals, true)
+	rcode, exists := cache.LookupNegative(name)
+	c.Assert(exists, Equals, true)
+	c.Assert(rcode, Equals, rcodeNameError)
+	c.Assert(cache.DumpNegative(), HasLen, 1)
+
+	// A negative response expiring earlier than the cached one is ignored
+	c.Assert(cache.UpdateNegative(now, name, rcodeNameError, 1), Equals, false)
+
+	// Unknown names have no negative entry
+	_, exists = cache.LookupNegative("other.com")
+	c.Assert(exists, Equals, false)
+
+	// A successful lookup removes the negative entry
+	cache.Update(now, name, []netip.Addr{netip.MustParseAddr("1.1.1.1")}, 5)
+	_, exists = cache.LookupNegative(name)
+	c.Assert(exists, Equals, false)
+	c.Assert(cache.DumpNegative(), HasLen, 0)
+
+	// Negative entries expire with their TTL and are removed on GC
+	c.Assert(cache.UpdateNegative(now, "expire.com", rcodeNameError, 3), Equals, true)
+	cache.GC(now.Add(10*time.Second), nil)
+	_, exists = cache.LookupNegative("expire.com")
+	c.Assert(exists, Equals, false)
+	c.Assert(cache.negative, Not(checker.HasKey), "expire.com", Commentf("Expired negative entry not deleted on GC"))
+}
+
 // TestDelete tests that we can forcibly clear parts of the cache.
 func (ds *DNSCacheTestSuite) TestDelete(c *C) {
 	names := map[string]netip.Addr{
@@ -578,6 +614,48 @@ func (ds *DNSCacheTestSuite) TestOverlimitEntriesWithoutLimit(c *C) {
 	c.Assert(cache.Lookup("test.com"), HasLen, 5)
 }
 
+func (ds *DNSCacheTestSuite) TestTotalLimitEvictionLRU(c *C) {
+	cache := NewDNSCacheWithLimits(0, 0, 2, CacheEvictionPolicyLRU)
+
+	cache.Update(now, "old.com", []netip.Addr{netip.MustParseAddr("1.1.1.1")}, 600)
+	cache.Update(now.Add(1*time.Second), "mid.com", []netip.Addr{netip.MustParseAddr("2.2.2.2")}, 600)
+	cache.Update(now.Add(2*time.Second), "new.com", []netip.Addr{netip.MustParseAddr("3.3.3.3")}, 600)
+
+	affectedNames, _ := cache.cleanupTotalLimitEntries()
+	c.Assert(affectedNames, checker.DeepEquals, []string{"old.com"})
+	c.Assert(cache.Lookup("old.com"), HasLen, 0)
+	c.Assert(cache.Lookup("mid.com"), HasLen, 1)
+	c.Assert(cache.Lookup("new.com"), HasLen, 1)
+
+	// Once under the cap no further evictions may happen.
+	affectedNames, _ = cache.cleanupTotalLimitEntries()
+	c.Assert(affectedNames, HasLen, 0)
+}
+
+func (ds *DNSCacheTestSuite) TestTotalLimitEvictionTTL(c *C) {
+	cache := NewDNSCacheWithLimits(0, 0, 2, CacheEvictionPolicyTTL)
+
+	cache.Update(now, "short.com", []netip.Addr{netip.MustParseAddr("1.1.1.1")}, 60)
+	cache.Update(now, "mid.com", []netip.Addr{netip.MustParseAddr("2.2.2.2")}, 300)
+	cache.Update(now, "long.com", []netip.Addr{netip.MustParseAddr("3.3.3.3")}, 600)
+
+	affectedNames, _ := cache.cleanupTotalLimitEntries()
+	c.Assert(affectedNames, checker.DeepEquals, []string{"short.com"})
+	c.Assert(cache.Lookup("short.com"), HasLen, 0)
+	c.Assert(cache.Lookup("mid.com"), HasLen, 1)
+	c.Assert(cache.Lookup("long.com"), HasLen, 1)
+}
+
+func (ds *DNSCacheTestSuite) TestTotalLimitEvictionWithoutLimit(c *C) {
+	cache := NewDNSCacheWithLimits(0, 0, 0, CacheEvictionPolicyLRU)
+	for i := 0; i < 5; i++ {
+		cache.Update(now, fmt.Sprintf("test%d.com", i), []netip.Addr{netip.MustParseAddr(fmt.Sprintf("1.1.1.%d", i))}, 600)
+	}
+	affectedNames, _ := cache.cleanupTotalLimitEntries()
+	c.Assert(affectedNames, HasLen, 0)
+	c.Assert(cache.forward, HasLen, 5)
+}
+
 func (ds *DNSCacheTestSuite) TestGCOverlimitAfterTTLCleanup(c *C) {
 	limit := 5
 	cache := NewDNSCacheWithLimit(0, limit)
@@ -26,4 +26,14 @@ type Config struct {
 	// UpdateSelectors is a callback to update the mapping of FQDNSelector to
 	// sets of IPs.
 	UpdateSelectors func(ctx context.Context, selectorsWithIPs map[api.FQDNSelector][]net.IP, selectorsWithoutIPs []api.FQDNSelector) (*sync.WaitGroup, []*identity.Identity, map[netip.Prefix]*identity.Identity, error)
+
+	// PreResolve enables proactive resolution of toFQDNs matchNames when
+	// they are registered at policy import time, with periodic
+	// re-resolution honoring the TTL of the responses.
+	PreResolve bool
+
+	// PreResolveLookup overrides the resolver used for pre-resolution.
+	// When nil, the resolvers in /etc/resolv.conf are queried. Used in
+	// tests.
+	PreResolveLookup func(ctx context.Context, name string) (*DNSIPRecords, error)
 }
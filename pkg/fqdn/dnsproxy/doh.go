@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dnsproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/miekg/dns"
+
+	"github.com/cilium/cilium/pkg/datapath/linux/linux_defaults"
+)
+
+const (
+	// dohMediaType is the media type of DNS-over-HTTPS messages (RFC 8484).
+	dohMediaType = "application/dns-message"
+
+	// dohMaxRequestSize bounds the body of a single DoH request. DNS
+	// messages cannot exceed 64KiB.
+	dohMaxRequestSize = 65535
+
+	// dnsPort is the standard plain DNS port. DoH queries are policy
+	// checked and forwarded as if the endpoint had sent them in plain DNS
+	// to this port of the intended resolver, so that existing ToFQDNs and
+	// DNS L7 rules keep applying.
+	dnsPort = 53
+)
+
+// DoHConfig configures termination of DNS-over-HTTPS (RFC 8484) queries from
+// endpoints. The datapath transparently redirects traffic destined to the
+// configured well-known resolver IPs on the HTTPS port to the listener, so
+// that ToFQDNs policy keeps working when applications use DoH clients.
+type DoHConfig struct {
+	// Port is the port the transparent DoH listener is bound to. 0
+	// disables DoH interception.
+	Port uint16
+
+	// CertFile and KeyFile are the TLS certificate and key presented to
+	// endpoints. Endpoints must trust the certificate for the resolver
+	// names they use.
+	CertFile string
+	KeyFile  string
+}
+
+// dohConnKey is the context key under which the intercepted connection of a
+// DoH request is stored.
+type dohConnKey struct{}
+
+// dohResponseWriter adapts a single DoH exchange to the dns.ResponseWriter
+// interface so that it can be dispatched through ServeDNS like any other
+// proxied query. LocalAddr is the plain DNS address of the resolver the
+// endpoint meant to reach, which is what ServeDNS keys the policy check and
+// the upstream connection on.
+type dohResponseWriter struct {
+	local    net.Addr
+	remote   net.Addr
+	response *dns.Msg
+}
+
+func (w *dohResponseWriter) LocalAddr() net.Addr  { return w.local }
+func (w *dohResponseWriter) RemoteAddr() net.Addr { return w.remote }
+
+func (w *dohResponseWriter) WriteMsg(m *dns.Msg) error {
+	w.response = m
+	return nil
+}
+
+func (w *dohResponseWriter) Write([]byte) (int, error) {
+	return 0, fmt.Errorf("raw writes are not supported for DoH responses")
+}
+
+func (w *dohResponseWriter) Close() error        { return nil }
+func (w *dohResponseWriter) TsigStatus() error   { return nil }
+func (w *dohResponseWriter) TsigTimersOnly(bool) {}
+func (w *dohResponseWriter) Hijack()             {}
+
+// startDoHServer starts the transparent DoH listener. The returned listener
+// is owned by the caller and must be closed to stop serving.
+func (p *DNSProxy) startDoHServer(conf DoHConfig, ipv4, ipv6 bool) (net.Listener, error) {
+	cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load DoH certificate: %w", err)
+	}
+
+	// The listener must be transparent to accept connections destined to
+	// the resolver IPs the TPROXY rules redirect here.
+	listener, err := listenConfig(linux_defaults.MagicMarkEgress, ipv4, ipv6).Listen(context.Background(),
+		"tcp", net.JoinHostPort("", strconv.Itoa(int(conf.Port))))
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind DoH listener: %w", err)
+	}
+
+	server := &http.Server{
+		Handler:      http.HandlerFunc(p.serveDoH),
+		ReadTimeout:  ProxyForwardTimeout,
+		WriteTimeout: ProxyForwardTimeout,
+		// Stash the accepted connection so that the handler can recover
+		// the original destination address preserved by TPROXY.
+		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
+			return context.WithValue(ctx, dohConnKey{}, c)
+		},
+	}
+
+	go func() {
+		err := server.Serve(tls.NewListener(listener, &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}))
+		if err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("DoH server stopped serving")
+		}
+	}()
+
+	log.WithField("port", conf.Port).Debug("DoH listener bound")
+	return listener, nil
+}
+
+// serveDoH decodes a single DoH request (RFC 8484 GET or POST) into a DNS
+// message and dispatches it through ServeDNS, subjecting it to the same
+// policy enforcement, notification and upstream forwarding as plain DNS.
+func (p *DNSProxy) serveDoH(w http.ResponseWriter, r *http.Request) {
+	var payload []byte
+	var err error
+	switch r.Method {
+	case http.MethodGet:
+		b64 := r.URL.Query().Get("dns")
+		if b64 == "" {
+			http.Error(w, "missing dns query parameter", http.StatusBadRequest)
+			return
+		}
+		payload, err = base64.RawURLEncoding.DecodeString(b64)
+	case http.MethodPost:
+		if r.Header.Get("Content-Type") != dohMediaType {
+			http.Error(w, "unsupported media type", http.StatusUnsupportedMediaType)
+			return
+		}
+		payload, err = io.ReadAll(io.LimitReader(r.Body, dohMaxRequestSize))
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, "cannot read DNS query", http.StatusBadRequest)
+		return
+	}
+
+	request := new(dns.Msg)
+	if err := request.Unpack(payload); err != nil || len(request.Question) == 0 {
+		http.Error(w, "cannot parse DNS query", http.StatusBadRequest)
+		return
+	}
+
+	conn, ok := r.Context().Value(dohConnKey{}).(net.Conn)
+	if !ok {
+		http.Error(w, "cannot determine connection addresses", http.StatusInternalServerError)
+		return
+	}
+	local, ok := conn.LocalAddr().(*net.TCPAddr)
+	if !ok {
+		http.Error(w, "cannot determine original DNS target", http.StatusInternalServerError)
+		return
+	}
+
+	writer := &dohResponseWriter{
+		local:  &net.TCPAddr{IP: local.IP, Port: dnsPort},
+		remote: conn.RemoteAddr(),
+	}
+	p.ServeDNS(writer, request)
+	if writer.response == nil {
+		http.Error(w, "no DNS response", http.StatusServiceUnavailable)
+		return
+	}
+
+	packed, err := writer.response.Pack()
+	if err != nil {
+		http.Error(w, "cannot pack DNS response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", dohMediaType)
+	w.Write(packed)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dnsproxy
+
+import (
+	"net"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// ECSModePreserve forwards EDNS0 Client Subnet options unmodified.
+	ECSModePreserve = "preserve"
+
+	// ECSModeStrip removes EDNS0 Client Subnet options from forwarded
+	// queries.
+	ECSModeStrip = "strip"
+
+	// ECSModeZero rewrites EDNS0 Client Subnet options in forwarded queries
+	// to the all-zero /0 subnet, which per RFC 7871 instructs the resolver
+	// not to use (nor reveal) any client subnet information.
+	ECSModeZero = "zero"
+)
+
+// rewriteECS strips or rewrites the EDNS0 Client Subnet option of a query
+// about to be forwarded upstream, according to the configured mode. This
+// avoids leaking pod CIDRs to external resolvers and prevents geo-split
+// answers varying by client subnet.
+func (p *DNSProxy) rewriteECS(request *dns.Msg) {
+	if p.EDNSClientSubnetMode == ECSModePreserve || p.EDNSClientSubnetMode == "" {
+		return
+	}
+
+	opt := request.IsEdns0()
+	if opt == nil {
+		return
+	}
+
+	options := opt.Option[:0]
+	for _, o := range opt.Option {
+		ecs, ok := o.(*dns.EDNS0_SUBNET)
+		if !ok {
+			options = append(options, o)
+			continue
+		}
+		if p.EDNSClientSubnetMode == ECSModeZero {
+			ecs.SourceNetmask = 0
+			ecs.SourceScope = 0
+			if ecs.Family == 2 {
+				ecs.Address = net.IPv6zero
+			} else {
+				ecs.Address = net.IPv4zero
+			}
+			options = append(options, ecs)
+		}
+		// ECSModeStrip: drop the option
+	}
+	opt.Option = options
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dnsproxy
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// handoverSocketName is the name of the unix domain socket in the agent
+	// runtime directory over which a running agent hands its DNS proxy
+	// listening sockets to a restarting agent. Together with the DNS rules
+	// restored from the endpoint state files this keeps DNS resolution for
+	// endpoints with toFQDNs rules working across agent restarts.
+	handoverSocketName = "dnsproxy-handover.sock"
+
+	// handoverTimeout bounds both the connection to a previous agent's
+	// handover socket and the transfer of the socket FDs over it.
+	handoverTimeout = 2 * time.Second
+)
+
+func handoverSocketPath() string {
+	return filepath.Join(option.Config.RunDir, handoverSocketName)
+}
+
+// takeoverListeners receives the DNS proxy listening sockets of a previously
+// running agent from its handover socket. It returns nil listeners when no
+// previous agent is serving the handover socket, in which case the caller
+// binds fresh sockets. port, when non-zero, is the proxy port the caller
+// requires; handed-over sockets bound to another port are rejected.
+func takeoverListeners(port uint16) (*net.UDPConn, *net.TCPListener, error) {
+	conn, err := net.DialTimeout("unix", handoverSocketPath(), handoverTimeout)
+	if err != nil {
+		// No previous agent is offering its sockets.
+		return nil, nil, nil
+	}
+	uc := conn.(*net.UnixConn)
+	defer uc.Close()
+
+	if err := uc.SetDeadline(time.Now().Add(handoverTimeout)); err != nil {
+		return nil, nil, err
+	}
+
+	buf := make([]byte, 1)
+	oob := make([]byte, unix.CmsgSpace(2*4))
+	_, oobn, _, _, err := uc.ReadMsgUnix(buf, oob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to receive DNS proxy sockets: %w", err)
+	}
+
+	msgs, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse DNS proxy socket control message: %w", err)
+	}
+	var fds []int
+	for _, msg := range msgs {
+		rights, err := unix.ParseUnixRights(&msg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse DNS proxy socket rights: %w", err)
+		}
+		fds = append(fds, rights...)
+	}
+	if len(fds) != 2 {
+		for _, fd := range fds {
+			unix.Close(fd)
+		}
+		return nil, nil, fmt.Errorf("expected 2 DNS proxy socket FDs, received %d", len(fds))
+	}
+
+	udpFile := os.NewFile(uintptr(fds[0]), "dnsproxy-udp")
+	defer udpFile.Close()
+	tcpFile := os.NewFile(uintptr(fds[1]), "dnsproxy-tcp")
+	defer tcpFile.Close()
+
+	fc, err := net.FileConn(udpFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to use handed-over UDP socket: %w", err)
+	}
+	fl, err := net.FileListener(tcpFile)
+	if err != nil {
+		fc.Close()
+		return nil, nil, fmt.Errorf("failed to use handed-over TCP socket: %w", err)
+	}
+
+	UDPConn, ok := fc.(*net.UDPConn)
+	if !ok {
+		fc.Close()
+		fl.Close()
+		return nil, nil, fmt.Errorf("handed-over UDP socket has unexpected type %T", fc)
+	}
+	TCPListener, ok := fl.(*net.TCPListener)
+	if !ok {
+		fc.Close()
+		fl.Close()
+		return nil, nil, fmt.Errorf("handed-over TCP socket has unexpected type %T", fl)
+	}
+
+	if boundPort := uint16(UDPConn.LocalAddr().(*net.UDPAddr).Port); port != 0 && boundPort != port {
+		UDPConn.Close()
+		TCPListener.Close()
+		return nil, nil, fmt.Errorf("handed-over DNS proxy sockets are bound to port %d, want %d", boundPort, port)
+	}
+
+	return UDPConn, TCPListener, nil
+}
+
+// startHandoverListener serves the DNS proxy listening sockets on the
+// handover socket, so that a restarting agent can take them over instead of
+// waiting for this agent to release them. Both agents then serve DNS from the
+// same sockets until the old agent exits.
+func startHandoverListener(udpConn *net.UDPConn, tcpListener *net.TCPListener) (*net.UnixListener, error) {
+	path := handoverSocketPath()
+	// Remove any stale socket left behind by a previous agent.
+	os.Remove(path)
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for {
+			conn, err := l.AcceptUnix()
+			if err != nil {
+				// The listener was closed during proxy cleanup.
+				return
+			}
+			if err := sendListeners(conn, udpConn, tcpListener); err != nil {
+				log.WithError(err).Warning("Failed to hand over DNS proxy sockets")
+			}
+			conn.Close()
+		}
+	}()
+
+	return l, nil
+}
+
+// sendListeners passes duplicates of the DNS proxy listening socket FDs over
+// conn.
+func sendListeners(conn *net.UnixConn, udpConn *net.UDPConn, tcpListener *net.TCPListener) error {
+	udpFile, err := udpConn.File()
+	if err != nil {
+		return err
+	}
+	defer udpFile.Close()
+	tcpFile, err := tcpListener.File()
+	if err != nil {
+		return err
+	}
+	defer tcpFile.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(handoverTimeout)); err != nil {
+		return err
+	}
+	rights := unix.UnixRights(int(udpFile.Fd()), int(tcpFile.Fd()))
+	_, _, err = conn.WriteMsgUnix([]byte{0}, rights, nil)
+	return err
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dnsproxy
+
+import (
+	"net"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/option"
+)
+
+type DNSProxyHandoverTestSuite struct{}
+
+var _ = Suite(&DNSProxyHandoverTestSuite{})
+
+func (s *DNSProxyHandoverTestSuite) TestSocketHandover(c *C) {
+	oldRunDir := option.Config.RunDir
+	option.Config.RunDir = c.MkDir()
+	defer func() { option.Config.RunDir = oldRunDir }()
+
+	udpConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	c.Assert(err, IsNil)
+	defer udpConn.Close()
+	port := uint16(udpConn.LocalAddr().(*net.UDPAddr).Port)
+
+	tcpListener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.ParseIP("127.0.0.1")})
+	c.Assert(err, IsNil)
+	defer tcpListener.Close()
+
+	handover, err := startHandoverListener(udpConn, tcpListener)
+	c.Assert(err, IsNil)
+	defer handover.Close()
+
+	// The requested port must match the one the sockets are bound to.
+	takenUDP, takenTCP, err := takeoverListeners(port + 1)
+	c.Assert(err, NotNil)
+	c.Assert(takenUDP, IsNil)
+	c.Assert(takenTCP, IsNil)
+
+	takenUDP, takenTCP, err = takeoverListeners(port)
+	c.Assert(err, IsNil)
+	c.Assert(takenUDP, Not(IsNil))
+	c.Assert(takenTCP, Not(IsNil))
+	defer takenUDP.Close()
+	defer takenTCP.Close()
+	c.Assert(takenUDP.LocalAddr().(*net.UDPAddr).Port, Equals, int(port))
+
+	// The handed-over socket refers to the same underlying socket: data
+	// sent to the original address arrives on the taken-over conn.
+	sender, err := net.DialUDP("udp", nil, udpConn.LocalAddr().(*net.UDPAddr))
+	c.Assert(err, IsNil)
+	defer sender.Close()
+	udpConn.Close()
+	_, err = sender.Write([]byte("ping"))
+	c.Assert(err, IsNil)
+	buf := make([]byte, 16)
+	n, err := takenUDP.Read(buf)
+	c.Assert(err, IsNil)
+	c.Assert(string(buf[:n]), Equals, "ping")
+}
+
+func (s *DNSProxyHandoverTestSuite) TestTakeoverWithoutPreviousAgent(c *C) {
+	oldRunDir := option.Config.RunDir
+	option.Config.RunDir = c.MkDir()
+	defer func() { option.Config.RunDir = oldRunDir }()
+
+	udpConn, tcpListener, err := takeoverListeners(0)
+	c.Assert(err, IsNil)
+	c.Assert(udpConn, IsNil)
+	c.Assert(tcpListener, IsNil)
+}
@@ -4,10 +4,14 @@
 package dnsproxy
 
 import (
+	"net"
 	"testing"
 
+	"github.com/miekg/dns"
 	. "gopkg.in/check.v1"
 
+	"golang.org/x/time/rate"
+
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/fqdn/re"
 	"github.com/cilium/cilium/pkg/identity"
@@ -51,6 +55,84 @@ func (s *DNSProxyHelperTestSuite) TestGetSelectorRegexMap(c *C) {
 	c.Assert(regex.MatchString(dnsName+"trolo"), Equals, false)
 }
 
+func (s *DNSProxyHelperTestSuite) TestEndpointRateLimiter(c *C) {
+	p := &DNSProxy{
+		EndpointRateLimit:    rate.Limit(1),
+		EndpointRateBurst:    2,
+		endpointRateLimiters: make(map[uint64]*rate.Limiter),
+		allowed:              make(perEPAllow),
+	}
+
+	// Each endpoint gets its own token bucket of EndpointRateBurst tokens
+	c.Assert(p.endpointRateLimiter(1).Allow(), Equals, true)
+	c.Assert(p.endpointRateLimiter(1).Allow(), Equals, true)
+	c.Assert(p.endpointRateLimiter(1).Allow(), Equals, false)
+	c.Assert(p.endpointRateLimiter(2).Allow(), Equals, true)
+
+	// The limiter of a removed endpoint is dropped, a new endpoint reusing
+	// the ID starts with a full bucket
+	p.removeEndpointSemaphoreLocked(1)
+	c.Assert(p.endpointRateLimiter(1).Allow(), Equals, true)
+}
+
+func (s *DNSProxyHelperTestSuite) TestExtractMsgDetailsSRV(c *C) {
+	query := new(dns.Msg)
+	query.SetQuestion("_ldap._tcp.example.com.", dns.TypeSRV)
+	msg := new(dns.Msg)
+	msg.SetReply(query)
+	msg.Answer = []dns.RR{
+		&dns.SRV{
+			Hdr:    dns.RR_Header{Name: "_ldap._tcp.example.com.", Rrtype: dns.TypeSRV, Class: dns.ClassINET, Ttl: 60},
+			Port:   389,
+			Target: "ldap.example.com.",
+		},
+	}
+	msg.Extra = []dns.RR{
+		&dns.A{
+			Hdr: dns.RR_Header{Name: "ldap.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30},
+			A:   net.ParseIP("10.0.0.1"),
+		},
+		&dns.A{
+			Hdr: dns.RR_Header{Name: "unrelated.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 30},
+			A:   net.ParseIP("10.0.0.2"),
+		},
+	}
+
+	qname, responseIPs, TTL, _, _, answerTypes, qTypes, err := ExtractMsgDetails(msg)
+	c.Assert(err, IsNil)
+	c.Assert(qname, Equals, "_ldap._tcp.example.com.")
+	c.Assert(responseIPs, HasLen, 1)
+	c.Assert(responseIPs[0].String(), Equals, "10.0.0.1")
+	c.Assert(TTL, Equals, uint32(30))
+	c.Assert(answerTypes, DeepEquals, []uint16{dns.TypeSRV})
+	c.Assert(qTypes, DeepEquals, []uint16{dns.TypeSRV})
+}
+
+func (s *DNSProxyHelperTestSuite) TestExtractMsgDetailsMX(c *C) {
+	query := new(dns.Msg)
+	query.SetQuestion("example.com.", dns.TypeMX)
+	msg := new(dns.Msg)
+	msg.SetReply(query)
+	msg.Answer = []dns.RR{
+		&dns.MX{
+			Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeMX, Class: dns.ClassINET, Ttl: 120},
+			Mx:  "mail.example.com.",
+		},
+		// Some servers return the target address records in the answer
+		// section directly.
+		&dns.A{
+			Hdr: dns.RR_Header{Name: "mail.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.ParseIP("10.0.0.3"),
+		},
+	}
+
+	_, responseIPs, TTL, _, _, _, _, err := ExtractMsgDetails(msg)
+	c.Assert(err, IsNil)
+	c.Assert(responseIPs, HasLen, 1)
+	c.Assert(responseIPs[0].String(), Equals, "10.0.0.3")
+	c.Assert(TTL, Equals, uint32(60))
+}
+
 type MockCachedSelector struct{}
 
 func (m MockCachedSelector) GetSelections() []identity.NumericIdentity {
@@ -72,3 +154,88 @@ func (m MockCachedSelector) IsNone() bool {
 func (m MockCachedSelector) String() string {
 	return "string"
 }
+
+func (s *DNSProxyHelperTestSuite) TestRewriteECS(c *C) {
+	newQuery := func() *dns.Msg {
+		query := new(dns.Msg)
+		query.SetQuestion("cilium.io.", dns.TypeA)
+		opt := &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT}}
+		opt.Option = append(opt.Option, &dns.EDNS0_SUBNET{
+			Code:          dns.EDNS0SUBNET,
+			Family:        1,
+			SourceNetmask: 24,
+			Address:       net.ParseIP("10.0.1.0"),
+		})
+		query.Extra = append(query.Extra, opt)
+		return query
+	}
+
+	// Preserve mode must leave the option untouched
+	p := &DNSProxy{EDNSClientSubnetMode: ECSModePreserve}
+	query := newQuery()
+	p.rewriteECS(query)
+	c.Assert(query.IsEdns0().Option, HasLen, 1)
+	ecs := query.IsEdns0().Option[0].(*dns.EDNS0_SUBNET)
+	c.Assert(ecs.SourceNetmask, Equals, uint8(24))
+
+	// Strip mode must remove the option
+	p = &DNSProxy{EDNSClientSubnetMode: ECSModeStrip}
+	query = newQuery()
+	p.rewriteECS(query)
+	c.Assert(query.IsEdns0().Option, HasLen, 0)
+
+	// Zero mode must rewrite the option to the all-zero /0 subnet
+	p = &DNSProxy{EDNSClientSubnetMode: ECSModeZero}
+	query = newQuery()
+	p.rewriteECS(query)
+	c.Assert(query.IsEdns0().Option, HasLen, 1)
+	ecs = query.IsEdns0().Option[0].(*dns.EDNS0_SUBNET)
+	c.Assert(ecs.SourceNetmask, Equals, uint8(0))
+	c.Assert(ecs.Address.IsUnspecified(), Equals, true)
+
+	// Queries without an OPT record must pass through unmodified
+	query = new(dns.Msg)
+	query.SetQuestion("cilium.io.", dns.TypeA)
+	p.rewriteECS(query)
+	c.Assert(query.IsEdns0(), IsNil)
+}
+
+func (s *DNSProxyHelperTestSuite) TestNamespaceUpstream(c *C) {
+	defer RemoveNamespaceUpstream("tenant-a", "policy-1")
+
+	// The port defaults to 53 when not given.
+	err := SetNamespaceUpstream("tenant-a", "policy-1", "10.0.0.53")
+	c.Assert(err, IsNil)
+	addr, ok := upstreamForNamespace("tenant-a")
+	c.Assert(ok, Equals, true)
+	c.Assert(addr, Equals, "10.0.0.53:53")
+
+	// An explicit port and IPv6 addresses are accepted.
+	err = SetNamespaceUpstream("tenant-a", "policy-1", "fd00::53")
+	c.Assert(err, IsNil)
+	addr, ok = upstreamForNamespace("tenant-a")
+	c.Assert(ok, Equals, true)
+	c.Assert(addr, Equals, "[fd00::53]:53")
+
+	err = SetNamespaceUpstream("tenant-a", "policy-1", "10.0.0.53:5353")
+	c.Assert(err, IsNil)
+	addr, ok = upstreamForNamespace("tenant-a")
+	c.Assert(ok, Equals, true)
+	c.Assert(addr, Equals, "10.0.0.53:5353")
+
+	// Non-IP upstreams are rejected.
+	err = SetNamespaceUpstream("tenant-a", "policy-1", "dns.example.com")
+	c.Assert(err, NotNil)
+
+	// Only the installing policy can remove the override.
+	RemoveNamespaceUpstream("tenant-a", "policy-2")
+	_, ok = upstreamForNamespace("tenant-a")
+	c.Assert(ok, Equals, true)
+	RemoveNamespaceUpstream("tenant-a", "policy-1")
+	_, ok = upstreamForNamespace("tenant-a")
+	c.Assert(ok, Equals, false)
+
+	// Namespaceless endpoints never match an override.
+	_, ok = upstreamForNamespace("")
+	c.Assert(ok, Equals, false)
+}
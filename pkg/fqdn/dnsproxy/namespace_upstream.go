@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dnsproxy
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// namespaceUpstream is an upstream resolver override installed for a
+// namespace, together with the policy that installed it so that only that
+// policy can remove it again.
+type namespaceUpstream struct {
+	addr  string
+	owner string
+}
+
+// namespaceUpstreams holds the per-namespace upstream resolver overrides of
+// the DNS proxy. Queries of endpoints in a namespace with an override are
+// forwarded to the configured resolver instead of the destination in the
+// original packet, enabling split-horizon DNS setups with per-tenant
+// resolvers.
+var namespaceUpstreams = struct {
+	lock.RWMutex
+	m map[string]namespaceUpstream
+}{m: map[string]namespaceUpstream{}}
+
+// SetNamespaceUpstream installs addr as the upstream resolver for all DNS
+// queries of endpoints in the given namespace. addr must be an IP with an
+// optional port, the port defaults to 53. owner identifies the policy
+// installing the override; an override installed by a different owner is
+// replaced.
+func SetNamespaceUpstream(namespace, owner, addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		// No port given, default to the DNS port.
+		host = addr
+		addr = net.JoinHostPort(addr, "53")
+	}
+	if net.ParseIP(host) == nil {
+		return fmt.Errorf("invalid upstream DNS server address %q", host)
+	}
+
+	namespaceUpstreams.Lock()
+	namespaceUpstreams.m[namespace] = namespaceUpstream{addr: addr, owner: owner}
+	namespaceUpstreams.Unlock()
+	return nil
+}
+
+// RemoveNamespaceUpstream removes the upstream resolver override of the given
+// namespace, if it was installed by owner.
+func RemoveNamespaceUpstream(namespace, owner string) {
+	namespaceUpstreams.Lock()
+	if cur, ok := namespaceUpstreams.m[namespace]; ok && cur.owner == owner {
+		delete(namespaceUpstreams.m, namespace)
+	}
+	namespaceUpstreams.Unlock()
+}
+
+// upstreamForNamespace returns the upstream resolver override of the given
+// namespace, if any.
+func upstreamForNamespace(namespace string) (string, bool) {
+	if namespace == "" {
+		return "", false
+	}
+	namespaceUpstreams.RLock()
+	upstream, ok := namespaceUpstreams.m[namespace]
+	namespaceUpstreams.RUnlock()
+	return upstream.addr, ok
+}
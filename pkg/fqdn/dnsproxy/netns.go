@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dnsproxy
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/miekg/dns"
+
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// ListenInNetNS binds the proxy's port on UDP and TCP inside the network
+// namespace at netNSPath and serves DNS requests on the resulting sockets
+// with the regular proxy handler. Only the bind happens inside the target
+// namespace, the sockets remain usable from the agent's own namespace once
+// created. This avoids port conflicts in the host namespace and lets the
+// proxy receive queries in chaining setups where traffic never traverses the
+// host-namespace listener.
+// Calling ListenInNetNS again for the same path is a no-op.
+func (p *DNSProxy) ListenInNetNS(netNSPath string) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if _, exists := p.netNSServers[netNSPath]; exists {
+		return nil
+	}
+
+	var (
+		UDPConn     *net.UDPConn
+		TCPListener *net.TCPListener
+
+		EnableIPv4, EnableIPv6 = option.Config.EnableIPv4, option.Config.EnableIPv6
+	)
+	err := ns.WithNetNSPath(netNSPath, func(_ ns.NetNS) error {
+		var err error
+		UDPConn, TCPListener, err = bindToAddr("", p.BindPort, EnableIPv4, EnableIPv6)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bind DNS proxy in %s: %w", netNSPath, err)
+	}
+
+	bindAddr := UDPConn.LocalAddr().String()
+	servers := []*dns.Server{
+		{PacketConn: UDPConn, Addr: bindAddr, Net: "udp", Handler: p,
+			SessionUDPFactory: &sessionUDPFactory{ipv4Enabled: EnableIPv4, ipv6Enabled: EnableIPv6},
+		},
+		{Listener: TCPListener, Addr: bindAddr, Net: "tcp", Handler: p},
+	}
+	if p.netNSServers == nil {
+		p.netNSServers = make(map[string][]*dns.Server)
+	}
+	p.netNSServers[netNSPath] = servers
+
+	for _, s := range servers {
+		go func(server *dns.Server) {
+			// Unlike the host-namespace listeners, failure here is not
+			// fatal as the endpoint can still be served through the
+			// regular redirect path.
+			if err := server.ActivateAndServe(); err != nil {
+				log.WithError(err).Errorf("Failed to start the %s DNS proxy on %s in %s", server.Net, server.Addr, netNSPath)
+			}
+		}(s)
+	}
+
+	log.WithField("address", bindAddr).WithField("netns", netNSPath).Debug("DNS Proxy bound inside endpoint network namespace")
+	return nil
+}
+
+// CloseNetNSListeners shuts down the DNS servers previously started by
+// ListenInNetNS for the network namespace at netNSPath, if any.
+func (p *DNSProxy) CloseNetNSListeners(netNSPath string) {
+	p.Lock()
+	defer p.Unlock()
+
+	for _, server := range p.netNSServers[netNSPath] {
+		server.Shutdown()
+	}
+	delete(p.netNSServers, netNSPath)
+}
@@ -5,12 +5,16 @@ package dnsproxy
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"math"
 	"net"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -20,7 +24,9 @@ import (
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
 	"golang.org/x/sys/unix"
+	"golang.org/x/time/rate"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/datapath/linux/linux_defaults"
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/fqdn/matchpattern"
@@ -51,8 +57,55 @@ const (
 	// ProxyBindRetryInterval is how long to wait between attempts to bind to the
 	// proxy address:port
 	ProxyBindRetryInterval = ProxyBindTimeout / 5
+
+	// dotPort is the standard DNS-over-TLS port (RFC 7858).
+	dotPort = 853
 )
 
+// DoTConfig configures forwarding of proxied DNS queries to upstream DNS
+// servers over DNS-over-TLS (RFC 7858).
+type DoTConfig struct {
+	// Enabled forwards proxied queries over DoT to port 853 of the
+	// originally intended DNS server, unless overridden by
+	// NamespaceUpstreams.
+	Enabled bool
+
+	// ServerName is the name the upstream server certificate is validated
+	// against. Required when forwarding to the originally intended DNS
+	// server by IP, as server certificates rarely carry IP SANs.
+	ServerName string
+
+	// CAFile is an optional CA bundle to validate the upstream server
+	// certificate with. The system roots are used when empty.
+	CAFile string
+
+	// NamespaceUpstreams maps a namespace to the DoT upstream
+	// ("host:port") queries of its endpoints are forwarded to, overriding
+	// the originally intended DNS server.
+	NamespaceUpstreams map[string]string
+}
+
+// tlsConfig returns the TLS client configuration used for upstream DoT
+// connections.
+func (c DoTConfig) tlsConfig() (*tls.Config, error) {
+	conf := &tls.Config{
+		ServerName: c.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if c.CAFile != "" {
+		ca, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in %s", c.CAFile)
+		}
+		conf.RootCAs = pool
+	}
+	return conf, nil
+}
+
 // DNSProxy is a L7 proxy for DNS traffic. It keeps a list of allowed DNS
 // lookups that can be regexps and blocks lookups that are not allowed.
 // A singleton is always running inside cilium-agent.
@@ -105,16 +158,56 @@ type DNSProxy struct {
 	// this may cause DNS disruption. A client pool may be better.
 	UDPClient, TCPClient *dns.Client
 
+	// TLSClient is the miekg/dns client used to forward requests over
+	// DNS-over-TLS. Only set when DoT.Enabled is true.
+	TLSClient *dns.Client
+
+	// DoT configures forwarding of proxied queries over DNS-over-TLS.
+	DoT DoTConfig
+
 	// EnableDNSCompression allows the DNS proxy to compress responses to
 	// endpoints that are larger than 512 Bytes or the EDNS0 option, if present.
 	EnableDNSCompression bool
 
+	// EnableDNS0x20Encoding randomizes the case of query names forwarded to
+	// upstream DNS servers and rejects responses that do not echo the same
+	// mixed case back (DNS 0x20 encoding). Combined with the random request
+	// ID and per-query source port this raises the entropy an off-path
+	// attacker must guess to spoof an upstream response.
+	EnableDNS0x20Encoding bool
+
+	// EDNSClientSubnetMode controls how EDNS0 Client Subnet options in
+	// proxied queries are forwarded upstream. See the ECSMode* constants.
+	EDNSClientSubnetMode string
+
+	// UseReservedIdentity makes connections to upstream DNS servers carry
+	// the reserved dns-proxy identity instead of the identity of the
+	// endpoint on whose behalf the query is made, so that host policy can
+	// match proxy-originated DNS traffic precisely.
+	UseReservedIdentity bool
+
 	// ConcurrencyLimit limits parallel goroutines number that serve DNS
 	ConcurrencyLimit *semaphore.Weighted
 	// ConcurrencyGracePeriod is the grace period for waiting on
 	// ConcurrencyLimit before timing out
 	ConcurrencyGracePeriod time.Duration
 
+	// EndpointConcurrencyLimit limits the parallel goroutines number that
+	// serve DNS for a single endpoint when greater than zero. It
+	// subdivides ConcurrencyLimit fairly so that a single chatty endpoint
+	// cannot starve the DNS requests of other endpoints.
+	EndpointConcurrencyLimit int64
+
+	// EndpointRateLimit token-bucket limits the rate of DNS queries of a
+	// single endpoint when greater than zero, so that a DNS flood from a
+	// compromised endpoint cannot saturate the proxy, the DNS response
+	// handling or identity allocation. Queries beyond the limit are
+	// refused.
+	EndpointRateLimit rate.Limit
+
+	// EndpointRateBurst is the token bucket size for EndpointRateLimit.
+	EndpointRateBurst int
+
 	// logLimiter limits log msgs that could be bursty and too verbose.
 	// Currently used when ConcurrencyLimit is set.
 	logLimiter logging.Limiter
@@ -135,6 +228,22 @@ type DNSProxy struct {
 	// This is used to limit the number of IPs we store for restored DNS rules.
 	usedServers map[string]struct{}
 
+	// endpointSemaphores bounds the in-flight DNS requests of a single
+	// endpoint, keyed by endpoint ID. Only used when
+	// EndpointConcurrencyLimit is set.
+	endpointSemaphores map[uint64]*semaphore.Weighted
+
+	// endpointRateLimiters token-bucket limit the DNS queries of a single
+	// endpoint, keyed by endpoint ID. Only used when EndpointRateLimit is
+	// set.
+	endpointRateLimiters map[uint64]*rate.Limiter
+
+	// netNSServers are the additional DNS servers serving on sockets bound
+	// inside endpoint network namespaces, keyed by the netns path. Only
+	// used when the DNS proxy is configured to bind inside endpoint
+	// network namespaces.
+	netNSServers map[string][]*dns.Server
+
 	// allowed tracks all allowed L7 DNS rules by endpointID, destination port,
 	// and L3 Selector. All must match for a query to be allowed.
 	//
@@ -142,6 +251,11 @@ type DNSProxy struct {
 	// Note: Simple DNS names, e.g. bar.foo.com, will treat the "." as a literal.
 	allowed perEPAllow
 
+	// scrub tracks, by endpointID and destination port, the answer scrubbing
+	// entries derived from DNS rules carrying AllowedCIDRs. Responses on
+	// ports without an entry here are passed on unmodified.
+	scrub perEPScrub
+
 	// restored is a set of rules restored from a previous instance that can be
 	// used until 'allowed' rules for an endpoint are first initialized after
 	// a restart
@@ -154,6 +268,12 @@ type DNSProxy struct {
 	// DNS request is invalid
 	rejectReply int32
 
+	// ipPlumbed reports whether an IP has already been plumbed into policy.
+	// When set, A/AAAA answer records for IPs it rejects are removed from
+	// responses before they are released to the endpoint. Set via
+	// SetIPPlumbedCheck.
+	ipPlumbed func(ip net.IP) bool
+
 	// UnbindAddress unbinds dns servers from socket in order to stop serving DNS traffic before proxy shutdown
 	unbindAddress func()
 }
@@ -168,6 +288,34 @@ type portToSelectorAllow map[uint16]CachedSelectorREEntry
 // policy.L7DataMap but the DNS rules are compiled into a single regexp
 type CachedSelectorREEntry map[policy.CachedSelector]*regexp.Regexp
 
+// perEPScrub maps EndpointIDs and destination ports to answer scrubbing
+// entries. Ports without an entry do not have their responses scrubbed.
+type perEPScrub map[uint64]map[uint16]*scrubEntry
+
+// scrubEntry holds the answer scrubbing rules for one endpoint and
+// destination port, derived from its DNS rules at the same granularity as
+// CheckAllowed operates on.
+type scrubEntry struct {
+	// unrestricted matches, per selector, the names allowed by DNS rules
+	// without AllowedCIDRs. Responses to names matching here are passed on
+	// unmodified, as at least one rule allows the lookup without
+	// restricting the addresses of the answer.
+	unrestricted CachedSelectorREEntry
+
+	// restricted lists the DNS rules carrying AllowedCIDRs. A response to
+	// a name matching only here has A and AAAA records outside the union
+	// of the AllowedCIDRs of the matching rules stripped.
+	restricted []scrubRule
+}
+
+// scrubRule is a single DNS rule with AllowedCIDRs, compiled for the
+// response path.
+type scrubRule struct {
+	selector policy.CachedSelector
+	re       *regexp.Regexp
+	cidrs    []*net.IPNet
+}
+
 // structure for restored rules that can be used while Cilium agent is restoring endpoints
 type perEPRestored map[uint64]restore.DNSRules
 
@@ -237,6 +385,38 @@ func (p *DNSProxy) GetRules(endpointID uint16) (restore.DNSRules, error) {
 	return restored, nil
 }
 
+// DumpAllowedRules returns the current L7 DNS rules of the proxy as API
+// models, one entry per endpoint, destination port and selector, sorted for
+// stable output. It is intended for debugging why the proxy rejects a query.
+func (p *DNSProxy) DumpAllowedRules() []*models.DNSProxyRule {
+	p.RLock()
+	defer p.RUnlock()
+
+	rules := make([]*models.DNSProxyRule, 0, len(p.allowed))
+	for endpointID, portRules := range p.allowed {
+		for port, entries := range portRules {
+			for cs, regex := range entries {
+				rules = append(rules, &models.DNSProxyRule{
+					EndpointID:  int64(endpointID),
+					DestPort:    int64(port),
+					Selector:    cs.String(),
+					RegexString: regex.String(),
+				})
+			}
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].EndpointID != rules[j].EndpointID {
+			return rules[i].EndpointID < rules[j].EndpointID
+		}
+		if rules[i].DestPort != rules[j].DestPort {
+			return rules[i].DestPort < rules[j].DestPort
+		}
+		return rules[i].Selector < rules[j].Selector
+	})
+	return rules
+}
+
 // RestoreRules is used in the beginning of endpoint restoration to
 // install rules saved before the restart to be used before the endpoint
 // is regenerated.
@@ -334,6 +514,26 @@ func (allow perEPAllow) getPortRulesForID(endpointID uint64, destPort uint16) (r
 	return rules, exists
 }
 
+// setPortScrubForID sets the answer scrubbing entry for endpointID and
+// destPort. A nil entry removes any previous one, disabling scrubbing.
+func (scrub perEPScrub) setPortScrubForID(endpointID uint64, destPort uint16, entry *scrubEntry) {
+	if entry == nil {
+		epPorts := scrub[endpointID]
+		delete(epPorts, destPort)
+		if len(epPorts) == 0 {
+			delete(scrub, endpointID)
+		}
+		return
+	}
+
+	epPorts, exist := scrub[endpointID]
+	if !exist {
+		epPorts = make(map[uint16]*scrubEntry)
+		scrub[endpointID] = epPorts
+	}
+	epPorts[destPort] = entry
+}
+
 // LookupEndpointIDByIPFunc wraps logic to lookup an endpoint with any backend.
 // See DNSProxy.LookupRegisteredEndpoint for usage.
 type LookupEndpointIDByIPFunc func(ip net.IP) (endpoint *endpoint.Endpoint, err error)
@@ -385,6 +585,28 @@ func (e ErrTimedOutAcquireSemaphore) Error() string {
 	)
 }
 
+// ErrEndpointRateLimited is an error representing a DNS request refused
+// because the endpoint exceeded its query rate limit.
+type ErrEndpointRateLimited struct{}
+
+func (ErrEndpointRateLimited) Error() string {
+	return "endpoint exceeded its DNS query rate limit"
+}
+
+// ErrDNS0x20ResponseMismatch is an error representing an upstream DNS response
+// that did not echo the DNS 0x20 mixed-case encoding of the forwarded query.
+// Such responses are discarded as potentially spoofed.
+type ErrDNS0x20ResponseMismatch struct {
+	qname string
+}
+
+func (e ErrDNS0x20ResponseMismatch) Error() string {
+	return fmt.Sprintf(
+		"response for %s does not match the DNS 0x20 encoding of the query",
+		e.qname,
+	)
+}
+
 // ErrDNSRequestNoEndpoint represents an error when the local daemon cannot
 // find the corresponding endpoint that triggered a DNS request processed by
 // the local DNS proxy (FQDN proxy).
@@ -398,13 +620,16 @@ func (ErrDNSRequestNoEndpoint) Error() string {
 type ProxyRequestContext struct {
 	ProcessingTime spanstat.SpanStat // This is going to happen at the end of the second callback.
 	// Error is a enum of [timeout, allow, denied, proxyerr].
-	UpstreamTime         spanstat.SpanStat
-	SemaphoreAcquireTime spanstat.SpanStat
-	PolicyCheckTime      spanstat.SpanStat
-	DataplaneTime        spanstat.SpanStat
-	Success              bool
-	Err                  error
-	DataSource           accesslog.DNSDataSource
+	UpstreamTime                 spanstat.SpanStat
+	SemaphoreAcquireTime         spanstat.SpanStat
+	EndpointSemaphoreAcquireTime spanstat.SpanStat
+	PolicyCheckTime              spanstat.SpanStat
+	DataplaneTime                spanstat.SpanStat
+	IdentityAllocationTime       spanstat.SpanStat
+	IPCachePlumbingTime          spanstat.SpanStat
+	Success                      bool
+	Err                          error
+	DataSource                   accesslog.DNSDataSource
 }
 
 // IsTimeout return true if the ProxyRequest timeout
@@ -428,12 +653,14 @@ func (proxyStat *ProxyRequestContext) IsTimeout() bool {
 // requesting endpoint. Note that denied requests will not trigger this
 // callback.
 func StartDNSProxy(
-	address string, port uint16, enableDNSCompression bool, maxRestoreDNSIPs int,
+	address string, port uint16, enableDNSCompression bool, enableDNS0x20Encoding bool, ednsClientSubnetMode string, useReservedIdentity bool, dotConfig DoTConfig, dohConfig DoHConfig, maxRestoreDNSIPs int,
 	lookupEPFunc LookupEndpointIDByIPFunc,
 	lookupSecIDFunc LookupSecIDByIPFunc,
 	lookupIPsFunc LookupIPsBySecIDFunc,
 	notifyFunc NotifyOnDNSMsgFunc,
 	concurrencyLimit int, concurrencyGracePeriod time.Duration,
+	endpointConcurrencyLimit int,
+	endpointMaxQPS float64, endpointMaxBurst int,
 ) (*DNSProxy, error) {
 	if err := re.InitRegexCompileLRU(option.Config.FQDNRegexCompileLRUSize); err != nil {
 		return nil, fmt.Errorf("failed to start DNS proxy: %w", err)
@@ -447,6 +674,12 @@ func StartDNSProxy(
 		return nil, errors.New("DNS proxy must have lookupEPFunc and notifyFunc provided")
 	}
 
+	switch ednsClientSubnetMode {
+	case "", ECSModePreserve, ECSModeStrip, ECSModeZero:
+	default:
+		return nil, fmt.Errorf("invalid EDNS client subnet mode %q", ednsClientSubnetMode)
+	}
+
 	p := &DNSProxy{
 		LookupRegisteredEndpoint: lookupEPFunc,
 		LookupSecIDByIP:          lookupSecIDFunc,
@@ -456,15 +689,29 @@ func StartDNSProxy(
 		lookupTargetDNSServer:    lookupTargetDNSServer,
 		usedServers:              make(map[string]struct{}),
 		allowed:                  make(perEPAllow),
+		scrub:                    make(perEPScrub),
 		restored:                 make(perEPRestored),
 		restoredEPs:              make(restoredEPs),
 		EnableDNSCompression:     enableDNSCompression,
+		EnableDNS0x20Encoding:    enableDNS0x20Encoding,
+		EDNSClientSubnetMode:     ednsClientSubnetMode,
+		UseReservedIdentity:      useReservedIdentity,
+		DoT:                      dotConfig,
 		maxIPsPerRestoredDNSRule: maxRestoreDNSIPs,
 	}
 	if concurrencyLimit > 0 {
 		p.ConcurrencyLimit = semaphore.NewWeighted(int64(concurrencyLimit))
 		p.ConcurrencyGracePeriod = concurrencyGracePeriod
 	}
+	if endpointConcurrencyLimit > 0 {
+		p.EndpointConcurrencyLimit = int64(endpointConcurrencyLimit)
+		p.endpointSemaphores = make(map[uint64]*semaphore.Weighted)
+	}
+	if endpointMaxQPS > 0 {
+		p.EndpointRateLimit = rate.Limit(endpointMaxQPS)
+		p.EndpointRateBurst = endpointMaxBurst
+		p.endpointRateLimiters = make(map[uint64]*rate.Limiter)
+	}
 	atomic.StoreInt32(&p.rejectReply, dns.RcodeRefused)
 
 	// Start the DNS listeners on UDP and TCP
@@ -481,18 +728,35 @@ func StartDNSProxy(
 	// retries into the previous lookup, suppressing them.
 	p.UDPClient = &dns.Client{Net: "udp", Timeout: ProxyForwardTimeout, SingleInflight: false}
 	p.TCPClient = &dns.Client{Net: "tcp", Timeout: ProxyForwardTimeout, SingleInflight: false}
+	if dotConfig.Enabled {
+		tlsConf, err := dotConfig.tlsConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure DNS-over-TLS upstream: %w", err)
+		}
+		p.TLSClient = &dns.Client{Net: "tcp-tls", Timeout: ProxyForwardTimeout, SingleInflight: false, TLSConfig: tlsConf}
+	}
 
-	start := time.Now()
-	for time.Since(start) < ProxyBindTimeout {
-		UDPConn, TCPListener, err = bindToAddr(address, port, EnableIPv4, EnableIPv6)
-		if err == nil {
-			break
+	if option.Config.DNSProxySocketHandover {
+		UDPConn, TCPListener, err = takeoverListeners(port)
+		if err != nil {
+			log.WithError(err).Warning("Failed to take over the DNS proxy sockets of the previous agent, binding fresh sockets")
+		} else if UDPConn != nil {
+			log.WithField("address", UDPConn.LocalAddr().String()).Info("Took over the DNS proxy sockets of the previous agent")
 		}
-		log.WithError(err).Warnf("Attempt to bind DNS Proxy failed, retrying in %v", ProxyBindRetryInterval)
-		time.Sleep(ProxyBindRetryInterval)
 	}
-	if err != nil {
-		return nil, err
+	if UDPConn == nil || TCPListener == nil {
+		start := time.Now()
+		for time.Since(start) < ProxyBindTimeout {
+			UDPConn, TCPListener, err = bindToAddr(address, port, EnableIPv4, EnableIPv6)
+			if err == nil {
+				break
+			}
+			log.WithError(err).Warnf("Attempt to bind DNS Proxy failed, retrying in %v", ProxyBindRetryInterval)
+			time.Sleep(ProxyBindRetryInterval)
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	p.BindAddr = UDPConn.LocalAddr().String()
@@ -518,10 +782,37 @@ func StartDNSProxy(
 		}(s)
 	}
 
+	// Terminate DoH traffic from endpoints on a separate transparent
+	// listener, steered here by the datapath for the configured resolver
+	// IPs. Queries are policy checked and forwarded like plain DNS.
+	var DoHListener net.Listener
+	if dohConfig.Port != 0 {
+		DoHListener, err = p.startDoHServer(dohConfig, EnableIPv4, EnableIPv6)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Offer the listening sockets to the next agent instance, so that a
+	// restarting agent can take them over without a resolution gap.
+	var HandoverListener *net.UnixListener
+	if option.Config.DNSProxySocketHandover {
+		HandoverListener, err = startHandoverListener(UDPConn, TCPListener)
+		if err != nil {
+			log.WithError(err).Warning("Failed to start the DNS proxy handover listener, sockets cannot be handed over on restart")
+		}
+	}
+
 	// This function is called in proxy.Cleanup, which is added to Daemon cleanup module in bootstrapFQDN
 	p.unbindAddress = func() {
+		if HandoverListener != nil {
+			HandoverListener.Close()
+		}
 		UDPConn.Close()
 		TCPListener.Close()
+		if DoHListener != nil {
+			DoHListener.Close()
+		}
 	}
 
 	return p, nil
@@ -543,13 +834,20 @@ func (p *DNSProxy) LookupEndpointByIP(ip net.IP) (endpoint *endpoint.Endpoint, e
 // UpdateAllowed sets newRules for endpointID and destPort. It compiles the DNS
 // rules into regexes that are then used in CheckAllowed.
 func (p *DNSProxy) UpdateAllowed(endpointID uint64, destPort uint16, newRules policy.L7DataMap) error {
+	newScrub, err := getScrubEntry(newRules)
+	if err != nil {
+		return err
+	}
+
 	p.Lock()
 	defer p.Unlock()
 
-	err := p.allowed.setPortRulesForID(endpointID, destPort, newRules)
+	err = p.allowed.setPortRulesForID(endpointID, destPort, newRules)
 	if err == nil {
+		p.scrub.setPortScrubForID(endpointID, destPort, newScrub)
 		// Rules were updated based on policy, remove restored rules
 		p.removeRestoredRulesLocked(endpointID)
+		p.removeEndpointSemaphoreLocked(endpointID)
 	}
 	return err
 }
@@ -561,12 +859,53 @@ func (p *DNSProxy) UpdateAllowedFromSelectorRegexes(endpointID uint64, destPort
 
 	err := p.allowed.setPortRulesForIDFromUnifiedFormat(endpointID, destPort, newRules)
 	if err == nil {
+		// The unified format carries no AllowedCIDRs, do not scrub.
+		p.scrub.setPortScrubForID(endpointID, destPort, nil)
 		// Rules were updated based on policy, remove restored rules
 		p.removeRestoredRulesLocked(endpointID)
+		p.removeEndpointSemaphoreLocked(endpointID)
 	}
 	return err
 }
 
+// removeEndpointSemaphoreLocked drops the per-endpoint concurrency semaphore
+// and rate limiter of an endpoint which no longer has any DNS rules, i.e.
+// which was deleted.
+func (p *DNSProxy) removeEndpointSemaphoreLocked(endpointID uint64) {
+	if _, ok := p.allowed[endpointID]; !ok {
+		delete(p.endpointSemaphores, endpointID)
+		delete(p.endpointRateLimiters, endpointID)
+	}
+}
+
+// endpointSemaphore returns the semaphore bounding the in-flight DNS requests
+// of the given endpoint, creating it on first use.
+func (p *DNSProxy) endpointSemaphore(endpointID uint64) *semaphore.Weighted {
+	p.Lock()
+	defer p.Unlock()
+
+	sem, ok := p.endpointSemaphores[endpointID]
+	if !ok {
+		sem = semaphore.NewWeighted(p.EndpointConcurrencyLimit)
+		p.endpointSemaphores[endpointID] = sem
+	}
+	return sem
+}
+
+// endpointRateLimiter returns the token bucket limiting the DNS queries of
+// the given endpoint, creating it on first use.
+func (p *DNSProxy) endpointRateLimiter(endpointID uint64) *rate.Limiter {
+	p.Lock()
+	defer p.Unlock()
+
+	limiter, ok := p.endpointRateLimiters[endpointID]
+	if !ok {
+		limiter = rate.NewLimiter(p.EndpointRateLimit, p.EndpointRateBurst)
+		p.endpointRateLimiters[endpointID] = limiter
+	}
+	return limiter
+}
+
 // CheckAllowed checks endpointID, destPort, destID, destIP, and name against the rules
 // added to the proxy or restored during restart, and only returns true if this all match
 // something that was added (via UpdateAllowed or RestoreRules) previously.
@@ -590,6 +929,113 @@ func (p *DNSProxy) CheckAllowed(endpointID uint64, destPort uint16, destID ident
 	return false, nil
 }
 
+// scrubAnswers strips A and AAAA records from the answer section of a proxied
+// DNS response when the rules allowing the lookup restrict the addresses the
+// answer may carry via AllowedCIDRs. It returns the number of records
+// removed. Responses to names also allowed by a rule without AllowedCIDRs are
+// passed on unmodified.
+func (p *DNSProxy) scrubAnswers(endpointID uint64, destPort uint16, destID identity.NumericIdentity, name string, response *dns.Msg) int {
+	name = strings.ToLower(dns.Fqdn(name))
+	p.RLock()
+	defer p.RUnlock()
+
+	entry, exists := p.scrub[endpointID][destPort]
+	if !exists {
+		return 0
+	}
+
+	for selector, re := range entry.unrestricted {
+		if selector.Selects(destID) && re.MatchString(name) {
+			return 0
+		}
+	}
+
+	var allowedNets []*net.IPNet
+	for _, rule := range entry.restricted {
+		if rule.selector.Selects(destID) && rule.re.MatchString(name) {
+			allowedNets = append(allowedNets, rule.cidrs...)
+		}
+	}
+	if allowedNets == nil {
+		return 0
+	}
+
+	removed := 0
+	answers := response.Answer[:0]
+	for _, ans := range response.Answer {
+		var ip net.IP
+		switch rec := ans.(type) {
+		case *dns.A:
+			ip = rec.A
+		case *dns.AAAA:
+			ip = rec.AAAA
+		default:
+			answers = append(answers, ans)
+			continue
+		}
+		contained := false
+		for _, allowedNet := range allowedNets {
+			if allowedNet.Contains(ip) {
+				contained = true
+				break
+			}
+		}
+		if contained {
+			answers = append(answers, ans)
+		} else {
+			removed++
+		}
+	}
+	response.Answer = answers
+	return removed
+}
+
+// filterUnplumbedAnswers removes A/AAAA answer records for IPs that have not
+// been plumbed into policy yet, so that an endpoint cannot race ahead of the
+// datapath by connecting to an IP for which no policy map entry exists. It is
+// a no-op unless an IP plumbed check has been set via SetIPPlumbedCheck.
+func (p *DNSProxy) filterUnplumbedAnswers(response *dns.Msg) int {
+	p.RLock()
+	ipPlumbed := p.ipPlumbed
+	p.RUnlock()
+	if ipPlumbed == nil {
+		return 0
+	}
+
+	removed := 0
+	answers := response.Answer[:0]
+	for _, ans := range response.Answer {
+		var ip net.IP
+		switch rec := ans.(type) {
+		case *dns.A:
+			ip = rec.A
+		case *dns.AAAA:
+			ip = rec.AAAA
+		default:
+			answers = append(answers, ans)
+			continue
+		}
+		if ipPlumbed(ip) {
+			answers = append(answers, ans)
+		} else {
+			removed++
+		}
+	}
+	response.Answer = answers
+	return removed
+}
+
+// upstreamDoTAddr returns the address of the DoT upstream queries of the
+// given endpoint are forwarded to. This is the namespace override, if one is
+// configured for the endpoint's namespace, and port 853 of the originally
+// intended DNS server otherwise.
+func (p *DNSProxy) upstreamDoTAddr(ep *endpoint.Endpoint, targetServerIP net.IP) string {
+	if addr, ok := p.DoT.NamespaceUpstreams[ep.GetK8sNamespace()]; ok {
+		return addr
+	}
+	return net.JoinHostPort(targetServerIP.String(), strconv.Itoa(dotPort))
+}
+
 func configureConnection(conn *net.Conn, secId identity.NumericIdentity) error {
 	var file *os.File
 	var err error
@@ -603,6 +1049,10 @@ func configureConnection(conn *net.Conn, secId identity.NumericIdentity) error {
 		if file, err = l4conn.File(); err != nil {
 			return fmt.Errorf("can't get file from %v: %w", l4conn, err)
 		}
+	case *tls.Conn:
+		// Mark the TCP connection underneath the TLS session.
+		netConn := l4conn.NetConn()
+		return configureConnection(&netConn, secId)
 	default:
 		return fmt.Errorf("unsupported type %T", l4conn)
 	}
@@ -686,6 +1136,47 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 
 	scopedLog = scopedLog.WithField(logfields.EndpointID, ep.StringID())
 
+	if p.EndpointRateLimit > 0 {
+		// Refuse queries beyond the endpoint's token bucket before any
+		// further processing, so that a DNS flood from a single endpoint
+		// cannot saturate the proxy, the DNS response handling or identity
+		// allocation.
+		if !p.endpointRateLimiter(uint64(ep.ID)).Allow() {
+			stat.ProcessingTime.End(false)
+			if p.logLimiter.Allow() {
+				scopedLog.Error("Dropping DNS request due to the endpoint's query rate limit")
+			}
+			stat.Err = ErrEndpointRateLimited{}
+			p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, 0, "", request, protocol, false, &stat)
+			p.sendRefused(scopedLog, w, request)
+			return
+		}
+	}
+
+	if p.EndpointConcurrencyLimit > 0 {
+		// TODO: Consider plumbing the daemon context here.
+		epCtx, cancel := context.WithTimeout(context.TODO(), p.ConcurrencyGracePeriod)
+		defer cancel()
+
+		sem := p.endpointSemaphore(uint64(ep.ID))
+		stat.EndpointSemaphoreAcquireTime.Start()
+		// Enforce the per-endpoint concurrency limit so that a single
+		// endpoint cannot starve the global concurrency limit.
+		if err := p.enforceEndpointConcurrencyLimit(epCtx, sem); err != nil {
+			stat.EndpointSemaphoreAcquireTime.End(false)
+			stat.ProcessingTime.End(false)
+			if p.logLimiter.Allow() {
+				scopedLog.WithError(err).Error("Dropping DNS request due to too many DNS requests already in-flight for this endpoint")
+			}
+			stat.Err = err
+			p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, 0, "", request, protocol, false, &stat)
+			p.sendRefused(scopedLog, w, request)
+			return
+		}
+		stat.EndpointSemaphoreAcquireTime.End(true)
+		defer sem.Release(1)
+	}
+
 	targetServerIP, targetServerPort, targetServerAddr, err := p.lookupTargetDNSServer(w)
 	if err != nil {
 		log.WithError(err).Error("cannot extract destination IP:port from DNS request")
@@ -735,10 +1226,15 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	// Keep the same L4 protocol. This handles DNS re-requests over TCP, for
 	// requests that were too large for UDP.
 	var client *dns.Client
-	switch protocol {
-	case "udp":
+	upstreamAddr := targetServerAddr
+	switch {
+	case p.DoT.Enabled:
+		// DoT is TCP based, regardless of the protocol the endpoint used.
+		client = p.TLSClient
+		upstreamAddr = p.upstreamDoTAddr(ep, targetServerIP)
+	case protocol == "udp":
 		client = p.UDPClient
-	case "tcp":
+	case protocol == "tcp":
 		client = p.TCPClient
 	default:
 		scopedLog.Error("Cannot parse DNS proxy client network to select forward client")
@@ -748,12 +1244,23 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 		p.sendRefused(scopedLog, w, request)
 		return
 	}
+
+	// A namespace upstream override redirects the query away from the
+	// destination in the original packet. It is skipped with DoT, where the
+	// TLS session is pinned to the configured resolver.
+	if !p.DoT.Enabled {
+		if addr, ok := upstreamForNamespace(ep.GetK8sNamespace()); ok {
+			scopedLog.WithField("upstream", addr).Debug("Forwarding DNS request to namespace upstream override")
+			upstreamAddr = addr
+		}
+	}
+
 	stat.ProcessingTime.End(true)
 	stat.UpstreamTime.Start()
 
-	conn, err := client.Dial(targetServerAddr)
+	conn, err := client.Dial(upstreamAddr)
 	if err != nil {
-		err := fmt.Errorf("failed to dial connection to %v: %w", targetServerAddr, err)
+		err := fmt.Errorf("failed to dial connection to %v: %w", upstreamAddr, err)
 		stat.Err = err
 		scopedLog.WithError(err).Error("Failed to dial connection to the upstream DNS server, cannot service DNS request")
 		p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, targetServerID, targetServerAddr, request, protocol, false, &stat)
@@ -762,7 +1269,11 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	}
 	defer conn.Close()
 
-	if err = configureConnection(&conn.Conn, ep.GetIdentity()); err != nil {
+	upstreamIdentity := ep.GetIdentity()
+	if p.UseReservedIdentity {
+		upstreamIdentity = identity.ReservedIdentityDNSProxy
+	}
+	if err = configureConnection(&conn.Conn, upstreamIdentity); err != nil {
 		err := fmt.Errorf("failed to set socket options: %w", err)
 		stat.Err = err
 		scopedLog.WithError(err).Error("Failed to configure connection to the upstream DNS server, cannot service DNS request")
@@ -772,8 +1283,22 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	}
 
 	request.Id = dns.Id() // force a random new ID for this request
+	p.rewriteECS(request)
+	var encodedQName string
+	if p.EnableDNS0x20Encoding {
+		// Randomize the case of the query name (DNS 0x20 encoding). The
+		// upstream server echoes the name back verbatim, allowing the
+		// response to be verified below before it is accepted.
+		encodedQName = encodeDNS0x20(qname)
+		request.Question[0].Name = encodedQName
+	}
 	response, _, err := client.ExchangeWithConn(request, conn)
 	stat.UpstreamTime.End(err == nil)
+	if p.EnableDNS0x20Encoding {
+		// Restore the query name as sent by the endpoint so that error
+		// paths below log and reply with the original name.
+		request.Question[0].Name = qname
+	}
 	if err != nil {
 		stat.Err = err
 		if stat.IsTimeout() {
@@ -787,11 +1312,46 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 		return
 	}
 
+	if p.EnableDNS0x20Encoding {
+		if !responseMatchesDNS0x20(response, encodedQName) {
+			scopedLog.Warn("Dropping DNS response that does not match the DNS 0x20 encoding of the query, possible spoofing attempt")
+			stat.Err = ErrDNS0x20ResponseMismatch{qname: qname}
+			p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, targetServerID, targetServerAddr, request, protocol, false, &stat)
+			p.sendRefused(scopedLog, w, request)
+			return
+		}
+		// Rewrite the mixed-case query name in the response back to the
+		// name as sent by the endpoint.
+		restoreDNS0x20(response, encodedQName, qname)
+	}
+
 	scopedLog.WithField(logfields.Response, response).Debug("Received DNS response to proxied lookup")
+
+	// Strip answer records the endpoint is not allowed to learn before the
+	// response is shared with the DNS cache and written to the endpoint.
+	if removed := p.scrubAnswers(uint64(ep.ID), targetServerPort, targetServerID, qname, response); removed > 0 {
+		scopedLog.WithField("removedRecords", removed).Debug("Removed DNS answer records outside of the allowed CIDRs")
+	}
+
 	stat.Success = true
 
 	scopedLog.Debug("Notifying with DNS response to original DNS query")
-	p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, targetServerID, targetServerAddr, response, protocol, true, &stat)
+	if err := p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, targetServerID, targetServerAddr, response, protocol, true, &stat); err != nil {
+		// The response must not be released to the endpoint, e.g. because
+		// policy could not be updated for its IPs and the agent runs in
+		// fail-closed mode.
+		scopedLog.WithError(err).Warn("Refusing DNS response that could not be plumbed into policy")
+		p.sendRefused(scopedLog, w, request)
+		return
+	}
+
+	// Drop answer records for IPs the agent has not plumbed into policy yet.
+	// NotifyOnDNSMsg above has waited for the policy updates triggered by
+	// this response, so any IP still missing at this point is being delayed
+	// elsewhere and must not be handed to the endpoint.
+	if removed := p.filterUnplumbedAnswers(response); removed > 0 {
+		scopedLog.WithField("removedRecords", removed).Debug("Removed DNS answer records for IPs not yet plumbed into policy")
+	}
 
 	scopedLog.Debug("Responding to original DNS query")
 	// restore the ID to the one in the initial request so it matches what the requester expects.
@@ -834,6 +1394,26 @@ func (p *DNSProxy) enforceConcurrencyLimit(ctx context.Context) error {
 	return nil
 }
 
+func (p *DNSProxy) enforceEndpointConcurrencyLimit(ctx context.Context, sem *semaphore.Weighted) error {
+	if p.ConcurrencyGracePeriod == 0 {
+		// No grace time configured. Failing to acquire semaphore means
+		// immediately give up.
+		if !sem.TryAcquire(1) {
+			return ErrFailedAcquireSemaphore{
+				parallel: option.Config.DNSProxyEndpointConcurrencyLimit,
+			}
+		}
+	} else if err := sem.Acquire(ctx, 1); err != nil && errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimedOutAcquireSemaphore{
+			ErrFailedAcquireSemaphore: ErrFailedAcquireSemaphore{
+				parallel: option.Config.DNSProxyEndpointConcurrencyLimit,
+			},
+			gracePeriod: p.ConcurrencyGracePeriod,
+		}
+	}
+	return nil
+}
+
 // sendRefused creates and sends a REFUSED response for request to w
 // The returned error is logged with scopedLog and is returned for convenience
 func (p *DNSProxy) sendRefused(scopedLog *logrus.Entry, w dns.ResponseWriter, request *dns.Msg) (err error) {
@@ -861,6 +1441,15 @@ func (p *DNSProxy) SetRejectReply(opt string) {
 	}
 }
 
+// SetIPPlumbedCheck enables filtering of A/AAAA answer records down to the
+// IPs for which check returns true, i.e. the IPs that are already plumbed
+// into policy. Passing nil disables the filtering.
+func (p *DNSProxy) SetIPPlumbedCheck(check func(ip net.IP) bool) {
+	p.Lock()
+	p.ipPlumbed = check
+	p.Unlock()
+}
+
 func (p *DNSProxy) GetBindPort() uint16 {
 	return p.BindPort
 }
@@ -869,6 +1458,8 @@ func (p *DNSProxy) GetBindPort() uint16 {
 // the lowest applicable TTL, rcode, anwer rr types and question types
 // When a CNAME is returned the chain is collapsed down, keeping the lowest TTL,
 // and CNAME targets are returned.
+// SRV and MX answers are followed to their target names, accumulating the
+// A/AAAA records of the targets from the answer and additional sections.
 func ExtractMsgDetails(msg *dns.Msg) (qname string, responseIPs []net.IP, TTL uint32, CNAMEs []string, rcode int, answerTypes []uint16, qTypes []uint16, err error) {
 	if len(msg.Question) == 0 {
 		return "", nil, 0, nil, 0, nil, nil, errors.New("Invalid DNS message")
@@ -879,13 +1470,20 @@ func ExtractMsgDetails(msg *dns.Msg) (qname string, responseIPs []net.IP, TTL ui
 	// This will change when we see CNAMEs.
 	rrName := strings.ToLower(qname)
 
+	// targetNames are the SRV and MX target names seen so far. A/AAAA
+	// records for these names are accumulated like records for the query
+	// name itself.
+	var targetNames map[string]struct{}
+
 	TTL = math.MaxUint32 // a TTL must exist in the RRs
 
 	answerTypes = make([]uint16, 0, len(msg.Answer))
 	for _, ans := range msg.Answer {
 		// Ensure we have records for DNS names we expect
-		if strings.ToLower(ans.Header().Name) != rrName {
-			return qname, nil, 0, nil, 0, nil, nil, fmt.Errorf("Unexpected name (%s) in RRs for %s (query for %s)", ans, rrName, qname)
+		if name := strings.ToLower(ans.Header().Name); name != rrName {
+			if _, isTarget := targetNames[name]; !isTarget {
+				return qname, nil, 0, nil, 0, nil, nil, fmt.Errorf("Unexpected name (%s) in RRs for %s (query for %s)", ans, rrName, qname)
+			}
 		}
 
 		// Handle A, AAAA and CNAME records by accumulating IPs and lowest TTL
@@ -908,10 +1506,48 @@ func ExtractMsgDetails(msg *dns.Msg) (qname string, responseIPs []net.IP, TTL ui
 			}
 			rrName = strings.ToLower(ans.Target)
 			CNAMEs = append(CNAMEs, ans.Target)
+		case *dns.SRV:
+			if TTL > ans.Hdr.Ttl {
+				TTL = ans.Hdr.Ttl
+			}
+			if targetNames == nil {
+				targetNames = make(map[string]struct{})
+			}
+			targetNames[strings.ToLower(ans.Target)] = struct{}{}
+		case *dns.MX:
+			if TTL > ans.Hdr.Ttl {
+				TTL = ans.Hdr.Ttl
+			}
+			if targetNames == nil {
+				targetNames = make(map[string]struct{})
+			}
+			targetNames[strings.ToLower(ans.Mx)] = struct{}{}
 		}
 		answerTypes = append(answerTypes, ans.Header().Rrtype)
 	}
 
+	// The A/AAAA records of SRV and MX targets are commonly returned in the
+	// additional section rather than the answer section.
+	if len(targetNames) > 0 {
+		for _, rr := range msg.Extra {
+			if _, isTarget := targetNames[strings.ToLower(rr.Header().Name)]; !isTarget {
+				continue
+			}
+			switch rr := rr.(type) {
+			case *dns.A:
+				responseIPs = append(responseIPs, rr.A)
+				if TTL > rr.Hdr.Ttl {
+					TTL = rr.Hdr.Ttl
+				}
+			case *dns.AAAA:
+				responseIPs = append(responseIPs, rr.AAAA)
+				if TTL > rr.Hdr.Ttl {
+					TTL = rr.Hdr.Ttl
+				}
+			}
+		}
+	}
+
 	qTypes = make([]uint16, 0, len(msg.Question))
 	for _, q := range msg.Question {
 		qTypes = append(qTypes, q.Qtype)
@@ -920,6 +1556,22 @@ func ExtractMsgDetails(msg *dns.Msg) (qname string, responseIPs []net.IP, TTL ui
 	return qname, responseIPs, TTL, CNAMEs, msg.Rcode, answerTypes, qTypes, nil
 }
 
+// ExtractNegativeTTL returns the time a negative response may be cached, per
+// RFC 2308: the minimum of the TTL and minimum TTL field of the SOA record in
+// the authority section. 0 is returned when the response carries no SOA
+// record, leaving the caching time to the configured minimum TTL.
+func ExtractNegativeTTL(msg *dns.Msg) uint32 {
+	for _, ns := range msg.Ns {
+		if soa, ok := ns.(*dns.SOA); ok {
+			if soa.Minttl < soa.Hdr.Ttl {
+				return soa.Minttl
+			}
+			return soa.Hdr.Ttl
+		}
+	}
+	return 0
+}
+
 // bindToAddr attempts to bind to address and port for both UDP and TCP. If
 // port is 0 a random open port is assigned and the same one is used for UDP
 // and TCP.
@@ -978,6 +1630,45 @@ func shouldCompressResponse(request, response *dns.Msg) bool {
 	return false
 }
 
+// encodeDNS0x20 randomizes the case of the ASCII letters in qname (DNS 0x20
+// encoding). Each letter carries one bit of entropy that an off-path attacker
+// must guess in addition to the request ID and source port.
+func encodeDNS0x20(qname string) string {
+	encoded := []byte(qname)
+	randomBits := make([]byte, len(encoded))
+	if _, err := rand.Read(randomBits); err != nil {
+		// Without random bits the name cannot be encoded. Fall back to the
+		// original name, the request ID and source port remain random.
+		return qname
+	}
+	for i, b := range encoded {
+		if ('a' <= b && b <= 'z' || 'A' <= b && b <= 'Z') && randomBits[i]&1 == 1 {
+			encoded[i] = b ^ 0x20
+		}
+	}
+	return string(encoded)
+}
+
+// responseMatchesDNS0x20 reports whether the response echoes the exact
+// mixed-case query name that was forwarded upstream.
+func responseMatchesDNS0x20(response *dns.Msg, encodedQName string) bool {
+	return len(response.Question) == 1 && response.Question[0].Name == encodedQName
+}
+
+// restoreDNS0x20 rewrites the mixed-case query name in the response back to
+// qname, both in the question section and in any record owner names that echo
+// the encoded name.
+func restoreDNS0x20(response *dns.Msg, encodedQName, qname string) {
+	response.Question[0].Name = qname
+	for _, rrs := range [][]dns.RR{response.Answer, response.Ns, response.Extra} {
+		for _, rr := range rrs {
+			if rr.Header().Name == encodedQName {
+				rr.Header().Name = qname
+			}
+		}
+	}
+}
+
 func GetSelectorRegexMap(l7 policy.L7DataMap) (CachedSelectorREEntry, error) {
 	newRE := make(CachedSelectorREEntry)
 	for selector, l7Rules := range l7 {
@@ -1008,6 +1699,77 @@ func GetSelectorRegexMap(l7 policy.L7DataMap) (CachedSelectorREEntry, error) {
 	return newRE, nil
 }
 
+// getScrubEntry compiles the answer scrubbing entry for the DNS rules in l7.
+// It returns nil when no rule carries AllowedCIDRs, in which case responses
+// are passed on unmodified.
+func getScrubEntry(l7 policy.L7DataMap) (*scrubEntry, error) {
+	hasCIDRs := false
+	for _, l7Rules := range l7 {
+		if l7Rules == nil {
+			continue
+		}
+		for _, dnsRule := range l7Rules.DNS {
+			if len(dnsRule.AllowedCIDRs) > 0 {
+				hasCIDRs = true
+			}
+		}
+	}
+	if !hasCIDRs {
+		return nil, nil
+	}
+
+	entry := &scrubEntry{unrestricted: make(CachedSelectorREEntry)}
+	for selector, l7Rules := range l7 {
+		if l7Rules == nil {
+			l7Rules = &policy.PerSelectorPolicy{L7Rules: api.L7Rules{DNS: []api.PortRuleDNS{{MatchPattern: "*"}}}}
+		}
+		reStrings := make([]string, 0, len(l7Rules.DNS))
+		for _, dnsRule := range l7Rules.DNS {
+			ruleREStrings := make([]string, 0, 2)
+			if len(dnsRule.MatchName) > 0 {
+				dnsRuleName := strings.ToLower(dns.Fqdn(dnsRule.MatchName))
+				dnsPatternAsRE := matchpattern.ToRegexp(dnsRuleName)
+				ruleREStrings = append(ruleREStrings, "("+dnsPatternAsRE+")")
+			}
+			if len(dnsRule.MatchPattern) > 0 {
+				dnsPattern := matchpattern.Sanitize(dnsRule.MatchPattern)
+				dnsPatternAsRE := matchpattern.ToRegexp(dnsPattern)
+				ruleREStrings = append(ruleREStrings, "("+dnsPatternAsRE+")")
+			}
+			if len(dnsRule.AllowedCIDRs) == 0 {
+				reStrings = append(reStrings, ruleREStrings...)
+				continue
+			}
+			rei, err := re.CompileRegex(strings.Join(ruleREStrings, "|"))
+			if err != nil {
+				return nil, err
+			}
+			cidrs := make([]*net.IPNet, 0, len(dnsRule.AllowedCIDRs))
+			for _, cidr := range dnsRule.AllowedCIDRs {
+				_, ipnet, err := net.ParseCIDR(string(cidr))
+				if err != nil {
+					return nil, fmt.Errorf("unable to parse AllowedCIDRs entry %q: %w", cidr, err)
+				}
+				cidrs = append(cidrs, ipnet)
+			}
+			entry.restricted = append(entry.restricted, scrubRule{
+				selector: selector,
+				re:       rei,
+				cidrs:    cidrs,
+			})
+		}
+		if len(reStrings) > 0 {
+			rei, err := re.CompileRegex(strings.Join(reStrings, "|"))
+			if err != nil {
+				return nil, err
+			}
+			entry.unrestricted[selector] = rei
+		}
+	}
+
+	return entry, nil
+}
+
 func (p *DNSProxy) Cleanup() {
 	if p.unbindAddress != nil {
 		p.unbindAddress()
@@ -13,6 +13,7 @@ import (
 	"os"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -193,7 +194,7 @@ func (s *DNSProxyTestSuite) SetUpTest(c *C) {
 	c.Assert(s.dnsServer, Not(IsNil), Commentf("unable to setup DNS server"))
 
 	option.Config.FQDNRegexCompileLRUSize = 1024
-	proxy, err := StartDNSProxy("", 0, true, 1000, // any address, any port, enable compression, max 1000 restore IPs
+	proxy, err := StartDNSProxy("", 0, true, false, ECSModePreserve, false, DoTConfig{}, DoHConfig{}, 1000, // any address, any port, enable compression, no 0x20 encoding, preserve ECS, endpoint identity for upstream connections, no DoT, no DoH, max 1000 restore IPs
 		// LookupEPByIP
 		func(ip net.IP) (*endpoint.Endpoint, error) {
 			if s.restoring {
@@ -233,7 +234,7 @@ func (s *DNSProxyTestSuite) SetUpTest(c *C) {
 		func(lookupTime time.Time, ep *endpoint.Endpoint, epIPPort string, serverID identity.NumericIdentity, dstAddr string, msg *dns.Msg, protocol string, allowed bool, stat *ProxyRequestContext) error {
 			return nil
 		},
-		0, 0,
+		0, 0, 0, 0, 0,
 	)
 	c.Assert(err, IsNil, Commentf("error starting DNS Proxy"))
 	s.proxy = proxy
@@ -406,6 +407,90 @@ func (s *DNSProxyTestSuite) TestRespondViaCorrectProtocol(c *C) {
 
 }
 
+func (s *DNSProxyTestSuite) TestScrubAnswersOutsideAllowedCIDRs(c *C) {
+	name := "cilium.io."
+	query := name
+	request := new(dns.Msg)
+	request.SetQuestion(query, dns.TypeA)
+
+	// The test server answers with 1.1.1.1, which is outside of the allowed
+	// CIDRs, so the A record is stripped from the response.
+	l7map := policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: name, AllowedCIDRs: []api.CIDR{"2.0.0.0/8"}}},
+			},
+		},
+	}
+	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+	response, _, err := s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(len(response.Answer), Equals, 0, Commentf("Proxy did not strip disallowed answer RRs %s", response))
+
+	// With the answer inside the allowed CIDRs the response is unmodified.
+	l7map = policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: name, AllowedCIDRs: []api.CIDR{"1.1.1.0/24"}}},
+			},
+		},
+	}
+	err = s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+	response, _, err = s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(len(response.Answer), Equals, 1, Commentf("Proxy stripped answer RRs inside the allowed CIDRs %s", response))
+
+	// A second rule matching the same name without AllowedCIDRs lifts the
+	// restriction.
+	l7map = policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{
+					{MatchName: name, AllowedCIDRs: []api.CIDR{"2.0.0.0/8"}},
+					{MatchPattern: "*"},
+				},
+			},
+		},
+	}
+	err = s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+	response, _, err = s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(len(response.Answer), Equals, 1, Commentf("Proxy scrubbed the response despite an unrestricted rule %s", response))
+}
+
+func (s *DNSProxyTestSuite) TestFilterUnplumbedAnswers(c *C) {
+	name := "cilium.io."
+	request := new(dns.Msg)
+	request.SetQuestion(name, dns.TypeA)
+
+	l7map := policy.L7DataMap{
+		cachedDstID1Selector: &policy.PerSelectorPolicy{
+			L7Rules: api.L7Rules{
+				DNS: []api.PortRuleDNS{{MatchName: name}},
+			},
+		},
+	}
+	err := s.proxy.UpdateAllowed(epID1, dstPort, l7map)
+	c.Assert(err, Equals, nil, Commentf("Could not update with rules"))
+
+	// The test server answers with 1.1.1.1. With no IP reported as plumbed
+	// the A record is stripped from the response.
+	s.proxy.SetIPPlumbedCheck(func(ip net.IP) bool { return false })
+	defer s.proxy.SetIPPlumbedCheck(nil)
+	response, _, err := s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(len(response.Answer), Equals, 0, Commentf("Proxy did not strip answer RRs for unplumbed IPs %s", response))
+
+	// Once the IP is reported as plumbed the response is unmodified.
+	s.proxy.SetIPPlumbedCheck(func(ip net.IP) bool { return ip.Equal(net.ParseIP("1.1.1.1")) })
+	response, _, err = s.dnsTCPClient.Exchange(request, s.proxy.TCPServer.Listener.Addr().String())
+	c.Assert(err, IsNil, Commentf("DNS request from test client failed when it should succeed"))
+	c.Assert(len(response.Answer), Equals, 1, Commentf("Proxy stripped answer RRs for plumbed IPs %s", response))
+}
+
 func (s *DNSProxyTestSuite) TestRespondMixedCaseInRequestResponse(c *C) {
 	// Test that mixed case query is allowed out and then back in to support
 	// high-order-bit query uniqueing schemes (and a data exfiltration
@@ -440,6 +525,30 @@ func (s *DNSProxyTestSuite) TestRespondMixedCaseInRequestResponse(c *C) {
 	c.Assert(response.Answer[0].String(), Equals, "ciliuM.io.\t60\tIN\tA\t1.1.1.1", Commentf("Proxy returned incorrect RRs"))
 }
 
+func (s *DNSProxyTestSuite) TestDNS0x20Encoding(c *C) {
+	qname := "sub.cilium.io."
+	encoded := encodeDNS0x20(qname)
+	c.Assert(strings.EqualFold(encoded, qname), Equals, true, Commentf("0x20 encoding changed more than the case of %s: %s", qname, encoded))
+
+	response := new(dns.Msg)
+	response.SetQuestion(encoded, dns.TypeA)
+	retARR, err := dns.NewRR(encoded + " 60 IN A 1.1.1.1")
+	c.Assert(err, IsNil, Commentf("Could not create test A record"))
+	response.Answer = append(response.Answer, retARR)
+
+	c.Assert(responseMatchesDNS0x20(response, encoded), Equals, true, Commentf("response echoing the encoded name was not accepted"))
+	restoreDNS0x20(response, encoded, qname)
+	c.Assert(response.Question[0].Name, Equals, qname, Commentf("query name case was not restored in the response"))
+	c.Assert(response.Answer[0].Header().Name, Equals, qname, Commentf("owner name case was not restored in the answer"))
+
+	// Flip the case of the first letter so the response no longer echoes the
+	// encoded name.
+	mismatched := []byte(encoded)
+	mismatched[0] ^= 0x20
+	response.SetQuestion(string(mismatched), dns.TypeA)
+	c.Assert(responseMatchesDNS0x20(response, encoded), Equals, false, Commentf("response with a different case was accepted"))
+}
+
 func (s *DNSProxyTestSuite) TestCheckAllowedTwiceRemovedOnce(c *C) {
 	name := "cilium.io."
 	l7map := policy.L7DataMap{
@@ -42,6 +42,23 @@ type NameManager struct {
 	// cache is a private copy of the pointer from config.
 	cache *DNSCache
 
+	// selectorMetricLabels maps each registered selector to the label value
+	// its per-selector metrics are emitted under, see
+	// registerSelectorMetricsLocked.
+	selectorMetricLabels map[api.FQDNSelector]string
+
+	// selectorMetricsInUse is the number of distinct selector labels
+	// currently emitted, bounded by fqdnSelectorMetricsLimit.
+	selectorMetricsInUse int
+
+	// preResolver proactively resolves registered matchNames. It is nil
+	// unless pre-resolution is enabled in the config.
+	preResolver *preResolver
+
+	// responseValidators are run over incoming DNS responses before they
+	// are admitted into the cache, see RegisterResponseValidator.
+	responseValidators []ResponseValidator
+
 	bootstrapCompleted bool
 }
 
@@ -64,6 +81,69 @@ func (n *NameManager) GetModel() *models.NameManager {
 	}
 }
 
+// GetSelectorsMatchingName returns all registered FQDNSelectors whose compiled
+// regex matches dnsName, along with the regex each selector compiled to.
+// dnsName must be in FQDN form, i.e. lowercased with a trailing ".".
+func (n *NameManager) GetSelectorsMatchingName(dnsName string) map[api.FQDNSelector]*regexp.Regexp {
+	n.RWMutex.RLock()
+	defer n.RWMutex.RUnlock()
+
+	matching := make(map[api.FQDNSelector]*regexp.Regexp)
+	for fqdnSel, fqdnRegex := range n.allSelectors {
+		if fqdnRegex.MatchString(dnsName) {
+			matching[fqdnSel] = fqdnRegex
+		}
+	}
+	return matching
+}
+
+// clampTTLLocked applies the TTL clamps of all registered selectors matching
+// dnsName to ttl. The largest MinTTL and the smallest MaxTTL among the
+// matching selectors apply; when they conflict, MaxTTL wins so that
+// aggressive expiry is honored. clamped is true when at least one matching
+// selector carries a clamp, in which case the clamp overrides the global
+// minimum TTL.
+// The NameManager lock must be held.
+func (n *NameManager) clampTTLLocked(dnsName string, ttl int) (clampedTTL int, clamped bool) {
+	var minTTL, maxTTL int
+	for fqdnSel, fqdnRegex := range n.allSelectors {
+		if fqdnSel.MinTTL == 0 && fqdnSel.MaxTTL == 0 {
+			continue
+		}
+		if !fqdnRegex.MatchString(dnsName) {
+			continue
+		}
+		clamped = true
+		if int(fqdnSel.MinTTL) > minTTL {
+			minTTL = int(fqdnSel.MinTTL)
+		}
+		if fqdnSel.MaxTTL != 0 && (maxTTL == 0 || int(fqdnSel.MaxTTL) < maxTTL) {
+			maxTTL = int(fqdnSel.MaxTTL)
+		}
+	}
+
+	if !clamped {
+		return ttl, false
+	}
+	if minTTL > 0 && ttl < minTTL {
+		ttl = minTTL
+	}
+	if maxTTL > 0 && ttl > maxTTL {
+		ttl = maxTTL
+	}
+	return ttl, true
+}
+
+// ClampTTL applies the TTL clamps of all registered ToFQDNs selectors
+// matching dnsName to ttl. clamped is true when a matching selector carries a
+// clamp, signalling that the result must be used as-is instead of being
+// raised to the global minimum TTL.
+func (n *NameManager) ClampTTL(dnsName string, ttl int) (clampedTTL int, clamped bool) {
+	n.RWMutex.RLock()
+	defer n.RWMutex.RUnlock()
+	return n.clampTTLLocked(dnsName, ttl)
+}
+
 // Lock must be held during any calls to RegisterForIdentityUpdatesLocked or
 // UnregisterForIdentityUpdatesLocked.
 func (n *NameManager) Lock() {
@@ -99,13 +179,22 @@ func (n *NameManager) RegisterForIdentityUpdatesLocked(selector api.FQDNSelector
 	}
 
 	n.allSelectors[selector] = regex
+	n.registerSelectorMetricsLocked(selector)
+
+	if n.preResolver != nil && selector.MatchName != "" {
+		n.preResolver.addName(prepareMatchName(selector.MatchName))
+	}
 }
 
 // UnregisterForIdentityUpdatesLocked removes this FQDNSelector from the set of
 // FQDNSelectors which are being tracked by the NameManager. No more updates
 // for IPs which correspond to said selector are propagated.
 func (n *NameManager) UnregisterForIdentityUpdatesLocked(selector api.FQDNSelector) {
+	if _, exists := n.allSelectors[selector]; exists && n.preResolver != nil && selector.MatchName != "" {
+		n.preResolver.removeName(prepareMatchName(selector.MatchName))
+	}
 	delete(n.allSelectors, selector)
+	n.unregisterSelectorMetricsLocked(selector)
 }
 
 // NewNameManager creates an initialized NameManager.
@@ -122,12 +211,18 @@ func NewNameManager(config Config) *NameManager {
 		}
 	}
 
-	return &NameManager{
-		config:       config,
-		allSelectors: make(map[api.FQDNSelector]*regexp.Regexp),
-		cache:        config.Cache,
+	n := &NameManager{
+		config:               config,
+		allSelectors:         make(map[api.FQDNSelector]*regexp.Regexp),
+		cache:                config.Cache,
+		selectorMetricLabels: make(map[api.FQDNSelector]string),
+	}
+
+	if config.PreResolve {
+		n.preResolver = newPreResolver(config.PreResolveLookup)
 	}
 
+	return n
 }
 
 // GetDNSCache returns the DNSCache used by the NameManager
@@ -135,12 +230,40 @@ func (n *NameManager) GetDNSCache() *DNSCache {
 	return n.cache
 }
 
+// RegisterResponseValidator adds a validator that is consulted for every DNS
+// response before it is admitted into the cache and used to generate policy.
+// Validators run in registration order; the first rejection wins.
+func (n *NameManager) RegisterResponseValidator(v ResponseValidator) {
+	n.Lock()
+	n.responseValidators = append(n.responseValidators, v)
+	n.Unlock()
+}
+
+// validateResponsesLocked runs the registered response validators over
+// updatedDNSIPs, removing any names whose response was rejected.
+func (n *NameManager) validateResponsesLocked(updatedDNSIPs map[string]*DNSIPRecords) {
+	for dnsName, record := range updatedDNSIPs {
+		for _, v := range n.responseValidators {
+			if err := v.ValidateResponse(dnsName, record); err != nil {
+				log.WithError(err).WithFields(logrus.Fields{
+					logfields.DNSName: dnsName,
+					"validator":       v.Name(),
+				}).Warning("Rejecting DNS response")
+				delete(updatedDNSIPs, dnsName)
+				break
+			}
+		}
+	}
+}
+
 // UpdateGenerateDNS inserts the new DNS information into the cache. If the IPs
 // have changed for a name they will be reflected in updatedDNSIPs.
 func (n *NameManager) UpdateGenerateDNS(ctx context.Context, lookupTime time.Time, updatedDNSIPs map[string]*DNSIPRecords) (wg *sync.WaitGroup, usedIdentities []*identity.Identity, newlyAllocatedIdentities map[netip.Prefix]*identity.Identity, err error) {
 	n.RWMutex.Lock()
 	defer n.RWMutex.Unlock()
 
+	n.validateResponsesLocked(updatedDNSIPs)
+
 	// Update IPs in n
 	fqdnSelectorsToUpdate, updatedDNSNames := n.updateDNSIPs(lookupTime, updatedDNSIPs)
 	for dnsName, IPs := range updatedDNSNames {
@@ -157,7 +280,12 @@ func (n *NameManager) UpdateGenerateDNS(ctx context.Context, lookupTime time.Tim
 			Debug("No IPs to insert when generating DNS name selected by ToFQDN rule")
 	}
 
-	return n.config.UpdateSelectors(ctx, selectorIPMapping, namesMissingIPs)
+	n.accountSelectorResponseMatchesLocked(fqdnSelectorsToUpdate)
+	n.updateSelectorIPMetricsLocked(selectorIPMapping)
+
+	wg, usedIdentities, newlyAllocatedIdentities, err = n.config.UpdateSelectors(ctx, selectorIPMapping, namesMissingIPs)
+	n.accountSelectorIdentityAllocationsLocked(selectorIPMapping, newlyAllocatedIdentities)
+	return wg, usedIdentities, newlyAllocatedIdentities, err
 }
 
 // ForceGenerateDNS unconditionally regenerates all rules that refer to DNS
@@ -184,6 +312,8 @@ func (n *NameManager) ForceGenerateDNS(ctx context.Context, namesToRegen []strin
 			Debug("No IPs to insert when generating DNS name selected by ToFQDN rule")
 	}
 
+	n.updateSelectorIPMetricsLocked(selectorIPMapping)
+
 	// Emit the new rules.
 	// Ignore newly allocated IDs (3rd result) as this is only used for deletes.
 	wg, _, _, err = n.config.UpdateSelectors(ctx, selectorIPMapping, namesMissingIPs)
@@ -245,11 +375,17 @@ func (n *NameManager) updateDNSIPs(lookupTime time.Time, updatedDNSIPs map[strin
 func (n *NameManager) updateIPsForName(lookupTime time.Time, dnsName string, newIPs []netip.Addr, ttl int) (updated bool) {
 	cacheIPs := n.cache.Lookup(dnsName)
 
-	if n.config.MinTTL > ttl {
+	// Per-rule TTL clamps override the global minimum TTL.
+	ttl, clamped := n.clampTTLLocked(dnsName, ttl)
+	if !clamped && n.config.MinTTL > ttl {
 		ttl = n.config.MinTTL
 	}
 
-	n.cache.Update(lookupTime, dnsName, newIPs, ttl)
+	if clamped {
+		n.cache.UpdateWithExactTTL(lookupTime, dnsName, newIPs, ttl)
+	} else {
+		n.cache.Update(lookupTime, dnsName, newIPs, ttl)
+	}
 	sortedNewIPs := n.cache.Lookup(dnsName) // DNSCache returns IPs sorted
 
 	// The 0 checks below account for an unlike race condition where this
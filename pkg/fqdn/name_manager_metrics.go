@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package fqdn
+
+import (
+	"net"
+	"net/netip"
+
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/ip"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+const (
+	// fqdnSelectorMetricsLimit caps the number of distinct selector label
+	// values emitted by the per-selector FQDN metrics. Selectors registered
+	// beyond the limit are aggregated under fqdnSelectorMetricsOther to bound
+	// metrics cardinality.
+	fqdnSelectorMetricsLimit = 100
+
+	// fqdnSelectorMetricsOther is the label value aggregating selectors
+	// registered beyond fqdnSelectorMetricsLimit.
+	fqdnSelectorMetricsOther = "other"
+)
+
+// selectorMetricLabel returns the metrics label value of a selector, its
+// matchName or matchPattern.
+func selectorMetricLabel(selector api.FQDNSelector) string {
+	if selector.MatchName != "" {
+		return selector.MatchName
+	}
+	return selector.MatchPattern
+}
+
+// registerSelectorMetricsLocked assigns the metrics label of a newly
+// registered selector. Once fqdnSelectorMetricsLimit distinct labels are in
+// use, further selectors are aggregated under fqdnSelectorMetricsOther.
+func (n *NameManager) registerSelectorMetricsLocked(selector api.FQDNSelector) {
+	label := fqdnSelectorMetricsOther
+	if n.selectorMetricsInUse < fqdnSelectorMetricsLimit {
+		label = selectorMetricLabel(selector)
+		n.selectorMetricsInUse++
+		metrics.FQDNSelectorIPs.WithLabelValues(label).Set(0)
+	}
+	n.selectorMetricLabels[selector] = label
+}
+
+// unregisterSelectorMetricsLocked drops the metrics series of an
+// unregistered selector, freeing its label for later selectors.
+func (n *NameManager) unregisterSelectorMetricsLocked(selector api.FQDNSelector) {
+	label, exists := n.selectorMetricLabels[selector]
+	if !exists {
+		return
+	}
+	delete(n.selectorMetricLabels, selector)
+	if label == fqdnSelectorMetricsOther {
+		return
+	}
+	n.selectorMetricsInUse--
+	metrics.FQDNSelectorIPs.DeleteLabelValues(label)
+	metrics.FQDNSelectorIdentityAllocations.DeleteLabelValues(label)
+	metrics.FQDNSelectorResponsesMatched.DeleteLabelValues(label)
+}
+
+// updateSelectorIPMetricsLocked records the number of IPs currently selected
+// by each selector in selectorIPMapping. Selectors aggregated under the
+// "other" label are skipped as a shared gauge would be meaningless.
+func (n *NameManager) updateSelectorIPMetricsLocked(selectorIPMapping map[api.FQDNSelector][]net.IP) {
+	for selector, ips := range selectorIPMapping {
+		label, exists := n.selectorMetricLabels[selector]
+		if !exists || label == fqdnSelectorMetricsOther {
+			continue
+		}
+		metrics.FQDNSelectorIPs.WithLabelValues(label).Set(float64(len(ips)))
+	}
+}
+
+// accountSelectorResponseMatchesLocked counts the DNS responses that matched
+// each selector, for the names updated by a DNS response.
+func (n *NameManager) accountSelectorResponseMatchesLocked(affectedSelectors map[api.FQDNSelector]struct{}) {
+	for selector := range affectedSelectors {
+		if label, exists := n.selectorMetricLabels[selector]; exists {
+			metrics.FQDNSelectorResponsesMatched.WithLabelValues(label).Inc()
+		}
+	}
+}
+
+// accountSelectorIdentityAllocationsLocked attributes newly allocated
+// identities to the selectors whose IPs triggered the allocation.
+func (n *NameManager) accountSelectorIdentityAllocationsLocked(selectorIPMapping map[api.FQDNSelector][]net.IP, newlyAllocatedIdentities map[netip.Prefix]*identity.Identity) {
+	if len(newlyAllocatedIdentities) == 0 {
+		return
+	}
+
+	allocatedAddrs := make(map[netip.Addr]struct{}, len(newlyAllocatedIdentities))
+	for prefix := range newlyAllocatedIdentities {
+		allocatedAddrs[prefix.Addr()] = struct{}{}
+	}
+
+	for selector, ips := range selectorIPMapping {
+		label, exists := n.selectorMetricLabels[selector]
+		if !exists {
+			continue
+		}
+		allocations := 0
+		for _, addr := range ip.MustAddrsFromIPs(ips) {
+			if _, allocated := allocatedAddrs[addr]; allocated {
+				allocations++
+			}
+		}
+		if allocations > 0 {
+			metrics.FQDNSelectorIdentityAllocations.WithLabelValues(label).Add(float64(allocations))
+		}
+	}
+}
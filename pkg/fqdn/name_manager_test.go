@@ -5,6 +5,7 @@ package fqdn
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"net/netip"
 	"sync"
@@ -140,3 +141,96 @@ func (ds *FQDNTestSuite) TestNameManagerMultiIPUpdate(c *C) {
 	nameManager.Unlock()
 
 }
+
+// TestNameManagerClampTTL tests that per-selector TTL clamps are applied to
+// TTLs of matching names, overriding the global minimum TTL.
+func (ds *FQDNTestSuite) TestNameManagerClampTTL(c *C) {
+	nameManager := NewNameManager(Config{
+		MinTTL: 100,
+		Cache:  NewDNSCache(0),
+
+		UpdateSelectors: func(ctx context.Context, selectorIPMapping map[api.FQDNSelector][]net.IP, selectorsWithoutIPs []api.FQDNSelector) (*sync.WaitGroup, []*identity.Identity, map[netip.Prefix]*identity.Identity, error) {
+			return &sync.WaitGroup{}, nil, nil, nil
+		},
+	})
+
+	clampedSel := api.FQDNSelector{MatchName: "cilium.io", MinTTL: 10, MaxTTL: 300}
+	nameManager.Lock()
+	nameManager.RegisterForIdentityUpdatesLocked(clampedSel)
+	nameManager.Unlock()
+
+	// TTLs of matching names are raised to MinTTL and capped at MaxTTL.
+	ttl, clamped := nameManager.ClampTTL(dns.FQDN("cilium.io"), 5)
+	c.Assert(clamped, Equals, true)
+	c.Assert(ttl, Equals, 10)
+
+	ttl, clamped = nameManager.ClampTTL(dns.FQDN("cilium.io"), 4000)
+	c.Assert(clamped, Equals, true)
+	c.Assert(ttl, Equals, 300)
+
+	ttl, clamped = nameManager.ClampTTL(dns.FQDN("cilium.io"), 60)
+	c.Assert(clamped, Equals, true)
+	c.Assert(ttl, Equals, 60)
+
+	// Names without a matching clamped selector are left alone.
+	ttl, clamped = nameManager.ClampTTL(dns.FQDN("github.com"), 5)
+	c.Assert(clamped, Equals, false)
+	c.Assert(ttl, Equals, 5)
+
+	// The per-rule clamp overrides the global minimum TTL in the cache.
+	_, _, _, err := nameManager.UpdateGenerateDNS(context.Background(), time.Now(), map[string]*DNSIPRecords{dns.FQDN("cilium.io"): {TTL: 5, IPs: []net.IP{net.ParseIP("10.0.0.1")}}})
+	c.Assert(err, IsNil)
+	lookups := nameManager.cache.Dump()
+	c.Assert(lookups, HasLen, 1)
+	c.Assert(lookups[0].TTL, Equals, 10)
+}
+
+// testResponseValidator rejects responses containing a blocked IP and clamps
+// TTLs to annotate responses in place.
+type testResponseValidator struct {
+	blockedIP net.IP
+	maxTTL    int
+}
+
+func (v *testResponseValidator) Name() string { return "test-validator" }
+
+func (v *testResponseValidator) ValidateResponse(dnsName string, record *DNSIPRecords) error {
+	for _, ip := range record.IPs {
+		if ip.Equal(v.blockedIP) {
+			return fmt.Errorf("response for %s resolves to blocked IP %s", dnsName, ip)
+		}
+	}
+	if record.TTL > v.maxTTL {
+		record.TTL = v.maxTTL
+	}
+	return nil
+}
+
+// Test that registered response validators can reject or annotate DNS
+// responses before they are admitted into the cache.
+func (ds *FQDNTestSuite) TestNameManagerResponseValidator(c *C) {
+	nameManager := NewNameManager(Config{
+		MinTTL: 1,
+		Cache:  NewDNSCache(0),
+	})
+	nameManager.RegisterResponseValidator(&testResponseValidator{
+		blockedIP: net.ParseIP("10.0.0.1"),
+		maxTTL:    60,
+	})
+
+	nameManager.Lock()
+	nameManager.RegisterForIdentityUpdatesLocked(ciliumIOSel)
+	nameManager.Unlock()
+
+	// A rejected response is not inserted into the cache.
+	_, _, _, err := nameManager.UpdateGenerateDNS(context.Background(), time.Now(), map[string]*DNSIPRecords{dns.FQDN("cilium.io"): {TTL: 60, IPs: []net.IP{net.ParseIP("10.0.0.1")}}})
+	c.Assert(err, IsNil)
+	c.Assert(nameManager.cache.Dump(), HasLen, 0)
+
+	// An admitted response carries the validator's annotations.
+	_, _, _, err = nameManager.UpdateGenerateDNS(context.Background(), time.Now(), map[string]*DNSIPRecords{dns.FQDN("cilium.io"): {TTL: 3600, IPs: []net.IP{net.ParseIP("1.1.1.1")}}})
+	c.Assert(err, IsNil)
+	lookups := nameManager.cache.Dump()
+	c.Assert(lookups, HasLen, 1)
+	c.Assert(lookups[0].TTL, Equals, 60)
+}
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package fqdn
+
+import (
+	"context"
+	"net"
+	"time"
+
+	miekgdns "github.com/miekg/dns"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+const (
+	// preResolveMinInterval bounds how often a name is re-resolved,
+	// regardless of how small the TTL of the last response was.
+	preResolveMinInterval = 5 * time.Second
+
+	// preResolveRetryInterval is the time after which a failed lookup is
+	// retried.
+	preResolveRetryInterval = 30 * time.Second
+
+	// preResolveTimeout bounds a single lookup.
+	preResolveTimeout = 10 * time.Second
+)
+
+// preResolver proactively resolves toFQDNs matchNames registered in policy so
+// that the corresponding IPs are in the DNS cache before the first connection
+// of an endpoint triggers a proxy-observed lookup. Names are re-resolved
+// periodically, honoring the TTL of the last response.
+type preResolver struct {
+	lock.Mutex
+
+	// names is a refcount of registered matchNames, in FQDN form. A name
+	// may be referenced by multiple selectors.
+	names map[string]int
+
+	// nextLookup is the time the next resolution of each name is due.
+	nextLookup map[string]time.Time
+
+	// trigger wakes up the resolution loop when a new name is registered.
+	trigger chan struct{}
+
+	// lookup resolves a single name. Overridable for testing.
+	lookup func(ctx context.Context, name string) (*DNSIPRecords, error)
+}
+
+func newPreResolver(lookup func(ctx context.Context, name string) (*DNSIPRecords, error)) *preResolver {
+	if lookup == nil {
+		lookup = resolveUpstream
+	}
+	return &preResolver{
+		names:      make(map[string]int),
+		nextLookup: make(map[string]time.Time),
+		trigger:    make(chan struct{}, 1),
+		lookup:     lookup,
+	}
+}
+
+// addName registers a matchName, in FQDN form, for pre-resolution. The first
+// registration schedules an immediate lookup.
+func (pr *preResolver) addName(dnsName string) {
+	pr.Lock()
+	pr.names[dnsName]++
+	if pr.names[dnsName] == 1 {
+		pr.nextLookup[dnsName] = time.Now()
+	}
+	pr.Unlock()
+
+	select {
+	case pr.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// removeName drops a reference on a matchName. The name is no longer
+// re-resolved once the last selector referencing it is unregistered.
+func (pr *preResolver) removeName(dnsName string) {
+	pr.Lock()
+	defer pr.Unlock()
+
+	pr.names[dnsName]--
+	if pr.names[dnsName] <= 0 {
+		delete(pr.names, dnsName)
+		delete(pr.nextLookup, dnsName)
+	}
+}
+
+// dueNames returns the names whose resolution is due at now.
+func (pr *preResolver) dueNames(now time.Time) []string {
+	pr.Lock()
+	defer pr.Unlock()
+
+	var due []string
+	for dnsName, next := range pr.nextLookup {
+		if !next.After(now) {
+			due = append(due, dnsName)
+		}
+	}
+	return due
+}
+
+// scheduleNext sets the time the next resolution of dnsName is due, unless
+// the name has been unregistered in the meantime.
+func (pr *preResolver) scheduleNext(dnsName string, interval time.Duration) {
+	if interval < preResolveMinInterval {
+		interval = preResolveMinInterval
+	}
+
+	pr.Lock()
+	defer pr.Unlock()
+
+	if _, ok := pr.names[dnsName]; ok {
+		pr.nextLookup[dnsName] = time.Now().Add(interval)
+	}
+}
+
+// StartPreResolver starts the loop which proactively resolves registered
+// toFQDNs matchNames. It is a no-op when pre-resolution is not enabled in
+// the NameManager config.
+func (n *NameManager) StartPreResolver(ctx context.Context) {
+	if n.preResolver == nil {
+		return
+	}
+	go n.preResolveLoop(ctx)
+}
+
+func (n *NameManager) preResolveLoop(ctx context.Context) {
+	pr := n.preResolver
+	timer := time.NewTimer(preResolveMinInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-pr.trigger:
+		case <-timer.C:
+		}
+
+		for _, dnsName := range pr.dueNames(time.Now()) {
+			n.preResolveName(ctx, pr, dnsName)
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(preResolveMinInterval)
+	}
+}
+
+// preResolveName resolves dnsName, ingests the result into the DNS cache via
+// UpdateGenerateDNS and schedules the next resolution based on the TTL of the
+// response.
+func (n *NameManager) preResolveName(ctx context.Context, pr *preResolver, dnsName string) {
+	lookupCtx, cancel := context.WithTimeout(ctx, preResolveTimeout)
+	records, err := pr.lookup(lookupCtx, dnsName)
+	cancel()
+	if err != nil {
+		log.WithError(err).WithField("matchName", dnsName).
+			Debug("Failed to pre-resolve toFQDNs matchName")
+		pr.scheduleNext(dnsName, preResolveRetryInterval)
+		return
+	}
+
+	if len(records.IPs) == 0 {
+		pr.scheduleNext(dnsName, preResolveRetryInterval)
+		return
+	}
+
+	wg, _, _, err := n.UpdateGenerateDNS(ctx, time.Now(), map[string]*DNSIPRecords{dnsName: records})
+	if err != nil {
+		log.WithError(err).WithField("matchName", dnsName).
+			Warning("Failed to update DNS cache with pre-resolved IPs")
+	}
+	wg.Wait()
+	pr.scheduleNext(dnsName, time.Duration(records.TTL)*time.Second)
+}
+
+// resolveUpstream resolves the A and AAAA records of name against the
+// resolvers configured in /etc/resolv.conf. The returned TTL is the smallest
+// TTL seen across the answers.
+func resolveUpstream(ctx context.Context, name string) (*DNSIPRecords, error) {
+	conf, err := miekgdns.ClientConfigFromFile("/etc/resolv.conf")
+	if err != nil {
+		return nil, err
+	}
+
+	client := &miekgdns.Client{}
+	records := &DNSIPRecords{}
+	var lastErr error
+	for _, qtype := range []uint16{miekgdns.TypeA, miekgdns.TypeAAAA} {
+		msg := new(miekgdns.Msg).SetQuestion(name, qtype)
+		for _, server := range conf.Servers {
+			response, _, err := client.ExchangeContext(ctx, msg, net.JoinHostPort(server, conf.Port))
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			for _, answer := range response.Answer {
+				var ip net.IP
+				switch rr := answer.(type) {
+				case *miekgdns.A:
+					ip = rr.A
+				case *miekgdns.AAAA:
+					ip = rr.AAAA
+				default:
+					continue
+				}
+				if ttl := int(answer.Header().Ttl); len(records.IPs) == 0 || ttl < records.TTL {
+					records.TTL = ttl
+				}
+				records.IPs = append(records.IPs, ip)
+			}
+			break
+		}
+	}
+
+	if len(records.IPs) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return records, nil
+}
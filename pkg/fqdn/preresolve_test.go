@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package fqdn
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/fqdn/dns"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+// TestPreResolveMatchNames tests that registering a matchName selector
+// schedules the name for pre-resolution, that resolving it populates the DNS
+// cache and emits the selector mapping, and that unregistering the selector
+// stops tracking the name.
+func (ds *FQDNTestSuite) TestPreResolveMatchNames(c *C) {
+	var (
+		selIPMap = make(map[api.FQDNSelector][]net.IP)
+
+		nameManager = NewNameManager(Config{
+			MinTTL: 1,
+			Cache:  NewDNSCache(0),
+
+			UpdateSelectors: func(ctx context.Context, selectorIPMapping map[api.FQDNSelector][]net.IP, selectorsWithoutIPs []api.FQDNSelector) (*sync.WaitGroup, []*identity.Identity, map[netip.Prefix]*identity.Identity, error) {
+				for k, v := range selectorIPMapping {
+					selIPMap[k] = v
+				}
+				return &sync.WaitGroup{}, nil, nil, nil
+			},
+
+			PreResolve: true,
+			PreResolveLookup: func(ctx context.Context, name string) (*DNSIPRecords, error) {
+				return &DNSIPRecords{TTL: 60, IPs: []net.IP{net.ParseIP("1.1.1.1")}}, nil
+			},
+		})
+	)
+
+	ciliumIO := dns.FQDN("cilium.io")
+	pr := nameManager.preResolver
+	c.Assert(pr, Not(IsNil))
+
+	// Registering the matchName selector schedules an immediate lookup.
+	nameManager.Lock()
+	nameManager.RegisterForIdentityUpdatesLocked(ciliumIOSel)
+	// MatchPattern selectors are not pre-resolved.
+	nameManager.RegisterForIdentityUpdatesLocked(ciliumIOSelMatchPattern)
+	nameManager.Unlock()
+	c.Assert(len(pr.names), Equals, 1)
+	due := pr.dueNames(time.Now())
+	c.Assert(due, DeepEquals, []string{ciliumIO})
+
+	// Resolving the name populates the cache and the selector mapping, and
+	// schedules the next lookup after the TTL.
+	nameManager.preResolveName(context.Background(), pr, ciliumIO)
+	c.Assert(len(nameManager.cache.Lookup(ciliumIO)), Equals, 1)
+	ips := selIPMap[ciliumIOSel]
+	c.Assert(len(ips), Equals, 1)
+	c.Assert(ips[0].Equal(net.ParseIP("1.1.1.1")), Equals, true)
+	c.Assert(len(pr.dueNames(time.Now())), Equals, 0)
+
+	// Unregistering the selector stops tracking the name.
+	nameManager.Lock()
+	nameManager.UnregisterForIdentityUpdatesLocked(ciliumIOSel)
+	nameManager.Unlock()
+	c.Assert(len(pr.names), Equals, 0)
+}
@@ -4,6 +4,9 @@
 package proxy
 
 import (
+	"net"
+
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/fqdn/restore"
 	"github.com/cilium/cilium/pkg/policy"
@@ -15,7 +18,11 @@ type DNSProxier interface {
 	UpdateAllowed(endpointID uint64, destPort uint16, newRules policy.L7DataMap) error
 	GetBindPort() uint16
 	SetRejectReply(string)
+	SetIPPlumbedCheck(func(ip net.IP) bool)
 	RestoreRules(op *endpoint.Endpoint)
+	DumpAllowedRules() []*models.DNSProxyRule
+	ListenInNetNS(netNSPath string) error
+	CloseNetNSListeners(netNSPath string)
 	Cleanup()
 }
 
@@ -41,10 +48,26 @@ func (m MockFQDNProxy) SetRejectReply(s string) {
 	return
 }
 
+func (m MockFQDNProxy) SetIPPlumbedCheck(check func(ip net.IP) bool) {
+	return
+}
+
 func (m MockFQDNProxy) RestoreRules(op *endpoint.Endpoint) {
 	return
 }
 
+func (m MockFQDNProxy) DumpAllowedRules() []*models.DNSProxyRule {
+	return nil
+}
+
+func (m MockFQDNProxy) ListenInNetNS(netNSPath string) error {
+	return nil
+}
+
+func (m MockFQDNProxy) CloseNetNSListeners(netNSPath string) {
+	return
+}
+
 func (m MockFQDNProxy) Cleanup() {
 	return
 }
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"regexp"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	lru "github.com/golang/groupcache/lru"
@@ -39,9 +40,13 @@ func CompileRegex(p string) (*regexp.Regexp, error) {
 	r, ok := lru.Get(p)
 	lru.Unlock()
 	if ok {
+		atomic.AddUint64(&cacheHits, 1)
 		return r.(*regexp.Regexp), nil
 	}
+	atomic.AddUint64(&cacheMisses, 1)
+	start := time.Now()
 	n, err := regexp.Compile(p)
+	atomic.AddInt64(&compileTime, int64(time.Since(start)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to compile regex: %w", err)
 	}
@@ -51,6 +56,21 @@ func CompileRegex(p string) (*regexp.Regexp, error) {
 	return n, nil
 }
 
+// Stats returns the number of cache hits and misses CompileRegex has served
+// since the agent started, the cumulative wall time spent compiling regexes
+// on cache misses and the current number of entries in the LRU.
+func Stats() (hits, misses uint64, compile time.Duration, entries int) {
+	hits = atomic.LoadUint64(&cacheHits)
+	misses = atomic.LoadUint64(&cacheMisses)
+	compile = time.Duration(atomic.LoadInt64(&compileTime))
+	if lru := (*RegexCompileLRU)(atomic.LoadPointer(&regexCompileLRU)); lru != nil {
+		lru.Lock()
+		entries = lru.Len()
+		lru.Unlock()
+	}
+	return hits, misses, compile, entries
+}
+
 // InitRegexCompileLRU creates a new instance of the regex compilation LRU.
 func InitRegexCompileLRU(size int) error {
 	if size < 0 {
@@ -71,6 +91,15 @@ func InitRegexCompileLRU(size int) error {
 // throughout Cilium.
 var regexCompileLRU unsafe.Pointer // *RegexCompileLRU
 
+// cacheHits, cacheMisses and compileTime account the work done by
+// CompileRegex. All are accessed atomically and survive re-initialization of
+// the LRU.
+var (
+	cacheHits   uint64
+	cacheMisses uint64
+	compileTime int64 // nanoseconds
+)
+
 // RegexCompileLRU is an LRU cache for storing compiled regex objects of FQDN
 // names or patterns, used in CiliumNetworkPolicy or
 // ClusterwideCiliumNetworkPolicy.
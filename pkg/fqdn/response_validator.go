@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package fqdn
+
+// A ResponseValidator inspects DNS responses before the NameManager admits
+// them into the cache and uses them to generate policy. Validators can
+// implement protections such as rejecting responses that resolve external
+// names to RFC1918 space (DNS rebinding protection) or annotating responses
+// by adjusting the records in place.
+type ResponseValidator interface {
+	// Name returns an identifier for this validator, used in log messages
+	// when a response is rejected.
+	Name() string
+
+	// ValidateResponse is called once per DNS name in a response, before
+	// the response is inserted into the cache. The validator may modify
+	// record in place, e.g. to drop individual IPs or clamp the TTL, or
+	// return a non-nil error to reject the response for this name
+	// entirely. Rejected responses are not inserted into the cache and do
+	// not update policy.
+	ValidateResponse(dnsName string, record *DNSIPRecords) error
+}
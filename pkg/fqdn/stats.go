@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package fqdn
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+const (
+	// dnsStatsTopNames is the number of most queried names reported per
+	// endpoint.
+	dnsStatsTopNames = 5
+
+	// dnsStatsMaxNames bounds the number of distinct names tracked per
+	// endpoint. Once reached, queries for new names are still counted in
+	// the totals but no longer tracked per name.
+	dnsStatsMaxNames = 1024
+)
+
+// DNSProxyStats aggregates per-endpoint counters about DNS queries handled by
+// the DNS proxy. It is updated from the proxy notification path and exposed
+// in the endpoint model, so a pod's DNS health is inspectable alongside its
+// policy state.
+type DNSProxyStats struct {
+	lock.Mutex
+
+	queriesProxied   int64
+	refusedByPolicy  int64
+	upstreamFailures int64
+
+	latencySum   time.Duration
+	latencyCount int64
+
+	// queriesByName counts proxied queries per DNS name.
+	queriesByName map[string]int64
+}
+
+// NewDNSProxyStats returns an initialized DNSProxyStats.
+func NewDNSProxyStats() *DNSProxyStats {
+	return &DNSProxyStats{
+		queriesByName: make(map[string]int64),
+	}
+}
+
+// CountProxied records a query for qname that was allowed by policy and
+// forwarded upstream.
+func (s *DNSProxyStats) CountProxied(qname string) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	s.queriesProxied++
+	if _, tracked := s.queriesByName[qname]; tracked || len(s.queriesByName) < dnsStatsMaxNames {
+		s.queriesByName[qname]++
+	}
+	s.Unlock()
+}
+
+// CountRefused records a query that was refused by policy.
+func (s *DNSProxyStats) CountRefused() {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	s.refusedByPolicy++
+	s.Unlock()
+}
+
+// CountUpstreamFailure records a query that failed upstream, including
+// timeouts.
+func (s *DNSProxyStats) CountUpstreamFailure() {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	s.upstreamFailures++
+	s.Unlock()
+}
+
+// ObserveLatency records the upstream resolution latency of a successfully
+// answered query.
+func (s *DNSProxyStats) ObserveLatency(latency time.Duration) {
+	if s == nil {
+		return
+	}
+	s.Lock()
+	s.latencySum += latency
+	s.latencyCount++
+	s.Unlock()
+}
+
+// GetModel returns the API representation of the statistics, with the query
+// counts reduced to the most queried names.
+func (s *DNSProxyStats) GetModel() *models.EndpointDNSStats {
+	if s == nil {
+		return nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	stats := &models.EndpointDNSStats{
+		QueriesProxied:   s.queriesProxied,
+		RefusedByPolicy:  s.refusedByPolicy,
+		UpstreamFailures: s.upstreamFailures,
+	}
+	if s.latencyCount > 0 {
+		stats.AverageLatencyMs = float64(s.latencySum.Microseconds()) / float64(s.latencyCount) / 1000.0
+	}
+
+	if len(s.queriesByName) > 0 {
+		names := make([]string, 0, len(s.queriesByName))
+		for name := range s.queriesByName {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if s.queriesByName[names[i]] != s.queriesByName[names[j]] {
+				return s.queriesByName[names[i]] > s.queriesByName[names[j]]
+			}
+			return names[i] < names[j]
+		})
+		if len(names) > dnsStatsTopNames {
+			names = names[:dnsStatsTopNames]
+		}
+
+		stats.TopNames = make(map[string]int64, len(names))
+		for _, name := range names {
+			stats.TopNames[name] = s.queriesByName[name]
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package fqdn
+
+import (
+	"fmt"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (ds *DNSCacheTestSuite) TestDNSProxyStats(c *C) {
+	stats := NewDNSProxyStats()
+
+	// A nil receiver is tolerated, as endpoints may not carry statistics.
+	var nilStats *DNSProxyStats
+	nilStats.CountProxied("cilium.io.")
+	nilStats.CountRefused()
+	nilStats.CountUpstreamFailure()
+	nilStats.ObserveLatency(time.Millisecond)
+	c.Assert(nilStats.GetModel(), IsNil)
+
+	for i := 0; i < 3; i++ {
+		stats.CountProxied("cilium.io.")
+	}
+	stats.CountProxied("ebpf.io.")
+	for i := 0; i < 6; i++ {
+		stats.CountProxied(fmt.Sprintf("name%d.example.com.", i))
+	}
+	stats.CountRefused()
+	stats.CountUpstreamFailure()
+	stats.ObserveLatency(2 * time.Millisecond)
+	stats.ObserveLatency(4 * time.Millisecond)
+
+	model := stats.GetModel()
+	c.Assert(model.QueriesProxied, Equals, int64(10))
+	c.Assert(model.RefusedByPolicy, Equals, int64(1))
+	c.Assert(model.UpstreamFailures, Equals, int64(1))
+	c.Assert(model.AverageLatencyMs, Equals, 3.0)
+
+	// Only the most queried names are reported, ties broken by name.
+	c.Assert(model.TopNames, HasLen, dnsStatsTopNames)
+	c.Assert(model.TopNames["cilium.io."], Equals, int64(3))
+	c.Assert(model.TopNames["ebpf.io."], Equals, int64(1))
+	c.Assert(model.TopNames["name0.example.com."], Equals, int64(1))
+}
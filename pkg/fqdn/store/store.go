@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package store
+
+import (
+	"encoding/json"
+	"net"
+	"path"
+	"time"
+
+	"github.com/cilium/cilium/pkg/kvstore"
+	"github.com/cilium/cilium/pkg/kvstore/store"
+)
+
+var (
+	// FQDNCacheStorePrefix is the kvstore prefix of the shared store
+	// holding DNS name to IP mappings observed by the agents.
+	//
+	// WARNING - STABLE API: Changing the structure or values of this will
+	// break backwards compatibility
+	FQDNCacheStorePrefix = path.Join(kvstore.BaseKeyPrefix, "state", "fqdncache", "v1")
+
+	// KeyCreator creates a mapping for a shared store
+	KeyCreator = func() store.Key {
+		return &Mapping{}
+	}
+)
+
+// Mapping is a DNS name to IP mapping observed by a single agent and shared
+// with the cluster. Keys are written with a lease, so mappings of an agent
+// which goes away are removed from the kvstore; on the consuming side the
+// mapping expires from the local caches via its TTL.
+type Mapping struct {
+	// Node is the name of the node which observed the lookup
+	Node string `json:"node"`
+
+	// Name is the DNS name which was looked up
+	Name string `json:"name"`
+
+	// IPs are the IPs the name resolved to
+	IPs []net.IP `json:"ips"`
+
+	// TTL is the lowest TTL of the DNS response, in seconds
+	TTL int `json:"ttl"`
+
+	// LookupTime is the time the DNS response was received
+	LookupTime time.Time `json:"lookup-time"`
+}
+
+// GetKeyName returns the kvstore key of the mapping. Mappings are keyed per
+// observing node so that agents do not overwrite each other's lookups.
+func (m *Mapping) GetKeyName() string { return path.Join(m.Node, m.Name) }
+
+// Marshal returns the mapping object as JSON byte slice
+func (m *Mapping) Marshal() ([]byte, error) { return json.Marshal(m) }
+
+// Unmarshal parses the JSON byte slice and updates the mapping receiver
+func (m *Mapping) Unmarshal(data []byte) error { return json.Unmarshal(data, m) }
+
+// DeepKeyCopy returns a deep copy of the mapping
+func (m *Mapping) DeepKeyCopy() store.LocalKey {
+	out := &Mapping{
+		Node:       m.Node,
+		Name:       m.Name,
+		IPs:        make([]net.IP, len(m.IPs)),
+		TTL:        m.TTL,
+		LookupTime: m.LookupTime,
+	}
+	copy(out.IPs, m.IPs)
+	return out
+}
+
+// MappingHandler is the interface the consumer of shared mappings has to
+// implement
+type MappingHandler interface {
+	// MappingUpdated is called when the store detects a new or updated
+	// mapping observed by another node
+	MappingUpdated(m *Mapping)
+}
+
+// MappingObserver implements the store.Observer interface and delegates
+// mappings observed by other nodes to the handler. Mappings observed by the
+// local node and deletions are ignored; expiry is driven by the TTL of the
+// local caches.
+type MappingObserver struct {
+	localNode string
+	handler   MappingHandler
+}
+
+// NewMappingObserver returns a new MappingObserver which ignores mappings of
+// the given local node
+func NewMappingObserver(localNode string, handler MappingHandler) *MappingObserver {
+	return &MappingObserver{
+		localNode: localNode,
+		handler:   handler,
+	}
+}
+
+func (o *MappingObserver) OnUpdate(k store.Key) {
+	if m, ok := k.(*Mapping); ok && m.Node != o.localNode {
+		o.handler.MappingUpdated(m.DeepKeyCopy().(*Mapping))
+	}
+}
+
+func (o *MappingObserver) OnDelete(k store.NamedKey) {}
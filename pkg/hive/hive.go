@@ -198,6 +198,12 @@ func (h *Hive) waitForSignalOrShutdown() error {
 	}
 }
 
+// Populate instantiates the hive. Use for validating the hive's
+// configuration and dependency graph without starting it.
+func (h *Hive) Populate() error {
+	return h.populate()
+}
+
 func (h *Hive) populate() error {
 	if h.populated {
 		return nil
@@ -31,13 +31,17 @@ func TestDecodeL7DNSRecord(t *testing.T) {
 		ServiceInfo:         nil,
 		DropReason:          nil,
 		DNS: &accesslog.LogRecordDNS{
-			Query:             "deathstar.empire.svc.cluster.local.",
-			IPs:               []net.IP{net.ParseIP("1.2.3.4")},
-			TTL:               5,
-			ObservationSource: accesslog.DNSSourceProxy,
-			RCode:             0,
-			QTypes:            []uint16{1},
-			AnswerTypes:       []uint16{1},
+			Query:                       "deathstar.empire.svc.cluster.local.",
+			IPs:                         []net.IP{net.ParseIP("1.2.3.4")},
+			TTL:                         5,
+			ObservationSource:           accesslog.DNSSourceProxy,
+			RCode:                       0,
+			QTypes:                      []uint16{1},
+			AnswerTypes:                 []uint16{1},
+			UpstreamLatencyNs:           1500000,
+			PolicyCheckLatencyNs:        200000,
+			IdentityAllocationLatencyNs: 300000,
+			IPCachePlumbingLatencyNs:    500000,
 		},
 	}
 	lr.SourceEndpoint.Port = 53
@@ -87,4 +91,7 @@ func TestDecodeL7DNSRecord(t *testing.T) {
 		Qtypes:            []string{"A"},
 		Rrtypes:           []string{"A"},
 	}, f.GetL7().GetDns())
+
+	// Responses report the latency of the individual proxy stages.
+	assert.Equal(t, uint64(2500000), f.GetL7().GetLatencyNs())
 }
@@ -133,6 +133,15 @@ func (p *Parser) Decode(r *accesslog.LogRecord, decoded *flowpb.Flow) error {
 	decoded.DestinationNames = destinationNames
 	decoded.L7 = decodeLayer7(r)
 	decoded.L7.LatencyNs = p.computeResponseTime(r, timestamp)
+	if decoded.L7.LatencyNs == 0 && r.Type == accesslog.TypeResponse && r.DNS != nil {
+		// DNS records carry no request ID to correlate on, but the DNS
+		// proxy reports the time it spent on each stage of the request
+		// directly.
+		decoded.L7.LatencyNs = uint64(r.DNS.UpstreamLatencyNs +
+			r.DNS.PolicyCheckLatencyNs +
+			r.DNS.IdentityAllocationLatencyNs +
+			r.DNS.IPCachePlumbingLatencyNs)
+	}
 	decoded.IsReply = decodeIsReply(r.Type)
 	decoded.Reply = decoded.GetIsReply().GetValue()
 	decoded.EventType = decodeCiliumEventType(api.MessageTypeAccessLog)
@@ -14,6 +14,7 @@ import (
 
 	flowpb "github.com/cilium/cilium/api/v1/flow"
 	"github.com/cilium/cilium/pkg/byteorder"
+	cgroupManager "github.com/cilium/cilium/pkg/cgroups/manager"
 	"github.com/cilium/cilium/pkg/hubble/parser/common"
 	"github.com/cilium/cilium/pkg/hubble/parser/errors"
 	"github.com/cilium/cilium/pkg/hubble/parser/getters"
@@ -34,7 +35,8 @@ type Parser struct {
 	cgroupGetter   getters.PodMetadataGetter
 	epResolver     *common.EndpointResolver
 
-	skipUnknownCGroupIDs bool
+	skipUnknownCGroupIDs      bool
+	hostNetworkPodAttribution bool
 }
 
 // New creates a new parser
@@ -47,15 +49,16 @@ func New(log logrus.FieldLogger,
 	cgroupGetter getters.PodMetadataGetter,
 ) (*Parser, error) {
 	return &Parser{
-		log:                  log,
-		endpointGetter:       endpointGetter,
-		identityGetter:       identityGetter,
-		dnsGetter:            dnsGetter,
-		ipGetter:             ipGetter,
-		serviceGetter:        serviceGetter,
-		cgroupGetter:         cgroupGetter,
-		epResolver:           common.NewEndpointResolver(log, endpointGetter, identityGetter, ipGetter),
-		skipUnknownCGroupIDs: option.Config.HubbleSkipUnknownCGroupIDs,
+		log:                       log,
+		endpointGetter:            endpointGetter,
+		identityGetter:            identityGetter,
+		dnsGetter:                 dnsGetter,
+		ipGetter:                  ipGetter,
+		serviceGetter:             serviceGetter,
+		cgroupGetter:              cgroupGetter,
+		epResolver:                common.NewEndpointResolver(log, endpointGetter, identityGetter, ipGetter),
+		skipUnknownCGroupIDs:      option.Config.HubbleSkipUnknownCGroupIDs,
+		hostNetworkPodAttribution: option.Config.HubbleHostNetworkPodAttribution,
 	}, nil
 }
 
@@ -77,7 +80,8 @@ func (p *Parser) Decode(data []byte, decoded *flowpb.Flow) error {
 	}
 
 	ipVersion := decodeIPVersion(sock.Flags)
-	epIP, ok := p.decodeEndpointIP(sock.CgroupId, ipVersion)
+	podMeta := p.podMetadata(sock.CgroupId)
+	epIP, ok := p.decodeEndpointIP(podMeta, sock.CgroupId, ipVersion)
 	if !ok && p.skipUnknownCGroupIDs {
 		// Skip events for which we cannot determine the endpoint ip based on
 		// the numeric cgroup id, since those events do not provide much value
@@ -93,6 +97,14 @@ func (p *Parser) Decode(data []byte, decoded *flowpb.Flow) error {
 	srcEndpoint := p.epResolver.ResolveEndpoint(srcIP, 0)
 	dstEndpoint := p.epResolver.ResolveEndpoint(dstIP, 0)
 
+	// Host-network pods share the node IP and resolve to the host or
+	// remote-node identity, making them indistinguishable from each other.
+	// Attribute the event to the pod owning the cgroup when enabled.
+	if p.hostNetworkPodAttribution && podMeta != nil && srcEndpoint.PodName == "" {
+		srcEndpoint.PodName = podMeta.Name
+		srcEndpoint.Namespace = podMeta.Namespace
+	}
+
 	// On the reverse path, source and destination IP of the packet are reversed
 	isRevNat := decodeRevNat(sock.XlatePoint)
 	if isRevNat {
@@ -126,30 +138,37 @@ func decodeIPVersion(flags uint8) flowpb.IPVersion {
 	return flowpb.IPVersion_IPv4
 }
 
-func (p *Parser) decodeEndpointIP(cgroupId uint64, ipVersion flowpb.IPVersion) (ip net.IP, ok bool) {
-	if p.cgroupGetter != nil {
-		if m := p.cgroupGetter.GetPodMetadataForContainer(cgroupId); m != nil {
-			scopedLog := p.log.WithFields(logrus.Fields{
-				logfields.CGroupID:     cgroupId,
-				logfields.K8sPodName:   m.Name,
-				logfields.K8sNamespace: m.Namespace,
-			})
-
-			for _, podIP := range m.IPs {
-				isIPv6 := strings.Contains(podIP, ":")
-				if isIPv6 && ipVersion == flowpb.IPVersion_IPv6 ||
-					!isIPv6 && ipVersion == flowpb.IPVersion_IPv4 {
-					ip = net.ParseIP(podIP)
-					if ip == nil {
-						scopedLog.WithField(logfields.IPAddr, podIP).Debug("failed to parse pod IP")
-						return nil, false
-					}
-
-					return ip, true
+// podMetadata returns the metadata of the pod owning the given cgroup, or nil
+// if the cgroup is unknown.
+func (p *Parser) podMetadata(cgroupId uint64) *cgroupManager.PodMetadata {
+	if p.cgroupGetter == nil {
+		return nil
+	}
+	return p.cgroupGetter.GetPodMetadataForContainer(cgroupId)
+}
+
+func (p *Parser) decodeEndpointIP(m *cgroupManager.PodMetadata, cgroupId uint64, ipVersion flowpb.IPVersion) (ip net.IP, ok bool) {
+	if m != nil {
+		scopedLog := p.log.WithFields(logrus.Fields{
+			logfields.CGroupID:     cgroupId,
+			logfields.K8sPodName:   m.Name,
+			logfields.K8sNamespace: m.Namespace,
+		})
+
+		for _, podIP := range m.IPs {
+			isIPv6 := strings.Contains(podIP, ":")
+			if isIPv6 && ipVersion == flowpb.IPVersion_IPv6 ||
+				!isIPv6 && ipVersion == flowpb.IPVersion_IPv4 {
+				ip = net.ParseIP(podIP)
+				if ip == nil {
+					scopedLog.WithField(logfields.IPAddr, podIP).Debug("failed to parse pod IP")
+					return nil, false
 				}
+
+				return ip, true
 			}
-			scopedLog.Debug("no matching IP for pod")
 		}
+		scopedLog.Debug("no matching IP for pod")
 	}
 
 	return nil, false
@@ -68,6 +68,10 @@ func TestDecodeSockEvent(t *testing.T) {
 		deathstarAltIPv6          = "f00d::20:21"
 		deathstarAltPodName       = "deathstar-2"
 		deathstarAltPodNamespace  = "default"
+		hostIPv4                  = "10.0.0.42"
+		hostPodCgroupId           = 404040
+		hostPodName               = "node-exporter"
+		hostPodNamespace          = "monitoring"
 	)
 	var (
 		xwingLabels     = []string{"k8s:org=alliance"}
@@ -188,6 +192,12 @@ func TestDecodeSockEvent(t *testing.T) {
 					Namespace: xwingPodNamespace,
 					IPs:       []string{xwingIPv4, xwingIPv6},
 				}
+			case hostPodCgroupId:
+				return &cgroupManager.PodMetadata{
+					Name:      hostPodName,
+					Namespace: hostPodNamespace,
+					IPs:       []string{hostIPv4},
+				}
 			}
 			return nil
 		},
@@ -196,7 +206,8 @@ func TestDecodeSockEvent(t *testing.T) {
 		name string
 		msg  monitor.TraceSockNotify
 
-		skipUnknownCGroupIDs bool
+		skipUnknownCGroupIDs      bool
+		hostNetworkPodAttribution bool
 
 		rawMsg []byte
 		flow   *flowpb.Flow
@@ -396,6 +407,43 @@ func TestDecodeSockEvent(t *testing.T) {
 				Summary:        "TCP",
 			},
 		},
+		{
+			name: "host-network pod attribution",
+			msg: monitor.TraceSockNotify{
+				Type:       monitorAPI.MessageTypeTraceSock,
+				XlatePoint: monitor.XlatePointPreDirectionFwd,
+				DstIP:      mustParseIP("10.10.10.10"),
+				DstPort:    8080,
+				CgroupId:   hostPodCgroupId,
+				L4Proto:    monitor.L4ProtocolTCP,
+			},
+			skipUnknownCGroupIDs:      true,
+			hostNetworkPodAttribution: true,
+			flow: &flowpb.Flow{
+				Type:     flowpb.FlowType_SOCK,
+				Verdict:  flowpb.Verdict_TRACED,
+				CgroupId: hostPodCgroupId,
+				IP: &flowpb.IP{
+					Source:      hostIPv4,
+					Destination: "10.10.10.10",
+					IpVersion:   flowpb.IPVersion_IPv4,
+				},
+				L4: &flowpb.Layer4{Protocol: &flowpb.Layer4_TCP{TCP: &flowpb.TCP{
+					DestinationPort: 8080,
+				}}},
+				Source: &flowpb.Endpoint{
+					PodName:   hostPodName,
+					Namespace: hostPodNamespace,
+				},
+				Destination: &flowpb.Endpoint{},
+				EventType: &flowpb.CiliumEventType{
+					Type:    monitorAPI.MessageTypeTraceSock,
+					SubType: monitor.XlatePointPreDirectionFwd,
+				},
+				SockXlatePoint: monitor.XlatePointPreDirectionFwd,
+				Summary:        "TCP",
+			},
+		},
 	}
 
 	p, err := New(logrus.New(), endpointGetter, identityGetter, dnsGetter, ipGetter, serviceGetter, cgroupGetter)
@@ -404,6 +452,7 @@ func TestDecodeSockEvent(t *testing.T) {
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			p.skipUnknownCGroupIDs = tc.skipUnknownCGroupIDs
+			p.hostNetworkPodAttribution = tc.hostNetworkPodAttribution
 			data := tc.rawMsg
 			if data == nil {
 				buf := &bytes.Buffer{}
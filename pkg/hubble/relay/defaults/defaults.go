@@ -37,6 +37,9 @@ const (
 	// ErrorAggregationWindow is the time window during which errors with the
 	// same message are coalesced.
 	ErrorAggregationWindow = 10 * time.Second
+	// ServiceMapWindow is the time window over which flows are aggregated
+	// into the service dependency graph when no window is requested.
+	ServiceMapWindow = 5 * time.Minute
 )
 
 var (
@@ -5,11 +5,13 @@ package server
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -28,6 +30,7 @@ import (
 	"github.com/cilium/cilium/pkg/hubble/relay/defaults"
 	"github.com/cilium/cilium/pkg/hubble/relay/observer"
 	"github.com/cilium/cilium/pkg/hubble/relay/pool"
+	"github.com/cilium/cilium/pkg/hubble/relay/servicemap"
 )
 
 var (
@@ -135,6 +138,7 @@ func New(options ...Option) (*Server, error) {
 	if opts.metricsListenAddress != "" {
 		mux := http.NewServeMux()
 		mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+		mux.Handle("/servicemap", serviceMapHandler(opts.log, observerSrv))
 		metricsServer = &http.Server{
 			Addr:    opts.metricsListenAddress,
 			Handler: mux,
@@ -187,6 +191,43 @@ func (s *Server) Stop() {
 	s.opts.log.Info("Server stopped")
 }
 
+// serviceMapHandler serves the service dependency graph of a namespace as
+// JSON, aggregated from the flows observed over the requested time window.
+// The namespace is selected with the mandatory "namespace" query parameter;
+// the window defaults to the last defaults.ServiceMapWindow and can be
+// changed with the "since" query parameter, e.g. "?since=15m".
+func serviceMapHandler(log logrus.FieldLogger, svc observerpb.ObserverServer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespace := r.URL.Query().Get("namespace")
+		if namespace == "" {
+			http.Error(w, "missing namespace query parameter", http.StatusBadRequest)
+			return
+		}
+		window := defaults.ServiceMapWindow
+		if since := r.URL.Query().Get("since"); since != "" {
+			d, err := time.ParseDuration(since)
+			if err != nil || d <= 0 {
+				http.Error(w, fmt.Sprintf("invalid since query parameter %q", since), http.StatusBadRequest)
+				return
+			}
+			window = d
+		}
+
+		until := time.Now()
+		graph, err := servicemap.Compute(r.Context(), svc, namespace, until.Add(-window), until)
+		if err != nil {
+			log.WithError(err).Error("Failed to compute service map")
+			http.Error(w, "failed to compute service map", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(graph); err != nil {
+			log.WithError(err).Error("Failed to encode service map")
+		}
+	})
+}
+
 // observerOptions returns the configured hubble-relay observer options along
 // with the hubble-relay logger.
 func copyObserverOptionsWithLogger(log logrus.FieldLogger, options []observer.Option) []observer.Option {
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Hubble
+
+// Package servicemap computes a service dependency graph for a namespace
+// from observed flows. The graph annotates each service-to-service edge
+// with the policy verdicts seen over the aggregation window so that
+// consumers such as the UI no longer have to reconstruct the topology
+// client-side from raw flows. The graph is served by the hubble-relay
+// HTTP server on the /servicemap endpoint.
+package servicemap
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	observerpb "github.com/cilium/cilium/api/v1/observer"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+)
+
+// ServiceID uniquely identifies a service in the dependency graph.
+type ServiceID struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// Edge represents observed traffic from a source service to a destination
+// service on a given port and protocol, aggregated over the window.
+type Edge struct {
+	Source          ServiceID `json:"source"`
+	Destination     ServiceID `json:"destination"`
+	DestinationPort uint32    `json:"destination_port"`
+	Protocol        string    `json:"protocol"`
+
+	// ForwardedCount and DroppedCount are the number of flows on this
+	// edge with the respective verdict.
+	ForwardedCount uint64 `json:"forwarded_count"`
+	DroppedCount   uint64 `json:"dropped_count"`
+
+	// Verdict is the verdict of the most recent flow seen on this edge.
+	Verdict flowpb.Verdict `json:"verdict"`
+
+	// PolicyMatchType describes how policy matched the most recent
+	// policy verdict event on this edge, e.g. "L3-L4" or "all". Flows do
+	// not carry the names of the matched rules, so the match type is the
+	// closest available annotation.
+	PolicyMatchType string `json:"policy_match_type,omitempty"`
+
+	// LastSeen is the timestamp of the most recent flow on this edge.
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// Graph is a service dependency graph. Services holds every service that
+// appears as a source or destination of an edge.
+type Graph struct {
+	Services []ServiceID `json:"services"`
+	Edges    []*Edge     `json:"edges"`
+}
+
+type edgeKey struct {
+	source      ServiceID
+	destination ServiceID
+	port        uint32
+	protocol    string
+}
+
+// Builder aggregates flows into a service dependency graph. It is not
+// safe for concurrent use.
+type Builder struct {
+	edges map[edgeKey]*Edge
+}
+
+// NewBuilder creates an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{
+		edges: make(map[edgeKey]*Edge),
+	}
+}
+
+// AddFlow aggregates flow f into the graph. Flows for which either the
+// source or the destination service is unknown are ignored and false is
+// returned.
+func (b *Builder) AddFlow(f *flowpb.Flow) bool {
+	src, dst := f.GetSourceService(), f.GetDestinationService()
+	if src.GetName() == "" || dst.GetName() == "" {
+		return false
+	}
+
+	key := edgeKey{
+		source:      ServiceID{Name: src.GetName(), Namespace: src.GetNamespace()},
+		destination: ServiceID{Name: dst.GetName(), Namespace: dst.GetNamespace()},
+	}
+	switch l4 := f.GetL4().GetProtocol().(type) {
+	case *flowpb.Layer4_TCP:
+		key.port, key.protocol = l4.TCP.GetDestinationPort(), "TCP"
+	case *flowpb.Layer4_UDP:
+		key.port, key.protocol = l4.UDP.GetDestinationPort(), "UDP"
+	case *flowpb.Layer4_ICMPv4:
+		key.protocol = "ICMPv4"
+	case *flowpb.Layer4_ICMPv6:
+		key.protocol = "ICMPv6"
+	case *flowpb.Layer4_SCTP:
+		key.port, key.protocol = l4.SCTP.GetDestinationPort(), "SCTP"
+	}
+
+	e, ok := b.edges[key]
+	if !ok {
+		e = &Edge{
+			Source:          key.source,
+			Destination:     key.destination,
+			DestinationPort: key.port,
+			Protocol:        key.protocol,
+		}
+		b.edges[key] = e
+	}
+
+	switch f.GetVerdict() {
+	case flowpb.Verdict_FORWARDED, flowpb.Verdict_REDIRECTED:
+		e.ForwardedCount++
+	case flowpb.Verdict_DROPPED:
+		e.DroppedCount++
+	}
+
+	ts := f.GetTime().AsTime()
+	if ts.After(e.LastSeen) {
+		e.LastSeen = ts
+		e.Verdict = f.GetVerdict()
+	}
+	if t := f.GetPolicyMatchType(); t != 0 {
+		e.PolicyMatchType = monitorAPI.PolicyMatchType(t).String()
+	}
+	return true
+}
+
+// Graph returns the aggregated graph. Services and edges are sorted to
+// make the output deterministic.
+func (b *Builder) Graph() *Graph {
+	g := &Graph{
+		Edges: make([]*Edge, 0, len(b.edges)),
+	}
+	services := make(map[ServiceID]struct{})
+	for _, e := range b.edges {
+		g.Edges = append(g.Edges, e)
+		services[e.Source] = struct{}{}
+		services[e.Destination] = struct{}{}
+	}
+	for id := range services {
+		g.Services = append(g.Services, id)
+	}
+	sort.Slice(g.Services, func(i, j int) bool {
+		a, b := g.Services[i], g.Services[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.Source != b.Source {
+			if a.Source.Namespace != b.Source.Namespace {
+				return a.Source.Namespace < b.Source.Namespace
+			}
+			return a.Source.Name < b.Source.Name
+		}
+		if a.Destination != b.Destination {
+			if a.Destination.Namespace != b.Destination.Namespace {
+				return a.Destination.Namespace < b.Destination.Namespace
+			}
+			return a.Destination.Name < b.Destination.Name
+		}
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+		return a.DestinationPort < b.DestinationPort
+	})
+	return g
+}
+
+// flowStream implements observerpb.Observer_GetFlowsServer for in-process
+// GetFlows calls, feeding every received flow into a builder. Only Send and
+// Context are exercised by the observer service.
+type flowStream struct {
+	ctx context.Context
+	b   *Builder
+}
+
+// Send implements observerpb.Observer_GetFlowsServer.Send.
+func (s *flowStream) Send(resp *observerpb.GetFlowsResponse) error {
+	if f := resp.GetFlow(); f != nil {
+		s.b.AddFlow(f)
+	}
+	return nil
+}
+
+// Context implements grpc.ServerStream.Context.
+func (s *flowStream) Context() context.Context { return s.ctx }
+
+// SetHeader implements grpc.ServerStream.SetHeader.
+func (s *flowStream) SetHeader(metadata.MD) error { return nil }
+
+// SendHeader implements grpc.ServerStream.SendHeader.
+func (s *flowStream) SendHeader(metadata.MD) error { return nil }
+
+// SetTrailer implements grpc.ServerStream.SetTrailer.
+func (s *flowStream) SetTrailer(metadata.MD) {}
+
+// SendMsg implements grpc.ServerStream.SendMsg.
+func (s *flowStream) SendMsg(interface{}) error { return nil }
+
+// RecvMsg implements grpc.ServerStream.RecvMsg.
+func (s *flowStream) RecvMsg(interface{}) error { return nil }
+
+// Compute builds the service dependency graph of the given namespace by
+// aggregating the flows observed between since and until. The flows are
+// requested from the given observer service with an in-process call, i.e.
+// without going through the gRPC stack.
+func Compute(ctx context.Context, svc observerpb.ObserverServer, namespace string, since, until time.Time) (*Graph, error) {
+	req := &observerpb.GetFlowsRequest{
+		Since: timestamppb.New(since),
+		Until: timestamppb.New(until),
+		Whitelist: []*flowpb.FlowFilter{
+			{SourcePod: []string{namespace + "/"}},
+			{DestinationPod: []string{namespace + "/"}},
+		},
+	}
+	stream := &flowStream{ctx: ctx, b: NewBuilder()}
+	if err := svc.GetFlows(req, stream); err != nil {
+		return nil, err
+	}
+	return stream.b.Graph(), nil
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Hubble
+
+package servicemap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	observerpb "github.com/cilium/cilium/api/v1/observer"
+)
+
+func flow(srcNS, src, dstNS, dst string, port uint32, verdict flowpb.Verdict, seconds int64) *flowpb.Flow {
+	return &flowpb.Flow{
+		Time:               &timestamppb.Timestamp{Seconds: seconds},
+		Verdict:            verdict,
+		SourceService:      &flowpb.Service{Name: src, Namespace: srcNS},
+		DestinationService: &flowpb.Service{Name: dst, Namespace: dstNS},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_TCP{
+				TCP: &flowpb.TCP{DestinationPort: port},
+			},
+		},
+	}
+}
+
+func TestBuilderAggregatesEdges(t *testing.T) {
+	b := NewBuilder()
+	assert.True(t, b.AddFlow(flow("default", "xwing", "default", "deathstar", 80, flowpb.Verdict_FORWARDED, 1)))
+	assert.True(t, b.AddFlow(flow("default", "xwing", "default", "deathstar", 80, flowpb.Verdict_FORWARDED, 2)))
+	assert.True(t, b.AddFlow(flow("default", "xwing", "default", "deathstar", 80, flowpb.Verdict_DROPPED, 3)))
+	assert.True(t, b.AddFlow(flow("default", "tiefighter", "default", "deathstar", 80, flowpb.Verdict_FORWARDED, 4)))
+
+	g := b.Graph()
+	assert.Len(t, g.Services, 3)
+	assert.Len(t, g.Edges, 2)
+
+	// Edges are sorted by source; tiefighter comes before xwing.
+	e := g.Edges[1]
+	assert.Equal(t, ServiceID{Name: "xwing", Namespace: "default"}, e.Source)
+	assert.Equal(t, ServiceID{Name: "deathstar", Namespace: "default"}, e.Destination)
+	assert.Equal(t, uint32(80), e.DestinationPort)
+	assert.Equal(t, "TCP", e.Protocol)
+	assert.Equal(t, uint64(2), e.ForwardedCount)
+	assert.Equal(t, uint64(1), e.DroppedCount)
+	// The verdict reflects the most recent flow.
+	assert.Equal(t, flowpb.Verdict_DROPPED, e.Verdict)
+	assert.Equal(t, int64(3), e.LastSeen.Unix())
+}
+
+func TestBuilderSeparatesPorts(t *testing.T) {
+	b := NewBuilder()
+	assert.True(t, b.AddFlow(flow("default", "xwing", "default", "deathstar", 80, flowpb.Verdict_FORWARDED, 1)))
+	assert.True(t, b.AddFlow(flow("default", "xwing", "default", "deathstar", 443, flowpb.Verdict_FORWARDED, 2)))
+
+	g := b.Graph()
+	assert.Len(t, g.Edges, 2)
+	assert.Equal(t, uint32(80), g.Edges[0].DestinationPort)
+	assert.Equal(t, uint32(443), g.Edges[1].DestinationPort)
+}
+
+func TestBuilderIgnoresFlowsWithoutServices(t *testing.T) {
+	b := NewBuilder()
+	f := flow("default", "xwing", "default", "deathstar", 80, flowpb.Verdict_FORWARDED, 1)
+	f.DestinationService = nil
+	assert.False(t, b.AddFlow(f))
+	assert.False(t, b.AddFlow(&flowpb.Flow{}))
+	assert.Empty(t, b.Graph().Edges)
+}
+
+// fakeObserver implements observerpb.ObserverServer by streaming a fixed
+// set of flows from GetFlows.
+type fakeObserver struct {
+	observerpb.UnimplementedObserverServer
+
+	flows []*flowpb.Flow
+}
+
+func (o *fakeObserver) GetFlows(req *observerpb.GetFlowsRequest, stream observerpb.Observer_GetFlowsServer) error {
+	for _, f := range o.flows {
+		if err := stream.Send(&observerpb.GetFlowsResponse{
+			ResponseTypes: &observerpb.GetFlowsResponse_Flow{Flow: f},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestCompute(t *testing.T) {
+	obs := &fakeObserver{
+		flows: []*flowpb.Flow{
+			flow("default", "xwing", "default", "deathstar", 80, flowpb.Verdict_FORWARDED, 1),
+			flow("default", "xwing", "default", "deathstar", 80, flowpb.Verdict_DROPPED, 2),
+		},
+	}
+
+	until := time.Now()
+	g, err := Compute(context.Background(), obs, "default", until.Add(-time.Minute), until)
+	assert.NoError(t, err)
+	assert.Len(t, g.Edges, 1)
+	assert.Equal(t, uint64(1), g.Edges[0].ForwardedCount)
+	assert.Equal(t, uint64(1), g.Edges[0].DroppedCount)
+}
+
+func TestBuilderPolicyMatchType(t *testing.T) {
+	b := NewBuilder()
+	f := flow("default", "xwing", "default", "deathstar", 80, flowpb.Verdict_FORWARDED, 1)
+	f.PolicyMatchType = 2 // L3+L4
+	assert.True(t, b.AddFlow(f))
+
+	g := b.Graph()
+	assert.Equal(t, "L3-L4", g.Edges[0].PolicyMatchType)
+}
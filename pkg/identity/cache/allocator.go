@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/cilium/cilium/pkg/allocator"
+	"github.com/cilium/cilium/pkg/allocator/doublewrite"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/idpool"
 	clientset "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
@@ -222,6 +223,27 @@ func (m *CachingIdentityAllocator) InitIdentityAllocator(client clientset.Interf
 				log.WithError(err).Fatal("Unable to initialize Kubernetes CRD backend for identity allocation")
 			}
 
+		case option.IdentityAllocationModeDoubleWriteReadKVstore, option.IdentityAllocationModeDoubleWriteReadCRD:
+			log.Debug("Identity allocation double-written to KVStore and CRD")
+			kvstoreBackend, err := kvstoreallocator.NewKVStoreBackend(m.identitiesPath, owner.GetNodeSuffix(), GlobalIdentity{}, kvstore.Client())
+			if err != nil {
+				log.WithError(err).Fatal("Unable to initialize kvstore backend for identity allocation")
+			}
+			crdBackend, err := identitybackend.NewCRDBackend(identitybackend.CRDBackendConfiguration{
+				NodeName: owner.GetNodeSuffix(),
+				Store:    identityStore,
+				Client:   client,
+				KeyType:  GlobalIdentity{},
+			})
+			if err != nil {
+				log.WithError(err).Fatal("Unable to initialize Kubernetes CRD backend for identity allocation")
+			}
+			if option.Config.IdentityAllocationMode == option.IdentityAllocationModeDoubleWriteReadKVstore {
+				backend = doublewrite.NewDoubleWriteBackend(kvstoreBackend, crdBackend)
+			} else {
+				backend = doublewrite.NewDoubleWriteBackend(crdBackend, kvstoreBackend)
+			}
+
 		default:
 			log.Fatalf("Unsupported identity allocation mode %s", option.Config.IdentityAllocationMode)
 		}
@@ -297,6 +319,14 @@ func (m *CachingIdentityAllocator) Close() {
 	m.globalIdentityAllocatorInitialized = make(chan struct{})
 }
 
+// LocalIdentityReferences returns the current reference count of each
+// allocated local (CIDR) identity. It is used by consistency checkers to
+// compare the allocator's view against the references held by the consumers
+// of local identities.
+func (m *CachingIdentityAllocator) LocalIdentityReferences() map[identity.NumericIdentity]int {
+	return m.localIdentities.getReferenceCounts()
+}
+
 // WaitForInitialGlobalIdentities waits for the initial set of global security
 // identities to have been received and populated into the allocator cache.
 func (m *CachingIdentityAllocator) WaitForInitialGlobalIdentities(ctx context.Context) error {
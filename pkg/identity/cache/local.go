@@ -177,6 +177,20 @@ func (l *localIdentityCache) lookupByID(id identity.NumericIdentity) *identity.I
 	return nil
 }
 
+// getReferenceCounts returns the current reference count of each local
+// identity.
+func (l *localIdentityCache) getReferenceCounts() map[identity.NumericIdentity]int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	counts := make(map[identity.NumericIdentity]int, len(l.identitiesByID))
+	for key, id := range l.identitiesByID {
+		counts[key] = id.ReferenceCount
+	}
+
+	return counts
+}
+
 // GetIdentities returns all local identities
 func (l *localIdentityCache) GetIdentities() map[identity.NumericIdentity]*identity.Identity {
 	cache := map[identity.NumericIdentity]*identity.Identity{}
@@ -62,6 +62,12 @@ type IPIdentityPair struct {
 	K8sNamespace string          `json:"K8sNamespace,omitempty"`
 	K8sPodName   string          `json:"K8sPodName,omitempty"`
 	NamedPorts   []NamedPort     `json:"NamedPorts,omitempty"`
+
+	// PublishedAt is the time at which the mapping was published by the
+	// owning agent, in nanoseconds since the Unix epoch. It allows remote
+	// agents to measure propagation delay. Zero when published by an agent
+	// which does not support this field.
+	PublishedAt int64 `json:"PublishedAt,omitempty"`
 }
 
 // NamedPort is a mapping from a port name to a port number and protocol.
@@ -105,6 +105,11 @@ const (
 	// ReservedIdentityIngress is the identity given to the IP used as the source
 	// address for connections from Ingress proxies.
 	ReservedIdentityIngress
+
+	// ReservedIdentityDNSProxy is the identity given to connections from the
+	// DNS proxy to upstream DNS servers, when the agent is configured to not
+	// use the identity of the endpoint on whose behalf the query is made.
+	ReservedIdentityDNSProxy
 )
 
 // Special identities for well-known cluster components
@@ -379,6 +384,7 @@ var (
 		labels.IDNameRemoteNode:    ReservedIdentityRemoteNode,
 		labels.IDNameKubeAPIServer: ReservedIdentityKubeAPIServer,
 		labels.IDNameIngress:       ReservedIdentityIngress,
+		labels.IDNameDNSProxy:      ReservedIdentityDNSProxy,
 	}
 	reservedIdentityNames = map[NumericIdentity]string{
 		IdentityUnknown:               "unknown",
@@ -390,6 +396,7 @@ var (
 		ReservedIdentityRemoteNode:    labels.IDNameRemoteNode,
 		ReservedIdentityKubeAPIServer: labels.IDNameKubeAPIServer,
 		ReservedIdentityIngress:       labels.IDNameIngress,
+		ReservedIdentityDNSProxy:      labels.IDNameDNSProxy,
 	}
 	reservedIdentityLabels = map[NumericIdentity]labels.Labels{
 		ReservedIdentityHost:       labels.LabelHost,
@@ -402,7 +409,8 @@ var (
 			labels.LabelKubeAPIServer.String(): "",
 			labels.LabelRemoteNode.String():    "",
 		}, ""),
-		ReservedIdentityIngress: labels.LabelIngress,
+		ReservedIdentityIngress:  labels.LabelIngress,
+		ReservedIdentityDNSProxy: labels.LabelDNSProxy,
 	}
 
 	// WellKnown identities stores global state of all well-known identities.
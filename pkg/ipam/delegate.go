@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cilium/cilium/pkg/ipam/delegate"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// delegateRequestTimeout bounds each RPC to the IPAM delegate. Allocations
+// are issued synchronously from the endpoint creation path, so a hanging
+// delegate must not stall endpoint creation indefinitely.
+const delegateRequestTimeout = 10 * time.Second
+
+// delegateAllocator serves allocations from an external IPAM system via the
+// gRPC interface defined in pkg/ipam/delegate/api.proto. While the delegate
+// is unreachable, allocations fall back to a local host-scope pool carved out
+// of the node allocation CIDR so that endpoint creation keeps working.
+type delegateAllocator struct {
+	family   Family
+	endpoint string
+	client   *delegate.Client
+
+	// fallback is the local pool used while the delegate is unreachable.
+	fallback Allocator
+
+	mutex lock.Mutex
+	// fallbackIPs tracks IPs served from the local pool, so that they are
+	// returned there on release rather than to the delegate.
+	fallbackIPs map[string]struct{}
+}
+
+func newDelegateAllocator(family Family, fallbackCIDR *net.IPNet) Allocator {
+	endpoint := option.Config.IPAMDelegateEndpoint
+	if endpoint == "" {
+		log.Fatalf("Option --%s must be set when using %s IPAM", option.IPAMDelegateEndpoint, option.Config.IPAM)
+	}
+
+	client, err := delegate.NewClient(endpoint)
+	if err != nil {
+		log.WithError(err).Fatal("Unable to initialize IPAM delegate client")
+	}
+
+	return &delegateAllocator{
+		family:      family,
+		endpoint:    endpoint,
+		client:      client,
+		fallback:    newHostScopeAllocator(fallbackCIDR),
+		fallbackIPs: map[string]struct{}{},
+	}
+}
+
+// delegateUnreachable returns true for errors which indicate that the
+// delegate could not serve the request at all, as opposed to rejecting it.
+func delegateUnreachable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func (d *delegateAllocator) allocate(ip net.IP, owner string) (*AllocationResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), delegateRequestTimeout)
+	defer cancel()
+
+	req := &delegate.AllocateRequest{
+		Owner:  owner,
+		Family: string(d.family),
+	}
+	if ip != nil {
+		req.IP = ip.String()
+	}
+
+	resp, err := d.client.Allocate(ctx, req)
+	if err != nil {
+		if !delegateUnreachable(err) {
+			return nil, err
+		}
+
+		log.WithError(err).WithField("endpoint", d.endpoint).
+			Warning("IPAM delegate unreachable, allocating from local fallback pool")
+
+		var result *AllocationResult
+		if ip != nil {
+			result, err = d.fallback.Allocate(ip, owner)
+		} else {
+			result, err = d.fallback.AllocateNext(owner)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		d.mutex.Lock()
+		d.fallbackIPs[result.IP.String()] = struct{}{}
+		d.mutex.Unlock()
+		return result, nil
+	}
+
+	allocatedIP := net.ParseIP(resp.IP)
+	if allocatedIP == nil {
+		return nil, fmt.Errorf("IPAM delegate returned invalid IP %q", resp.IP)
+	}
+
+	return &AllocationResult{
+		IP:        allocatedIP,
+		CIDRs:     resp.CIDRs,
+		GatewayIP: resp.GatewayIP,
+	}, nil
+}
+
+func (d *delegateAllocator) Allocate(ip net.IP, owner string) (*AllocationResult, error) {
+	return d.allocate(ip, owner)
+}
+
+func (d *delegateAllocator) AllocateWithoutSyncUpstream(ip net.IP, owner string) (*AllocationResult, error) {
+	return d.allocate(ip, owner)
+}
+
+func (d *delegateAllocator) AllocateNext(owner string) (*AllocationResult, error) {
+	return d.allocate(nil, owner)
+}
+
+func (d *delegateAllocator) AllocateNextWithoutSyncUpstream(owner string) (*AllocationResult, error) {
+	return d.allocate(nil, owner)
+}
+
+func (d *delegateAllocator) Release(ip net.IP) error {
+	ipStr := ip.String()
+
+	d.mutex.Lock()
+	_, fromFallback := d.fallbackIPs[ipStr]
+	if fromFallback {
+		delete(d.fallbackIPs, ipStr)
+	}
+	d.mutex.Unlock()
+
+	if fromFallback {
+		return d.fallback.Release(ip)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), delegateRequestTimeout)
+	defer cancel()
+
+	return d.client.Release(ctx, &delegate.ReleaseRequest{IP: ipStr})
+}
+
+func (d *delegateAllocator) Dump() (map[string]string, string) {
+	ctx, cancel := context.WithTimeout(context.Background(), delegateRequestTimeout)
+	defer cancel()
+
+	health := "healthy"
+	if err := d.client.Healthy(ctx); err != nil {
+		health = fmt.Sprintf("unreachable: %s", err)
+	}
+
+	d.mutex.Lock()
+	numFallback := len(d.fallbackIPs)
+	d.mutex.Unlock()
+
+	return nil, fmt.Sprintf("delegated to %s (%s), %d IPs allocated from local fallback pool",
+		d.endpoint, health, numFallback)
+}
+
+func (d *delegateAllocator) RestoreFinished() {
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package delegate
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	allocateMethod = "/ipamdelegate.v1.IPAMDelegate/Allocate"
+	releaseMethod  = "/ipamdelegate.v1.IPAMDelegate/Release"
+)
+
+// Client talks to an external IPAM delegate implementing the IPAMDelegate
+// gRPC service defined in api.proto.
+type Client struct {
+	conn   *grpc.ClientConn
+	health healthpb.HealthClient
+}
+
+// NewClient connects to the delegate at the given endpoint, e.g.
+// "unix:///var/run/cilium/ipam.sock" or "ipam.kube-system.svc:9040". The
+// connection is established lazily, individual RPCs fail with codes
+// Unavailable while the delegate cannot be reached.
+func NewClient(endpoint string) (*Client, error) {
+	conn, err := grpc.Dial(endpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec{})))
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to IPAM delegate %q: %w", endpoint, err)
+	}
+
+	return &Client{
+		conn:   conn,
+		health: healthpb.NewHealthClient(conn),
+	}, nil
+}
+
+// Allocate reserves an IP with the delegate. If req.IP is set, exactly that
+// IP is requested, otherwise the delegate picks the next free IP of
+// req.Family.
+func (c *Client) Allocate(ctx context.Context, req *AllocateRequest) (*AllocateResponse, error) {
+	resp := &AllocateResponse{}
+	if err := c.conn.Invoke(ctx, allocateMethod, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Release returns a previously allocated IP to the delegate.
+func (c *Client) Release(ctx context.Context, req *ReleaseRequest) error {
+	return c.conn.Invoke(ctx, releaseMethod, req, &ReleaseResponse{})
+}
+
+// Healthy probes the delegate via the standard gRPC health checking protocol
+// and returns nil when it reports itself as serving.
+func (c *Client) Healthy(ctx context.Context) error {
+	resp, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("IPAM delegate reports status %s", resp.Status)
+	}
+	return nil
+}
+
+// Close tears down the connection to the delegate.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
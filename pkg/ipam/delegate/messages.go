@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package delegate
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The message types below mirror the definitions in api.proto. They are
+// marshalled by hand via protowire rather than generated, as only string and
+// repeated string fields are needed, keeping the agent free of a code
+// generation step while remaining wire compatible with delegates built from
+// the proto definition.
+
+// message is implemented by all request and response types exchanged with the
+// delegate.
+type message interface {
+	marshal() []byte
+	unmarshal(data []byte) error
+}
+
+// AllocateRequest asks the delegate to reserve an IP. See api.proto.
+type AllocateRequest struct {
+	Owner  string
+	Family string
+	IP     string
+}
+
+func (m *AllocateRequest) marshal() []byte {
+	var b []byte
+	b = appendStringField(b, 1, m.Owner)
+	b = appendStringField(b, 2, m.Family)
+	b = appendStringField(b, 3, m.IP)
+	return b
+}
+
+func (m *AllocateRequest) unmarshal(data []byte) error {
+	return consumeFields(data, func(num protowire.Number, val string) {
+		switch num {
+		case 1:
+			m.Owner = val
+		case 2:
+			m.Family = val
+		case 3:
+			m.IP = val
+		}
+	})
+}
+
+// AllocateResponse is the delegate's answer to an AllocateRequest. See
+// api.proto.
+type AllocateResponse struct {
+	IP        string
+	GatewayIP string
+	CIDRs     []string
+}
+
+func (m *AllocateResponse) marshal() []byte {
+	var b []byte
+	b = appendStringField(b, 1, m.IP)
+	b = appendStringField(b, 2, m.GatewayIP)
+	for _, c := range m.CIDRs {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, c)
+	}
+	return b
+}
+
+func (m *AllocateResponse) unmarshal(data []byte) error {
+	return consumeFields(data, func(num protowire.Number, val string) {
+		switch num {
+		case 1:
+			m.IP = val
+		case 2:
+			m.GatewayIP = val
+		case 3:
+			m.CIDRs = append(m.CIDRs, val)
+		}
+	})
+}
+
+// ReleaseRequest asks the delegate to return an IP to the pool. See
+// api.proto.
+type ReleaseRequest struct {
+	IP string
+}
+
+func (m *ReleaseRequest) marshal() []byte {
+	return appendStringField(nil, 1, m.IP)
+}
+
+func (m *ReleaseRequest) unmarshal(data []byte) error {
+	return consumeFields(data, func(num protowire.Number, val string) {
+		if num == 1 {
+			m.IP = val
+		}
+	})
+}
+
+// ReleaseResponse is the empty answer to a ReleaseRequest.
+type ReleaseResponse struct{}
+
+func (m *ReleaseResponse) marshal() []byte {
+	return []byte{}
+}
+
+func (m *ReleaseResponse) unmarshal(data []byte) error {
+	return consumeFields(data, func(protowire.Number, string) {})
+}
+
+// appendStringField appends a length-delimited string field, omitting it when
+// empty as proto3 does.
+func appendStringField(b []byte, num protowire.Number, val string) []byte {
+	if val == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	b = protowire.AppendString(b, val)
+	return b
+}
+
+// consumeFields walks all fields of a wire-encoded message, invoking fn for
+// each length-delimited field and skipping fields of any other type, so that
+// unknown extensions added by newer delegates are tolerated.
+func consumeFields(data []byte, fn func(num protowire.Number, val string)) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if typ == protowire.BytesType {
+			val, n := protowire.ConsumeString(data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			fn(num, val)
+			data = data[n:]
+			continue
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+// codec marshals the hand-written message types in the standard proto wire
+// format. It reports "proto" as its name so that the gRPC content subtype
+// matches what protoc-generated delegates expect.
+type codec struct{}
+
+func (codec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(message)
+	if !ok {
+		return nil, fmt.Errorf("failed to marshal, message is %T", v)
+	}
+	return m.marshal(), nil
+}
+
+func (codec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(message)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal, message is %T", v)
+	}
+	return m.unmarshal(data)
+}
+
+func (codec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package delegate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageRoundTrip(t *testing.T) {
+	messages := []struct {
+		in  message
+		out message
+	}{
+		{
+			in:  &AllocateRequest{Owner: "default/pod-1", Family: "ipv4", IP: "10.0.0.1"},
+			out: &AllocateRequest{},
+		},
+		{
+			in:  &AllocateRequest{Owner: "default/pod-1", Family: "ipv6"},
+			out: &AllocateRequest{},
+		},
+		{
+			in:  &AllocateResponse{IP: "10.0.0.1", GatewayIP: "10.0.0.254", CIDRs: []string{"10.0.0.0/16", "192.168.0.0/24"}},
+			out: &AllocateResponse{},
+		},
+		{
+			in:  &ReleaseRequest{IP: "f00d::1"},
+			out: &ReleaseRequest{},
+		},
+		{
+			in:  &ReleaseResponse{},
+			out: &ReleaseResponse{},
+		},
+	}
+
+	c := codec{}
+	for _, msg := range messages {
+		data, err := c.Marshal(msg.in)
+		require.NoError(t, err)
+		require.NoError(t, c.Unmarshal(data, msg.out))
+		require.Equal(t, msg.in, msg.out)
+	}
+}
+
+func TestUnmarshalSkipsUnknownFields(t *testing.T) {
+	// Field 3 (ip) followed by an unknown varint field 15, as a newer
+	// delegate might emit.
+	data := append([]byte{0x1a, 0x08}, []byte("10.0.0.1")...)
+	data = append(data, 0x78, 0x2a)
+
+	req := &AllocateRequest{}
+	require.NoError(t, codec{}.Unmarshal(data, req))
+	require.Equal(t, "10.0.0.1", req.IP)
+}
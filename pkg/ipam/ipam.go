@@ -146,6 +146,14 @@ func NewIPAM(nodeAddressing types.NodeAddressing, c Configuration, owner Owner,
 		if c.IPv4Enabled() {
 			ipam.IPv4Allocator = newCRDAllocator(IPv4, c, owner, clientset, k8sEventReg, mtuConfig)
 		}
+	case ipamOption.IPAMDelegatedGRPC:
+		log.Info("Initializing gRPC delegated IPAM")
+		if c.IPv6Enabled() {
+			ipam.IPv6Allocator = newDelegateAllocator(IPv6, nodeAddressing.IPv6().AllocationCIDR().IPNet)
+		}
+		if c.IPv4Enabled() {
+			ipam.IPv4Allocator = newDelegateAllocator(IPv4, nodeAddressing.IPv4().AllocationCIDR().IPNet)
+		}
 	case ipamOption.IPAMDelegatedPlugin:
 		log.Info("Initializing no-op IPAM since we're using a CNI delegated plugin")
 		if c.IPv6Enabled() {
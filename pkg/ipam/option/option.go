@@ -33,6 +33,12 @@ const (
 	// In this mode, Cilium CNI invokes another CNI binary (the delegated plugin) for IPAM.
 	// See https://www.cni.dev/docs/spec/#section-4-plugin-delegation
 	IPAMDelegatedPlugin = "delegated-plugin"
+
+	// IPAMDelegatedGRPC is the value to select the gRPC delegated IPAM mode.
+	// In this mode, the agent requests allocations from an external IPAM
+	// system implementing the IPAMDelegate gRPC service, falling back to a
+	// local pool while the delegate is unreachable.
+	IPAMDelegatedGRPC = "delegated-grpc"
 )
 
 const (
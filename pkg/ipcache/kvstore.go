@@ -20,6 +20,7 @@ import (
 	"github.com/cilium/cilium/pkg/kvstore"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/source"
@@ -121,6 +122,7 @@ func UpsertIPToKVStore(ctx context.Context, IP, hostIP net.IP, ID identity.Numer
 		K8sNamespace: k8sNamespace,
 		K8sPodName:   k8sPodName,
 		NamedPorts:   namedPorts,
+		PublishedAt:  time.Now().UnixNano(),
 	}
 
 	marshaledIPIDPair, err := json.Marshal(ipIDPair)
@@ -275,6 +277,14 @@ restart:
 						WithError(err).Error("Not adding entry to ip cache; error unmarshaling data from key-value store")
 					continue
 				}
+				// Only measure propagation delay for watch events. The
+				// initial list replays entries of arbitrary age and would
+				// skew the histogram.
+				if iw.isSynced() && ipIDPair.PublishedAt > 0 {
+					if delay := time.Since(time.Unix(0, ipIDPair.PublishedAt)); delay > 0 {
+						metrics.IPCachePropagationDelay.WithLabelValues().Observe(delay.Seconds())
+					}
+				}
 				ip := ipIDPair.PrefixString()
 				if ip == "<nil>" {
 					if option.Config.Debug {
@@ -383,6 +393,16 @@ func (iw *IPIdentityWatcher) waitForInitialSync() {
 	<-iw.synced
 }
 
+// isSynced returns true once the initial list of the watcher has completed.
+func (iw *IPIdentityWatcher) isSynced() bool {
+	select {
+	case <-iw.synced:
+		return true
+	default:
+		return false
+	}
+}
+
 var (
 	watcher     *IPIdentityWatcher
 	initialized = make(chan struct{})
@@ -0,0 +1,400 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package ipfix implements an IPFIX (RFC 7011) flow exporter fed by sampled
+// datapath trace events. It allows NetFlow/IPFIX-based network monitoring
+// systems to ingest pod traffic data without adopting Hubble. Exported data
+// records carry the standard 5-tuple and delta counters, plus the Cilium
+// security identities of the flow endpoints as enterprise-specific fields.
+package ipfix
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/monitor"
+	monitorConsumer "github.com/cilium/cilium/pkg/monitor/agent/consumer"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "ipfix")
+
+var _ monitorConsumer.MonitorConsumer = (*Exporter)(nil)
+
+const (
+	// ipfixVersion is the version number carried in every message header.
+	ipfixVersion = 10
+
+	// templateSetID is the set ID reserved for template sets.
+	templateSetID = 2
+
+	// templateIDv4 and templateIDv6 identify the IPv4 and IPv6 data record
+	// templates. Data set IDs below 256 are reserved.
+	templateIDv4 = 256
+	templateIDv6 = 257
+
+	// templateRefreshInterval is how often templates are re-sent. IPFIX
+	// over UDP has no reliable template delivery, so templates are
+	// periodically prepended to data messages.
+	templateRefreshInterval = time.Minute
+
+	// enterpriseNumber is the private enterprise number under which the
+	// Cilium-specific fields are exported. This is the number reserved for
+	// documentation use by RFC 5612 until a Cilium-specific assignment
+	// exists; collectors matching on the enterprise fields must be
+	// configured accordingly.
+	enterpriseNumber = 32473
+
+	// Standard information elements used in the templates.
+	ieOctetDeltaCount          = 1
+	iePacketDeltaCount         = 2
+	ieProtocolIdentifier       = 4
+	ieSourceTransportPort      = 7
+	ieSourceIPv4Address        = 8
+	ieDestinationTransportPort = 11
+	ieDestinationIPv4Address   = 12
+	ieSourceIPv6Address        = 27
+	ieDestinationIPv6Address   = 28
+
+	// Enterprise-specific information elements carrying the Cilium
+	// security identities of the flow source and destination.
+	ieSourceIdentity      = 1
+	ieDestinationIdentity = 2
+
+	// eventQueueSize bounds the number of sampled events waiting to be
+	// encoded. Events beyond this are dropped; the export is sampled
+	// anyway.
+	eventQueueSize = 1024
+)
+
+// flowRecord is one sampled packet observation, decoded and enriched, ready
+// to be encoded as an IPFIX data record.
+type flowRecord struct {
+	srcIP, dstIP     net.IP
+	proto            uint8
+	srcPort, dstPort uint16
+	octets           uint64
+	packets          uint64
+	srcIdentity      uint32
+	dstIdentity      uint32
+	ipv6             bool
+}
+
+// Exporter consumes monitor trace events, samples them and exports the
+// sampled flows as IPFIX data records to a collector. It implements
+// monitorConsumer.MonitorConsumer.
+type Exporter struct {
+	conn       net.Conn
+	sampleRate uint64
+
+	// counter counts observed trace events for sampling. Accessed
+	// atomically.
+	counter uint64
+
+	// dropped counts sampled events discarded because the encoder could
+	// not keep up. Accessed atomically.
+	dropped uint64
+
+	// events carries the raw perf event payloads of sampled trace events
+	// from the perf reader to the encoder goroutine.
+	events chan []byte
+
+	// sequence is the IPFIX sequence number, counting exported data
+	// records. Only touched by the encoder goroutine.
+	sequence uint32
+
+	// lastTemplate is when templates were last sent. Only touched by the
+	// encoder goroutine.
+	lastTemplate time.Time
+}
+
+// NewExporter connects to the given UDP collector address and starts
+// exporting one of every sampleRate observed trace events as an IPFIX data
+// record.
+func NewExporter(collectorAddress string, sampleRate int) (*Exporter, error) {
+	if sampleRate < 1 {
+		return nil, fmt.Errorf("invalid IPFIX sample rate %d", sampleRate)
+	}
+	conn, err := net.Dial("udp", collectorAddress)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to IPFIX collector %s: %w", collectorAddress, err)
+	}
+
+	e := &Exporter{
+		conn:       conn,
+		sampleRate: uint64(sampleRate),
+		events:     make(chan []byte, eventQueueSize),
+	}
+	go e.run()
+
+	log.WithFields(map[string]interface{}{
+		"collector":  collectorAddress,
+		"sampleRate": sampleRate,
+	}).Info("Exporting sampled flows via IPFIX")
+	return e, nil
+}
+
+// Close stops the exporter and closes the connection to the collector.
+func (e *Exporter) Close() {
+	close(e.events)
+}
+
+// NotifyAgentEvent implements monitorConsumer.MonitorConsumer. Agent events
+// carry no packet data and are not exported.
+func (e *Exporter) NotifyAgentEvent(typ int, message interface{}) {}
+
+// NotifyPerfEvent implements monitorConsumer.MonitorConsumer. One of every
+// sampleRate trace events is handed to the encoder goroutine.
+func (e *Exporter) NotifyPerfEvent(data []byte, cpu int) {
+	if len(data) == 0 || data[0] != byte(monitorAPI.MessageTypeTrace) {
+		return
+	}
+	if atomic.AddUint64(&e.counter, 1)%e.sampleRate != 0 {
+		return
+	}
+
+	// The perf reader reuses its buffer, hand a copy to the encoder.
+	event := make([]byte, len(data))
+	copy(event, data)
+	select {
+	case e.events <- event:
+	default:
+		atomic.AddUint64(&e.dropped, 1)
+	}
+}
+
+// NotifyPerfEventLost implements monitorConsumer.MonitorConsumer. Lost events
+// only widen the effective sampling interval and are not reported to the
+// collector.
+func (e *Exporter) NotifyPerfEventLost(numLostEvents uint64, cpu int) {}
+
+// run decodes sampled trace events and exports them until Close is called.
+func (e *Exporter) run() {
+	defer e.conn.Close()
+
+	var (
+		eth     layers.Ethernet
+		ip4     layers.IPv4
+		ip6     layers.IPv6
+		tcp     layers.TCP
+		udp     layers.UDP
+		sctp    layers.SCTP
+		decoded []gopacket.LayerType
+	)
+	parser := gopacket.NewDecodingLayerParser(layers.LayerTypeEthernet,
+		&eth, &ip4, &ip6, &tcp, &udp, &sctp)
+	parser.IgnoreUnsupported = true
+
+	for event := range e.events {
+		record, ok := e.decodeEvent(event, parser, &ip4, &ip6, &tcp, &udp, &sctp, &decoded)
+		if !ok {
+			continue
+		}
+		if err := e.export(record); err != nil {
+			log.WithError(err).Debug("Unable to export IPFIX data record")
+		}
+	}
+}
+
+// decodeEvent turns a raw trace event into a flow record. Only events
+// observed at the endpoint observation points are exported, so that each
+// packet of pod traffic is counted once per direction regardless of how many
+// other observation points it traverses.
+func (e *Exporter) decodeEvent(event []byte, parser *gopacket.DecodingLayerParser,
+	ip4 *layers.IPv4, ip6 *layers.IPv6, tcp *layers.TCP, udp *layers.UDP,
+	sctp *layers.SCTP, decoded *[]gopacket.LayerType,
+) (flowRecord, bool) {
+	var tn monitor.TraceNotify
+	if err := monitor.DecodeTraceNotify(event, &tn); err != nil {
+		return flowRecord{}, false
+	}
+	switch tn.ObsPoint {
+	case monitorAPI.TraceToLxc, monitorAPI.TraceFromLxc:
+	default:
+		return flowRecord{}, false
+	}
+	offset := tn.DataOffset()
+	if offset == 0 || uint(len(event)) <= offset {
+		return flowRecord{}, false
+	}
+
+	if err := parser.DecodeLayers(event[offset:], decoded); err != nil {
+		return flowRecord{}, false
+	}
+
+	record := flowRecord{
+		// Scale the counters up by the sampling rate so that collectors
+		// aggregating the records approximate the real traffic volume.
+		octets:      uint64(tn.OrigLen) * e.sampleRate,
+		packets:     e.sampleRate,
+		srcIdentity: tn.SrcLabel.Uint32(),
+		dstIdentity: tn.DstLabel.Uint32(),
+	}
+	haveNetwork := false
+	for _, layerType := range *decoded {
+		switch layerType {
+		case layers.LayerTypeIPv4:
+			record.srcIP, record.dstIP = ip4.SrcIP, ip4.DstIP
+			record.proto = uint8(ip4.Protocol)
+			haveNetwork = true
+		case layers.LayerTypeIPv6:
+			record.srcIP, record.dstIP = ip6.SrcIP, ip6.DstIP
+			record.proto = uint8(ip6.NextHeader)
+			record.ipv6 = true
+			haveNetwork = true
+		case layers.LayerTypeTCP:
+			record.srcPort = uint16(tcp.SrcPort)
+			record.dstPort = uint16(tcp.DstPort)
+		case layers.LayerTypeUDP:
+			record.srcPort = uint16(udp.SrcPort)
+			record.dstPort = uint16(udp.DstPort)
+		case layers.LayerTypeSCTP:
+			record.srcPort = uint16(sctp.SrcPort)
+			record.dstPort = uint16(sctp.DstPort)
+		}
+	}
+	return record, haveNetwork
+}
+
+// export encodes the record into an IPFIX message and sends it to the
+// collector. Templates are prepended when they have not been sent recently.
+func (e *Exporter) export(record flowRecord) error {
+	now := time.Now()
+	withTemplates := now.Sub(e.lastTemplate) >= templateRefreshInterval
+	msg := encodeMessage(record, e.sequence, uint32(now.Unix()), withTemplates)
+	if _, err := e.conn.Write(msg); err != nil {
+		return err
+	}
+	if withTemplates {
+		e.lastTemplate = now
+	}
+	e.sequence++
+	return nil
+}
+
+// fieldSpec encodes one template field specifier. Enterprise-specific fields
+// have the enterprise bit set on the element ID and carry the enterprise
+// number.
+type fieldSpec struct {
+	id         uint16
+	length     uint16
+	enterprise bool
+}
+
+var (
+	commonFields = []fieldSpec{
+		{id: ieProtocolIdentifier, length: 1},
+		{id: ieSourceTransportPort, length: 2},
+		{id: ieDestinationTransportPort, length: 2},
+		{id: ieOctetDeltaCount, length: 8},
+		{id: iePacketDeltaCount, length: 8},
+		{id: ieSourceIdentity, length: 4, enterprise: true},
+		{id: ieDestinationIdentity, length: 4, enterprise: true},
+	}
+	templateFieldsV4 = append([]fieldSpec{
+		{id: ieSourceIPv4Address, length: 4},
+		{id: ieDestinationIPv4Address, length: 4},
+	}, commonFields...)
+	templateFieldsV6 = append([]fieldSpec{
+		{id: ieSourceIPv6Address, length: 16},
+		{id: ieDestinationIPv6Address, length: 16},
+	}, commonFields...)
+)
+
+// encodeMessage builds a complete IPFIX message carrying one data record and,
+// when withTemplates is set, a template set describing both record layouts.
+func encodeMessage(record flowRecord, sequence, exportTime uint32, withTemplates bool) []byte {
+	// Message header, filled in at the end once the length is known.
+	msg := make([]byte, 16)
+	binary.BigEndian.PutUint16(msg[0:2], ipfixVersion)
+	binary.BigEndian.PutUint32(msg[4:8], exportTime)
+	binary.BigEndian.PutUint32(msg[8:12], sequence)
+
+	if withTemplates {
+		msg = appendTemplateSet(msg)
+	}
+	msg = appendDataSet(msg, record)
+
+	binary.BigEndian.PutUint16(msg[2:4], uint16(len(msg)))
+	return msg
+}
+
+// appendTemplateSet appends a template set describing the IPv4 and IPv6 data
+// record layouts.
+func appendTemplateSet(msg []byte) []byte {
+	setStart := len(msg)
+	msg = appendUint16(msg, templateSetID)
+	msg = appendUint16(msg, 0) // set length, filled in below
+
+	for templateID, fields := range map[uint16][]fieldSpec{
+		templateIDv4: templateFieldsV4,
+		templateIDv6: templateFieldsV6,
+	} {
+		msg = appendUint16(msg, templateID)
+		msg = appendUint16(msg, uint16(len(fields)))
+		for _, field := range fields {
+			if field.enterprise {
+				msg = appendUint16(msg, field.id|0x8000)
+				msg = appendUint16(msg, field.length)
+				msg = appendUint32(msg, enterpriseNumber)
+			} else {
+				msg = appendUint16(msg, field.id)
+				msg = appendUint16(msg, field.length)
+			}
+		}
+	}
+
+	binary.BigEndian.PutUint16(msg[setStart+2:setStart+4], uint16(len(msg)-setStart))
+	return msg
+}
+
+// appendDataSet appends a data set carrying the record, using the template
+// matching its address family.
+func appendDataSet(msg []byte, record flowRecord) []byte {
+	setStart := len(msg)
+	if record.ipv6 {
+		msg = appendUint16(msg, templateIDv6)
+		msg = appendUint16(msg, 0) // set length, filled in below
+		msg = append(msg, record.srcIP.To16()...)
+		msg = append(msg, record.dstIP.To16()...)
+	} else {
+		msg = appendUint16(msg, templateIDv4)
+		msg = appendUint16(msg, 0) // set length, filled in below
+		msg = append(msg, record.srcIP.To4()...)
+		msg = append(msg, record.dstIP.To4()...)
+	}
+	msg = append(msg, record.proto)
+	msg = appendUint16(msg, record.srcPort)
+	msg = appendUint16(msg, record.dstPort)
+	msg = appendUint64(msg, record.octets)
+	msg = appendUint64(msg, record.packets)
+	msg = appendUint32(msg, record.srcIdentity)
+	msg = appendUint32(msg, record.dstIdentity)
+
+	binary.BigEndian.PutUint16(msg[setStart+2:setStart+4], uint16(len(msg)-setStart))
+	return msg
+}
+
+func appendUint16(msg []byte, v uint16) []byte {
+	return append(msg, byte(v>>8), byte(v))
+}
+
+func appendUint32(msg []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], v)
+	return append(msg, buf[:]...)
+}
+
+func appendUint64(msg []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	return append(msg, buf[:]...)
+}
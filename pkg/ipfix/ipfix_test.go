@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipfix
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeMessage(t *testing.T) {
+	record := flowRecord{
+		srcIP:       net.ParseIP("10.0.0.1"),
+		dstIP:       net.ParseIP("10.0.0.2"),
+		proto:       6,
+		srcPort:     43872,
+		dstPort:     80,
+		octets:      1500,
+		packets:     1,
+		srcIdentity: 1000,
+		dstIdentity: 2000,
+	}
+
+	msg := encodeMessage(record, 7, 1234567890, true)
+
+	// Message header.
+	require.EqualValues(t, ipfixVersion, binary.BigEndian.Uint16(msg[0:2]))
+	require.EqualValues(t, len(msg), binary.BigEndian.Uint16(msg[2:4]))
+	require.EqualValues(t, 1234567890, binary.BigEndian.Uint32(msg[4:8]))
+	require.EqualValues(t, 7, binary.BigEndian.Uint32(msg[8:12]))
+
+	// Template set: both templates with all fields.
+	require.EqualValues(t, templateSetID, binary.BigEndian.Uint16(msg[16:18]))
+	templateSetLen := int(binary.BigEndian.Uint16(msg[18:20]))
+	expectedTemplateLen := func(fields []fieldSpec) (length int) {
+		length = 4
+		for _, field := range fields {
+			length += 4
+			if field.enterprise {
+				length += 4
+			}
+		}
+		return length
+	}
+	require.Equal(t, 4+expectedTemplateLen(templateFieldsV4)+expectedTemplateLen(templateFieldsV6), templateSetLen)
+
+	// Data set: template ID and the encoded record.
+	dataSet := msg[16+templateSetLen:]
+	require.EqualValues(t, templateIDv4, binary.BigEndian.Uint16(dataSet[0:2]))
+	require.EqualValues(t, len(dataSet), binary.BigEndian.Uint16(dataSet[2:4]))
+	require.Equal(t, net.IP(dataSet[4:8]).String(), "10.0.0.1")
+	require.Equal(t, net.IP(dataSet[8:12]).String(), "10.0.0.2")
+	require.EqualValues(t, 6, dataSet[12])
+	require.EqualValues(t, 43872, binary.BigEndian.Uint16(dataSet[13:15]))
+	require.EqualValues(t, 80, binary.BigEndian.Uint16(dataSet[15:17]))
+	require.EqualValues(t, 1500, binary.BigEndian.Uint64(dataSet[17:25]))
+	require.EqualValues(t, 1, binary.BigEndian.Uint64(dataSet[25:33]))
+	require.EqualValues(t, 1000, binary.BigEndian.Uint32(dataSet[33:37]))
+	require.EqualValues(t, 2000, binary.BigEndian.Uint32(dataSet[37:41]))
+	require.Len(t, dataSet, 41)
+
+	// Without a template refresh due, the data set directly follows the
+	// message header and IPv6 records use the IPv6 template.
+	record.srcIP = net.ParseIP("f00d::1")
+	record.dstIP = net.ParseIP("f00d::2")
+	record.ipv6 = true
+	msg = encodeMessage(record, 8, 1234567890, false)
+	require.EqualValues(t, len(msg), binary.BigEndian.Uint16(msg[2:4]))
+	require.EqualValues(t, templateIDv6, binary.BigEndian.Uint16(msg[16:18]))
+	require.Equal(t, net.IP(msg[20:36]).String(), "f00d::1")
+	require.Len(t, msg[16:], 4+16+16+1+2+2+8+8+4+4)
+}
@@ -36,6 +36,15 @@ const (
 	// or "false".
 	PolicyLabelIstioSidecarProxy = LabelPrefix + ".policy.istiosidecarproxy"
 
+	// PolicyLabelNetworkAttachment is the prefix of the label keys added
+	// to the identity of a pod for each of its secondary network
+	// attachments, so policies can be scoped by network.
+	PolicyLabelNetworkAttachment = LabelPrefix + ".policy.network-attachment"
+
+	// PodNetworksAnnotation is the annotation used to request secondary
+	// pod networks via NetworkAttachmentDefinitions.
+	PodNetworksAnnotation = "k8s.v1.cni.cncf.io/networks"
+
 	// PodNamespaceMetaLabels is the label used to store the labels of the
 	// kubernetes namespace's labels.
 	PodNamespaceMetaLabels = LabelPrefix + ".namespace.labels"
@@ -98,6 +98,13 @@ func getEndpointSelector(namespace string, labelSelector *slim_metav1.LabelSelec
 	return es
 }
 
+// getNodeSelector converts the provided labelSelector into an EndpointSelector
+// matching on node labels. Nodes are cluster-scoped, so no namespace matches
+// are added.
+func getNodeSelector(labelSelector *slim_metav1.LabelSelector) api.EndpointSelector {
+	return api.NewESFromK8sLabelSelector(labels.LabelSourceNodeKeyPrefix, labelSelector)
+}
+
 func parseToCiliumIngressCommonRule(namespace string, es api.EndpointSelector, ing api.IngressCommonRule) api.IngressCommonRule {
 	matchesInit := matchesPodInit(es)
 	var retRule api.IngressCommonRule
@@ -131,6 +138,13 @@ func parseToCiliumIngressCommonRule(namespace string, es api.EndpointSelector, i
 		copy(retRule.FromEntities, ing.FromEntities)
 	}
 
+	if ing.FromNodes != nil {
+		retRule.FromNodes = make([]api.EndpointSelector, len(ing.FromNodes))
+		for j, node := range ing.FromNodes {
+			retRule.FromNodes[j] = getNodeSelector(node.LabelSelector)
+		}
+	}
+
 	return retRule
 }
 
@@ -213,6 +227,13 @@ func parseToCiliumEgressCommonRule(namespace string, es api.EndpointSelector, eg
 		copy(retRule.ToEntities, egr.ToEntities)
 	}
 
+	if egr.ToNodes != nil {
+		retRule.ToNodes = make([]api.EndpointSelector, len(egr.ToNodes))
+		for j, node := range egr.ToNodes {
+			retRule.ToNodes[j] = getNodeSelector(node.LabelSelector)
+		}
+	}
+
 	if egr.ToGroups != nil {
 		retRule.ToGroups = make([]api.ToGroups, len(egr.ToGroups))
 		copy(retRule.ToGroups, egr.ToGroups)
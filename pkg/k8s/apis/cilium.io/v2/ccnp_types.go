@@ -127,9 +127,9 @@ func (r *CiliumClusterwideNetworkPolicy) Parse() (api.Rules, error) {
 		retRules = append(retRules, cr)
 	}
 	if r.Specs != nil {
-		for _, rule := range r.Specs {
+		for i, rule := range r.Specs {
 			if err := rule.Sanitize(); err != nil {
-				return nil, NewErrParse(fmt.Sprintf("Invalid CiliumClusterwideNetworkPolicy specs: %s", err))
+				return nil, NewErrParse(fmt.Sprintf("Invalid CiliumClusterwideNetworkPolicy specs[%d]: %s", i, err))
 
 			}
 			cr := k8sCiliumUtils.ParseToCiliumRule("", name, uid, rule)
@@ -222,9 +222,9 @@ func (r *CiliumNetworkPolicy) Parse() (api.Rules, error) {
 		retRules = append(retRules, cr)
 	}
 	if r.Specs != nil {
-		for _, rule := range r.Specs {
+		for i, rule := range r.Specs {
 			if err := rule.Sanitize(); err != nil {
-				return nil, NewErrParse(fmt.Sprintf("Invalid CiliumNetworkPolicy specs: %s", err))
+				return nil, NewErrParse(fmt.Sprintf("Invalid CiliumNetworkPolicy specs[%d]: %s", i, err))
 
 			}
 			cr := k8sCiliumUtils.ParseToCiliumRule(namespace, name, uid, rule)
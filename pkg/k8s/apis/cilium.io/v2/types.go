@@ -400,6 +400,14 @@ type NodeSpec struct {
 	//
 	// +kubebuilder:validation:Optional
 	NodeIdentity uint64 `json:"nodeidentity,omitempty"`
+
+	// PolicyEnforcement overrides the policy enforcement mode the agent on
+	// this node was started with. When empty, the agent's configured mode
+	// applies.
+	//
+	// +kubebuilder:validation:Enum=default;always;never
+	// +kubebuilder:validation:Optional
+	PolicyEnforcement string `json:"policy-enforcement,omitempty"`
 }
 
 // HealthAddressingSpec is the addressing information required to do
@@ -1112,6 +1112,10 @@ func (in *NodeSpec) DeepEqual(other *NodeSpec) bool {
 		return false
 	}
 
+	if in.PolicyEnforcement != other.PolicyEnforcement {
+		return false
+	}
+
 	return true
 }
 
@@ -79,6 +79,30 @@ type CiliumBGPNeighbor struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=4294967295
 	PeerASN int `json:"peerASN"`
+	// GracefulRestart configures the BGP graceful restart capability for
+	// this peer, instructing it to retain routes learned from this router
+	// across a restart of the BGP session.
+	//
+	// +kubebuilder:validation:Optional
+	GracefulRestart CiliumBGPNeighborGracefulRestart `json:"gracefulRestart,omitempty"`
+}
+
+// CiliumBGPNeighborGracefulRestart holds the graceful restart configuration
+// of a CiliumBGPNeighbor.
+type CiliumBGPNeighborGracefulRestart struct {
+	// Enabled flags graceful restart capability negotiation for this peer.
+	//
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+	// RestartTimeSeconds is the estimated time it will take for the BGP
+	// session to be re-established with the peer after a restart. The
+	// peer retains routes learned from this router for this long. If
+	// unset, a default of 120 seconds is advertised.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4095
+	RestartTimeSeconds int32 `json:"restartTimeSeconds,omitempty"`
 }
 
 // CiliumBGPVirtualRouter defines a discrete BGP virtual router configuration.
@@ -95,6 +119,14 @@ type CiliumBGPVirtualRouter struct {
 	//
 	// +kubebuilder:validation:Optional
 	ExportPodCIDR bool `json:"exportPodCIDR"`
+	// NextHopSelf instructs this virtual router to advertise the node's own
+	// address as the next hop of exported routes, instead of letting the BGP
+	// speaker resolve a next hop from the peering subnet. This is useful for
+	// peerings where the auto-resolved next hop is not reachable from the
+	// peer.
+	//
+	// +kubebuilder:validation:Optional
+	NextHopSelf bool `json:"nextHopSelf,omitempty"`
 	// Neighbors is a list of neighboring BGP peers for this virtual router
 	//
 	// +kubebuilder:validation:Required
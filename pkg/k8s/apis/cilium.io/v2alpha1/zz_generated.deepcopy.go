@@ -104,6 +104,7 @@ func (in *CiliumBGPLoadBalancerIPPoolSpec) DeepCopy() *CiliumBGPLoadBalancerIPPo
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CiliumBGPNeighbor) DeepCopyInto(out *CiliumBGPNeighbor) {
 	*out = *in
+	out.GracefulRestart = in.GracefulRestart
 	return
 }
 
@@ -117,6 +118,22 @@ func (in *CiliumBGPNeighbor) DeepCopy() *CiliumBGPNeighbor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumBGPNeighborGracefulRestart) DeepCopyInto(out *CiliumBGPNeighborGracefulRestart) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumBGPNeighborGracefulRestart.
+func (in *CiliumBGPNeighborGracefulRestart) DeepCopy() *CiliumBGPNeighborGracefulRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumBGPNeighborGracefulRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CiliumBGPPeeringPolicy) DeepCopyInto(out *CiliumBGPPeeringPolicy) {
 	*out = *in
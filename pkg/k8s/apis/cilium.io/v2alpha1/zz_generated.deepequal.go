@@ -68,6 +68,26 @@ func (in *CiliumBGPNeighbor) DeepEqual(other *CiliumBGPNeighbor) bool {
 	if in.PeerASN != other.PeerASN {
 		return false
 	}
+	if in.GracefulRestart != other.GracefulRestart {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *CiliumBGPNeighborGracefulRestart) DeepEqual(other *CiliumBGPNeighborGracefulRestart) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Enabled != other.Enabled {
+		return false
+	}
+	if in.RestartTimeSeconds != other.RestartTimeSeconds {
+		return false
+	}
 
 	return true
 }
@@ -134,6 +154,9 @@ func (in *CiliumBGPVirtualRouter) DeepEqual(other *CiliumBGPVirtualRouter) bool
 	if in.ExportPodCIDR != other.ExportPodCIDR {
 		return false
 	}
+	if in.NextHopSelf != other.NextHopSelf {
+		return false
+	}
 	if ((in.Neighbors != nil) && (other.Neighbors != nil)) || ((in.Neighbors == nil) != (other.Neighbors == nil)) {
 		in, other := &in.Neighbors, &other.Neighbors
 		if other == nil {
@@ -88,6 +88,7 @@ func (in *Endpoints) DeepCopy() *Endpoints {
 type Backend struct {
 	Ports         serviceStore.PortConfiguration
 	NodeName      string
+	Zone          string
 	Terminating   bool
 	HintsForZones []string
 	Preferred     bool
@@ -218,6 +219,9 @@ func ParseEndpointSliceV1Beta1(ep *slim_discovery_v1beta1.EndpointSlice) (Endpoi
 				if nodeName, ok := sub.Topology["kubernetes.io/hostname"]; ok {
 					backend.NodeName = nodeName
 				}
+				if zone, ok := sub.Topology[LabelTopologyZone]; ok {
+					backend.Zone = zone
+				}
 				if option.Config.EnableK8sTerminatingEndpoint {
 					if sub.Conditions.Terminating != nil && *sub.Conditions.Terminating {
 						backend.Terminating = true
@@ -306,6 +310,11 @@ func ParseEndpointSliceV1(ep *slim_discovery_v1.EndpointSlice) (EndpointSliceID,
 						backend.NodeName = nodeName
 					}
 				}
+				if sub.Zone != nil {
+					backend.Zone = *sub.Zone
+				} else if zone, ok := sub.DeprecatedTopology[LabelTopologyZone]; ok {
+					backend.Zone = zone
+				}
 				if option.Config.EnableK8sTerminatingEndpoint {
 					if sub.Conditions.Terminating != nil && *sub.Conditions.Terminating {
 						backend.Terminating = true
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package events emits Kubernetes events for significant agent conditions,
+// such as a failing policy map update or identity allocation, attached to
+// the Kubernetes object the condition relates to. Events are deduplicated
+// and rate limited before they are sent to the apiserver, so that a
+// persistent condition does not flood the cluster event log.
+package events
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+
+	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "k8s-events")
+
+const (
+	// component is the event source reported to Kubernetes.
+	component = "cilium-agent"
+
+	// eventCooldown is the minimum interval between two events with the
+	// same object, reason and message. A condition which persists across
+	// retries is reported again at most once per cooldown period.
+	eventCooldown = 5 * time.Minute
+
+	// eventQPS and eventBurst rate limit the overall number of events
+	// sent to the apiserver, across all objects and reasons.
+	eventQPS   = 1.0
+	eventBurst = 10
+)
+
+// Emitter sends deduplicated and rate limited events to Kubernetes.
+type Emitter struct {
+	recorder    record.EventRecorder
+	rateLimiter flowcontrol.RateLimiter
+
+	mutex       lock.Mutex
+	lastEmitted map[string]time.Time
+}
+
+var (
+	emitterMutex   lock.RWMutex
+	defaultEmitter *Emitter
+)
+
+// newEmitter returns an Emitter sending events via the given recorder.
+func newEmitter(recorder record.EventRecorder) *Emitter {
+	return &Emitter{
+		recorder:    recorder,
+		rateLimiter: flowcontrol.NewTokenBucketRateLimiter(eventQPS, eventBurst),
+		lastEmitted: map[string]time.Time{},
+	}
+}
+
+// Init configures the default emitter to send events via the given clientset.
+// Until Init is called, emitted events are silently discarded.
+func Init(clientset k8sClient.Clientset) {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: clientset.CoreV1().Events(""),
+	})
+	recorder := broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: component})
+
+	emitterMutex.Lock()
+	defaultEmitter = newEmitter(recorder)
+	emitterMutex.Unlock()
+}
+
+// Warning emits a warning event with the given reason and message, attached
+// to the referenced object. Events repeated within the cooldown period or
+// exceeding the overall rate limit are dropped.
+func Warning(ref *corev1.ObjectReference, reason, message string) {
+	emitterMutex.RLock()
+	emitter := defaultEmitter
+	emitterMutex.RUnlock()
+
+	if emitter == nil {
+		return
+	}
+	emitter.warning(ref, reason, message)
+}
+
+func (e *Emitter) warning(ref *corev1.ObjectReference, reason, message string) {
+	key := ref.Kind + "/" + ref.Namespace + "/" + ref.Name + "/" + reason + "/" + message
+	now := time.Now()
+
+	e.mutex.Lock()
+	if last, ok := e.lastEmitted[key]; ok && now.Sub(last) < eventCooldown {
+		e.mutex.Unlock()
+		return
+	}
+	e.gcLastEmitted(now)
+	e.lastEmitted[key] = now
+	e.mutex.Unlock()
+
+	if !e.rateLimiter.TryAccept() {
+		log.WithFields(logrus.Fields{
+			logfields.Object: ref.Name,
+			logfields.Reason: reason,
+		}).Debug("Dropping Kubernetes event due to rate limit")
+		return
+	}
+
+	e.recorder.Event(ref, corev1.EventTypeWarning, reason, message)
+}
+
+// gcLastEmitted drops expired entries from the deduplication cache to bound
+// its growth. Must be called with e.mutex held.
+func (e *Emitter) gcLastEmitted(now time.Time) {
+	for key, last := range e.lastEmitted {
+		if now.Sub(last) >= eventCooldown {
+			delete(e.lastEmitted, key)
+		}
+	}
+}
+
+// PodRef returns a reference to the pod with the given namespace and name.
+func PodRef(namespace, name string) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		Kind:      "Pod",
+		Namespace: namespace,
+		Name:      name,
+	}
+}
+
+// NodeRef returns a reference to the node with the given name.
+func NodeRef(name string) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		Kind: "Node",
+		Name: name,
+	}
+}
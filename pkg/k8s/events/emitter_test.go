@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package events
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/client-go/tools/record"
+)
+
+func TestWarningDeduplication(t *testing.T) {
+	recorder := record.NewFakeRecorder(16)
+	e := newEmitter(recorder)
+
+	e.warning(PodRef("kube-system", "foo"), "PolicyMapUpdateFailed", "full")
+	// Identical event within the cooldown period must be dropped.
+	e.warning(PodRef("kube-system", "foo"), "PolicyMapUpdateFailed", "full")
+	// Different message, object or reason must still be emitted.
+	e.warning(PodRef("kube-system", "foo"), "PolicyMapUpdateFailed", "other")
+	e.warning(PodRef("kube-system", "bar"), "PolicyMapUpdateFailed", "full")
+	e.warning(NodeRef("node1"), "DatapathLoadFailed", "full")
+
+	if n := len(recorder.Events); n != 4 {
+		t.Fatalf("expected 4 events to be emitted, got %d", n)
+	}
+}
+
+func TestWarningRateLimit(t *testing.T) {
+	recorder := record.NewFakeRecorder(4 * eventBurst)
+	e := newEmitter(recorder)
+
+	for i := 0; i < 4*eventBurst; i++ {
+		e.warning(PodRef("default", fmt.Sprintf("pod-%d", i)), "Reason", "msg")
+	}
+
+	// The token bucket may refill slightly while the loop runs, but the
+	// vast majority of the events must have been dropped.
+	if n := len(recorder.Events); n < 1 || n > 2*eventBurst {
+		t.Fatalf("expected roughly %d events to pass the rate limit, got %d", eventBurst, n)
+	}
+}
@@ -120,6 +120,12 @@ func GetPodMetadata(k8sNs *slim_corev1.Namespace, pod *slim_corev1.Pod) (contain
 
 	k8sLabels[k8sConst.PolicyLabelCluster] = option.Config.ClusterName
 
+	if option.Config.EnableMultiNetwork {
+		for _, network := range getPodNetworkAttachments(annotations) {
+			k8sLabels[policy.JoinPath(k8sConst.PolicyLabelNetworkAttachment, network)] = "true"
+		}
+	}
+
 	for _, containers := range pod.Spec.Containers {
 		for _, cp := range containers.Ports {
 			containerPorts = append(containerPorts, cp)
@@ -129,6 +135,38 @@ func GetPodMetadata(k8sNs *slim_corev1.Namespace, pod *slim_corev1.Pod) (contain
 	return containerPorts, k8sLabels, annotations, nil
 }
 
+// getPodNetworkAttachments parses the secondary network attachments requested
+// via the networks annotation. Only the plain comma-separated form of the
+// annotation is supported; entries may be namespaced ("ns/net") and carry an
+// interface suffix ("net@eth1").
+func getPodNetworkAttachments(annotations map[string]string) []string {
+	v, ok := annotations[k8sConst.PodNetworksAnnotation]
+	if !ok {
+		return nil
+	}
+	if strings.HasPrefix(strings.TrimSpace(v), "[") {
+		// The JSON form of the annotation is not supported yet.
+		log.WithField(logfields.Annotations, k8sConst.PodNetworksAnnotation).
+			Debug("Ignoring networks annotation in JSON form")
+		return nil
+	}
+
+	var networks []string
+	for _, entry := range strings.Split(v, ",") {
+		name := strings.TrimSpace(entry)
+		if i := strings.Index(name, "@"); i >= 0 {
+			name = name[:i]
+		}
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+		if name != "" {
+			networks = append(networks, name)
+		}
+	}
+	return networks
+}
+
 // filterPodLabels returns a copy of the given labels map, without the labels owned by Cilium.
 func filterPodLabels(labels map[string]string) map[string]string {
 	res := map[string]string{}
@@ -185,3 +185,45 @@ func Test_filterPodLabels(t *testing.T) {
 		})
 	}
 }
+
+func Test_getPodNetworkAttachments(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []string
+	}{
+		{
+			name:        "no annotation",
+			annotations: map[string]string{},
+			want:        nil,
+		},
+		{
+			name: "single network",
+			annotations: map[string]string{
+				"k8s.v1.cni.cncf.io/networks": "macvlan-net",
+			},
+			want: []string{"macvlan-net"},
+		},
+		{
+			name: "multiple networks with namespace and interface",
+			annotations: map[string]string{
+				"k8s.v1.cni.cncf.io/networks": "other-ns/sriov-net@eth1, macvlan-net",
+			},
+			want: []string{"sriov-net", "macvlan-net"},
+		},
+		{
+			name: "JSON form is not supported",
+			annotations: map[string]string{
+				"k8s.v1.cni.cncf.io/networks": `[{"name": "macvlan-net"}]`,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getPodNetworkAttachments(tt.annotations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getPodNetworkAttachments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
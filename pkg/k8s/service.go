@@ -66,6 +66,45 @@ func getAnnotationTopologyAwareHints(svc *slim_corev1.Service) bool {
 	return false
 }
 
+func getAnnotationNoHostNSAccess(svc *slim_corev1.Service) bool {
+	if value, ok := svc.ObjectMeta.Annotations[annotation.NoHostNSAccess]; ok {
+		return strings.ToLower(value) == "true"
+	}
+
+	return false
+}
+
+func getAnnotationPreserveClientIdentity(svc *slim_corev1.Service) bool {
+	if value, ok := svc.ObjectMeta.Annotations[annotation.PreserveClientIdentity]; ok {
+		return strings.ToLower(value) == "true"
+	}
+
+	return false
+}
+
+func getAnnotationLBAlgorithm(svc *slim_corev1.Service) loadbalancer.SVCLoadBalancingAlgorithm {
+	value, ok := svc.ObjectMeta.Annotations[annotation.LBAlgorithm]
+	if !ok {
+		return loadbalancer.SVCLoadBalancingAlgorithmNone
+	}
+	switch alg := loadbalancer.SVCLoadBalancingAlgorithm(strings.ToLower(value)); alg {
+	case loadbalancer.SVCLoadBalancingAlgorithmRandom,
+		loadbalancer.SVCLoadBalancingAlgorithmMaglev:
+		return alg
+	default:
+		log.WithFields(logrus.Fields{
+			logfields.K8sSvcName:   svc.ObjectMeta.Name,
+			logfields.K8sNamespace: svc.ObjectMeta.Namespace,
+		}).Warnf("Ignoring unsupported value %q of annotation %s",
+			value, annotation.LBAlgorithm)
+		return loadbalancer.SVCLoadBalancingAlgorithmNone
+	}
+}
+
+func getAnnotationHealthCheckPath(svc *slim_corev1.Service) string {
+	return svc.ObjectMeta.Annotations[annotation.HealthCheckPath]
+}
+
 // isValidServiceFrontendIP returns true if the provided service frontend IP address type
 // is supported in cilium configuration.
 func isValidServiceFrontendIP(netIP net.IP) bool {
@@ -235,6 +274,10 @@ func ParseService(svc *slim_corev1.Service, nodeAddressing types.NodeAddressing)
 	}
 
 	svcInfo.TopologyAware = getAnnotationTopologyAwareHints(svc)
+	svcInfo.NoHostNSAccess = getAnnotationNoHostNSAccess(svc)
+	svcInfo.PreserveClientIdentity = getAnnotationPreserveClientIdentity(svc)
+	svcInfo.LoadBalancerAlgorithm = getAnnotationLBAlgorithm(svc)
+	svcInfo.HealthCheckPath = getAnnotationHealthCheckPath(svc)
 
 	return svcID, svcInfo
 }
@@ -358,6 +401,25 @@ type Service struct {
 	// TopologyAware denotes whether service endpoints might have topology aware
 	// hints
 	TopologyAware bool
+
+	// NoHostNSAccess denotes whether the ClusterIP of the service must not
+	// be reachable from the host network namespace via the socket-based
+	// load balancer.
+	NoHostNSAccess bool
+
+	// PreserveClientIdentity denotes whether intra-cluster traffic to the
+	// NodePort or LoadBalancer IPs of the service keeps the client's source
+	// address instead of being SNATed to the node address.
+	PreserveClientIdentity bool
+
+	// LoadBalancerAlgorithm is the per-service backend selection algorithm,
+	// overriding the global --node-port-algorithm setting.
+	LoadBalancerAlgorithm loadbalancer.SVCLoadBalancingAlgorithm
+
+	// HealthCheckPath is the HTTP path on which the healthcheck nodePort
+	// server responds for this service. If empty, the server responds on
+	// all paths.
+	HealthCheckPath string
 }
 
 // DeepEqual returns true if both the receiver and 'o' are deeply equal.
@@ -5,6 +5,7 @@ package k8s
 
 import (
 	"net"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/sirupsen/logrus"
@@ -19,6 +20,7 @@ import (
 	"github.com/cilium/cilium/pkg/loadbalancer"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
 	serviceStore "github.com/cilium/cilium/pkg/service/store"
 )
@@ -70,6 +72,11 @@ type ServiceEvent struct {
 	// SWG provides a mechanism to detect if a service was synchronized with
 	// the datapath.
 	SWG *lock.StoppableWaitGroup
+
+	// ReceivedAt is the time the Kubernetes update triggering this event
+	// was received. It is used to measure the delay until the service is
+	// programmed in the datapath.
+	ReceivedAt time.Time
 }
 
 // ServiceCache is a list of services correlated with the matching endpoints.
@@ -212,6 +219,7 @@ func (s *ServiceCache) UpdateService(k8sSvc *slim_corev1.Service, swg *lock.Stop
 		swg.Add()
 		s.Events <- ServiceEvent{
 			Action:     UpdateService,
+			ReceivedAt: time.Now(),
 			ID:         svcID,
 			Service:    newService,
 			OldService: oldService,
@@ -231,6 +239,7 @@ func (s *ServiceCache) EnsureService(svcID ServiceID, swg *lock.StoppableWaitGro
 			swg.Add()
 			s.Events <- ServiceEvent{
 				Action:     UpdateService,
+				ReceivedAt: time.Now(),
 				ID:         svcID,
 				Service:    svc,
 				OldService: svc,
@@ -258,11 +267,12 @@ func (s *ServiceCache) DeleteService(k8sSvc *slim_corev1.Service, swg *lock.Stop
 	if serviceOK {
 		swg.Add()
 		s.Events <- ServiceEvent{
-			Action:    DeleteService,
-			ID:        svcID,
-			Service:   oldService,
-			Endpoints: endpoints,
-			SWG:       swg,
+			Action:     DeleteService,
+			ReceivedAt: time.Now(),
+			ID:         svcID,
+			Service:    oldService,
+			Endpoints:  endpoints,
+			SWG:        swg,
 		}
 	}
 }
@@ -289,11 +299,12 @@ func (s *ServiceCache) updateEndpoints(esID EndpointSliceID, newEndpoints *Endpo
 	if ok && serviceReady {
 		swg.Add()
 		s.Events <- ServiceEvent{
-			Action:    UpdateService,
-			ID:        esID.ServiceID,
-			Service:   svc,
-			Endpoints: endpoints,
-			SWG:       swg,
+			Action:     UpdateService,
+			ReceivedAt: time.Now(),
+			ID:         esID.ServiceID,
+			Service:    svc,
+			Endpoints:  endpoints,
+			SWG:        swg,
 		}
 	}
 
@@ -339,11 +350,12 @@ func (s *ServiceCache) deleteEndpoints(svcID EndpointSliceID, swg *lock.Stoppabl
 	if serviceOK {
 		swg.Add()
 		event := ServiceEvent{
-			Action:    UpdateService,
-			ID:        svcID.ServiceID,
-			Service:   svc,
-			Endpoints: endpoints,
-			SWG:       swg,
+			Action:     UpdateService,
+			ReceivedAt: time.Now(),
+			ID:         svcID.ServiceID,
+			Service:    svc,
+			Endpoints:  endpoints,
+			SWG:        swg,
 		}
 
 		s.Events <- event
@@ -471,6 +483,14 @@ func (s *ServiceCache) filterEndpoints(localEndpoints *Endpoints, svc *Service)
 		return localEndpoints
 	}
 
+	if threshold := option.Config.ServiceTopologySpilloverThreshold; threshold > 0 &&
+		float64(len(filteredEndpoints.Backends)) < threshold*float64(len(localEndpoints.Backends)) {
+		// Too few backends remain in the local zone. Spill over to all
+		// zones to avoid overloading the local backends.
+		metrics.ServiceTopologySpillover.Inc()
+		return localEndpoints
+	}
+
 	return filteredEndpoints
 }
 
@@ -577,6 +597,7 @@ func (s *ServiceCache) mergeServiceUpdateLocked(service *serviceStore.ClusterSer
 		swg.Add()
 		s.Events <- ServiceEvent{
 			Action:     UpdateService,
+			ReceivedAt: time.Now(),
 			ID:         id,
 			Service:    svc,
 			OldService: oldService,
@@ -617,11 +638,12 @@ func (s *ServiceCache) MergeExternalServiceDelete(service *serviceStore.ClusterS
 		if ok && svc.Shared {
 			swg.Add()
 			event := ServiceEvent{
-				Action:    UpdateService,
-				ID:        id,
-				Service:   svc,
-				Endpoints: endpoints,
-				SWG:       swg,
+				Action:     UpdateService,
+				ReceivedAt: time.Now(),
+				ID:         id,
+				Service:    svc,
+				Endpoints:  endpoints,
+				SWG:        swg,
 			}
 
 			if !serviceReady {
@@ -679,11 +701,12 @@ func (s *ServiceCache) MergeClusterServiceDelete(service *serviceStore.ClusterSe
 	if ok {
 		swg.Add()
 		s.Events <- ServiceEvent{
-			Action:    DeleteService,
-			ID:        id,
-			Service:   svc,
-			Endpoints: endpoints,
-			SWG:       swg,
+			Action:     DeleteService,
+			ReceivedAt: time.Now(),
+			ID:         id,
+			Service:    svc,
+			Endpoints:  endpoints,
+			SWG:        swg,
 		}
 	}
 }
@@ -746,6 +769,7 @@ func (s *ServiceCache) updateSelfNodeLabels(labels map[string]string,
 			swg.Add()
 			s.Events <- ServiceEvent{
 				Action:     UpdateService,
+				ReceivedAt: time.Now(),
 				ID:         id,
 				Service:    svc,
 				OldService: svc,
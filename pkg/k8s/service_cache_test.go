@@ -1059,3 +1059,39 @@ func (s *K8sSuite) TestServiceEndpointFiltering(c *check.C) {
 		return true
 	}, 2*time.Second), check.IsNil)
 }
+
+func (s *K8sSuite) TestServiceEndpointFilteringSpillover(c *check.C) {
+	oldOptionEnableServiceTopology := option.Config.EnableServiceTopology
+	oldOptionSpilloverThreshold := option.Config.ServiceTopologySpilloverThreshold
+	defer func() {
+		option.Config.EnableServiceTopology = oldOptionEnableServiceTopology
+		option.Config.ServiceTopologySpilloverThreshold = oldOptionSpilloverThreshold
+	}()
+	option.Config.EnableServiceTopology = true
+
+	svcCache := NewServiceCache(fakeDatapath.NewNodeAddressing())
+	svcCache.selfNodeZoneLabel = "test-zone-1"
+
+	svc := &Service{TopologyAware: true}
+	eps := &Endpoints{Backends: map[cmtypes.AddrCluster]*Backend{
+		cmtypes.MustParseAddrCluster("10.0.0.1"): {HintsForZones: []string{"test-zone-1"}},
+		cmtypes.MustParseAddrCluster("10.0.0.2"): {HintsForZones: []string{"test-zone-2"}},
+		cmtypes.MustParseAddrCluster("10.0.0.3"): {HintsForZones: []string{"test-zone-2"}},
+	}}
+
+	// Without a threshold only the zone-local backend is selected.
+	option.Config.ServiceTopologySpilloverThreshold = 0
+	filtered := svcCache.filterEndpoints(eps, svc)
+	c.Assert(len(filtered.Backends), check.Equals, 1)
+
+	// One out of three backends is below the threshold, so traffic spills
+	// over to all zones.
+	option.Config.ServiceTopologySpilloverThreshold = 0.5
+	filtered = svcCache.filterEndpoints(eps, svc)
+	c.Assert(len(filtered.Backends), check.Equals, 3)
+
+	// With a lower threshold the zone filtering applies again.
+	option.Config.ServiceTopologySpilloverThreshold = 0.3
+	filtered = svcCache.filterEndpoints(eps, svc)
+	c.Assert(len(filtered.Backends), check.Equals, 1)
+}
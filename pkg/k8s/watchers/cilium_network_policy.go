@@ -6,11 +6,15 @@ package watchers
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/cilium/cilium/pkg/annotation"
 	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/fqdn/dnsproxy"
 	"github.com/cilium/cilium/pkg/k8s"
 	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
 	"github.com/cilium/cilium/pkg/k8s/client"
@@ -81,6 +85,86 @@ func (r *ruleImportMetadataCache) get(cnp *types.SlimCNP) (policyImportMetadata,
 	return policyImportMeta, ok
 }
 
+// cnpExpiryCheckInterval is the interval at which the expiry controller of a
+// CiliumNetworkPolicy carrying the expires-at annotation re-evaluates whether
+// the expiry time has passed.
+const cnpExpiryCheckInterval = time.Minute
+
+// cnpExpiryControllerName returns the name of the expiry controller of the
+// given CiliumNetworkPolicy.
+func cnpExpiryControllerName(cnp *types.SlimCNP) string {
+	return fmt.Sprintf("cnp-expiry (%v %v/%v)", cnp.TypeMeta.Kind, cnp.ObjectMeta.Namespace, cnp.ObjectMeta.Name)
+}
+
+// cnpExpiryTime returns the expiry time of the given CiliumNetworkPolicy as
+// set via the expires-at annotation, if any.
+func cnpExpiryTime(cnp *types.SlimCNP) (time.Time, bool, error) {
+	v, ok := cnp.ObjectMeta.Annotations[annotation.PolicyExpiresAt]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	expiry, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("unable to parse annotation %s=%q: %w", annotation.PolicyExpiresAt, v, err)
+	}
+	return expiry, true, nil
+}
+
+// updateCNPDNSProxyUpstream installs or removes the per-namespace upstream
+// resolver override of the DNS proxy carried by the policy's
+// dns.proxy-upstream annotation. Clusterwide policies have no namespace and
+// cannot carry the override.
+func updateCNPDNSProxyUpstream(scopedLog *logrus.Entry, cnp *types.SlimCNP) {
+	ns := cnp.ObjectMeta.Namespace
+	if ns == "" {
+		return
+	}
+
+	if addr, ok := cnp.ObjectMeta.Annotations[annotation.DNSProxyUpstream]; ok {
+		if err := dnsproxy.SetNamespaceUpstream(ns, cnp.ObjectMeta.Name, addr); err != nil {
+			scopedLog.WithError(err).Warn("Invalid DNS proxy upstream annotation, ignoring it")
+		}
+	} else {
+		// The annotation may have been removed on update.
+		dnsproxy.RemoveNamespaceUpstream(ns, cnp.ObjectMeta.Name)
+	}
+}
+
+// expireCiliumNetworkPolicyV2 treats the given CiliumNetworkPolicy as absent
+// by removing its rules from the policy repository, emitting a warning.
+func (k *K8sWatcher) expireCiliumNetworkPolicyV2(cnp *types.SlimCNP, expiry time.Time) {
+	log.WithFields(logrus.Fields{
+		logfields.CiliumNetworkPolicyName: cnp.ObjectMeta.Name,
+		logfields.K8sAPIVersion:           cnp.TypeMeta.APIVersion,
+		logfields.K8sNamespace:            cnp.ObjectMeta.Namespace,
+		"expiresAt":                       expiry,
+	}).Warn("CiliumNetworkPolicy has expired, treating the policy as absent")
+
+	if _, err := k.policyManager.PolicyDelete(cnp.GetIdentityLabels()); err != nil {
+		log.WithError(err).Debug("Unable to remove rules of expired CiliumNetworkPolicy")
+	}
+}
+
+// runCNPExpiryController spawns a controller which removes the rules of the
+// given CiliumNetworkPolicy once its expiry time has passed.
+func (k *K8sWatcher) runCNPExpiryController(cnp *types.SlimCNP, expiry time.Time) {
+	ctrlName := cnpExpiryControllerName(cnp)
+	k8sCM.UpdateController(ctrlName,
+		controller.ControllerParams{
+			RunInterval: cnpExpiryCheckInterval,
+			DoFunc: func(ctx context.Context) error {
+				if time.Now().Before(expiry) {
+					return nil
+				}
+				k.expireCiliumNetworkPolicyV2(cnp, expiry)
+				// The policy is gone from the repository; the
+				// controller is no longer needed.
+				return k8sCM.RemoveController(ctrlName)
+			},
+		},
+	)
+}
+
 func (k *K8sWatcher) ciliumNetworkPoliciesInit(cs client.Clientset) {
 	apiGroup := k8sAPIGroupCiliumNetworkPolicyV2
 	_, ciliumV2Controller := informer.NewInformer(
@@ -162,6 +246,24 @@ func (k *K8sWatcher) addCiliumNetworkPolicyV2(ciliumNPClient clientset.Interface
 
 	scopedLog.Debug("Adding CiliumNetworkPolicy")
 
+	updateCNPDNSProxyUpstream(scopedLog, cnp)
+
+	if expiry, hasExpiry, err := cnpExpiryTime(cnp); err != nil {
+		scopedLog.WithError(err).Warn("Invalid policy expiry annotation, ignoring it")
+	} else if hasExpiry {
+		if !time.Now().Before(expiry) {
+			k.expireCiliumNetworkPolicyV2(cnp, expiry)
+			importMetadataCache.upsert(cnp, 0, nil)
+			return nil
+		}
+		k.runCNPExpiryController(cnp, expiry)
+	} else {
+		// The annotation may have been removed on update.
+		if err := k8sCM.RemoveController(cnpExpiryControllerName(cnp)); err != nil {
+			scopedLog.WithError(err).Debug("Unable to remove expiry controller")
+		}
+	}
+
 	var rev uint64
 
 	rules, policyImportErr := cnp.Parse()
@@ -220,6 +322,10 @@ func (k *K8sWatcher) deleteCiliumNetworkPolicyV2(cnp *types.SlimCNP) error {
 
 	scopedLog.Debug("Deleting CiliumNetworkPolicy")
 
+	if ns := cnp.ObjectMeta.Namespace; ns != "" {
+		dnsproxy.RemoveNamespaceUpstream(ns, cnp.ObjectMeta.Name)
+	}
+
 	importMetadataCache.delete(cnp)
 	ctrlName := cnp.GetControllerName()
 	err := k8sCM.RemoveControllerAndWait(ctrlName)
@@ -227,6 +333,11 @@ func (k *K8sWatcher) deleteCiliumNetworkPolicyV2(cnp *types.SlimCNP) error {
 		log.WithError(err).Debugf("Unable to remove controller %s", ctrlName)
 	}
 
+	expiryCtrlName := cnpExpiryControllerName(cnp)
+	if err := k8sCM.RemoveController(expiryCtrlName); err != nil {
+		log.WithError(err).Debugf("Unable to remove controller %s", expiryCtrlName)
+	}
+
 	_, err = k.policyManager.PolicyDelete(cnp.GetIdentityLabels())
 	if err == nil {
 		scopedLog.Info("Deleted CiliumNetworkPolicy")
@@ -317,6 +428,8 @@ func (k *K8sWatcher) updateCiliumNetworkPolicyV2AnnotationsOnly(ciliumNPClient c
 
 	scopedLog.Info("updating node status due to annotations-only change to CiliumNetworkPolicy")
 
+	updateCNPDNSProxyUpstream(scopedLog, cnp)
+
 	ctrlName := cnp.GetControllerName()
 
 	// Revision will *always* be populated because importMetadataCache is guaranteed
@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/sirupsen/logrus"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -22,8 +23,10 @@ import (
 	"github.com/cilium/cilium/pkg/k8s/watchers/subscriber"
 	"github.com/cilium/cilium/pkg/kvstore"
 	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging/logfields"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/policy"
 )
 
 // RegisterCiliumNodeSubscriber allows registration of subscriber.CiliumNode implementations.
@@ -56,6 +59,7 @@ func (k *K8sWatcher) ciliumNodeInit(ciliumNPClient client.Clientset, asyncContro
 							k.egressGatewayManager.OnUpdateNode(n)
 						}
 						if n.IsLocal() {
+							k.reconcilePolicyEnforcement(ciliumNode)
 							return
 						}
 						k.nodeDiscoverManager.NodeUpdated(n)
@@ -87,6 +91,7 @@ func (k *K8sWatcher) ciliumNodeInit(ciliumNPClient client.Clientset, asyncContro
 								k.egressGatewayManager.OnUpdateNode(n)
 							}
 							if isLocal {
+								k.reconcilePolicyEnforcement(ciliumNode)
 								return
 							}
 							k.nodeDiscoverManager.NodeUpdated(n)
@@ -110,6 +115,11 @@ func (k *K8sWatcher) ciliumNodeInit(ciliumNPClient client.Clientset, asyncContro
 					if errs != nil {
 						valid = false
 					}
+					if n.IsLocal() {
+						// The override is gone with the object, fall back to
+						// the configured enforcement mode.
+						k.reconcilePolicyEnforcement(&cilium_v2.CiliumNode{})
+					}
 					k.nodeDiscoverManager.NodeDeleted(n)
 				},
 			},
@@ -147,6 +157,42 @@ func (k *K8sWatcher) ciliumNodeInit(ciliumNPClient client.Clientset, asyncContro
 	}
 }
 
+// reconcilePolicyEnforcement applies the policy enforcement override from the
+// local node's CiliumNode spec. An empty override falls back to the mode the
+// agent was configured with. When the effective mode changes, endpoint policy
+// is recomputed, allowing e.g. canary nodes to run "always" enforcement while
+// the rest of the cluster stays in "default" mode.
+func (k *K8sWatcher) reconcilePolicyEnforcement(ciliumNode *cilium_v2.CiliumNode) {
+	enforcement := ciliumNode.Spec.PolicyEnforcement
+	if enforcement == "" {
+		enforcement = option.Config.EnablePolicy
+	}
+
+	switch enforcement {
+	case option.DefaultEnforcement, option.AlwaysEnforce, option.NeverEnforce:
+	default:
+		log.WithFields(logrus.Fields{
+			logfields.Node:      ciliumNode.Name,
+			"policyEnforcement": enforcement,
+		}).Warning("Ignoring invalid policy enforcement override in CiliumNode spec")
+		return
+	}
+
+	// Serialize with policy enforcement changes via the config API.
+	option.Config.ConfigPatchMutex.Lock()
+	changed := policy.GetPolicyEnabled() != enforcement
+	if changed {
+		policy.SetPolicyEnabled(enforcement)
+	}
+	option.Config.ConfigPatchMutex.Unlock()
+
+	if changed {
+		log.WithField("policyEnforcement", enforcement).
+			Info("Policy enforcement mode set via CiliumNode spec")
+		k.policyManager.TriggerPolicyUpdates(true, "policy enforcement mode changed via CiliumNode spec")
+	}
+}
+
 // GetCiliumNode returns the CiliumNode "nodeName" from the local store. If the
 // local store is not initialized then it will fallback retrieving the node
 // from kube-apiserver.
@@ -5,6 +5,7 @@ package watchers
 
 import (
 	"net/netip"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	v1meta "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -139,6 +140,32 @@ func (k *K8sWatcher) handleKubeAPIServerServiceEPChanges(desiredIPs map[netip.Pr
 	for ip := range desiredIPs {
 		k.ipcache.UpsertLabels(ip, labels.LabelKubeAPIServer, src, rid)
 	}
+
+	// Track the mapped IPs and their freshness for status reporting.
+	now := time.Now()
+	k.kubeAPIServerIPsMU.Lock()
+	for ip := range k.kubeAPIServerIPs {
+		if _, ok := desiredIPs[ip]; !ok {
+			delete(k.kubeAPIServerIPs, ip)
+		}
+	}
+	for ip := range desiredIPs {
+		k.kubeAPIServerIPs[ip] = now
+	}
+	k.kubeAPIServerIPsMU.Unlock()
+}
+
+// KubeAPIServerIPs returns the IPs currently associated with the reserved
+// kube-apiserver identity, along with the time of their last update.
+func (k *K8sWatcher) KubeAPIServerIPs() map[netip.Prefix]time.Time {
+	k.kubeAPIServerIPsMU.RLock()
+	defer k.kubeAPIServerIPsMU.RUnlock()
+
+	ips := make(map[netip.Prefix]time.Time, len(k.kubeAPIServerIPs))
+	for ip, updated := range k.kubeAPIServerIPs {
+		ips[ip] = updated
+	}
+	return ips
 }
 
 func insertK8sPrefix(desiredIPs map[netip.Prefix]struct{}, addr string, resource ipcacheTypes.ResourceID) {
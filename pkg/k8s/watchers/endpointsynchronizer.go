@@ -71,6 +71,10 @@ func (epSync *EndpointSynchronizer) RunK8sCiliumEndpointSync(e *endpoint.Endpoin
 		return
 	}
 
+	if option.Config.EnablePodNetworkReadinessGate {
+		epSync.RunPodReadinessGateSync(e)
+	}
+
 	var (
 		lastMdl  *cilium_v2.EndpointStatus
 		localCEP *cilium_v2.CiliumEndpoint // the local copy of the CEP object. Reused.
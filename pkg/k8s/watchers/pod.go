@@ -291,7 +291,7 @@ func (k *K8sWatcher) updateK8sPodV1(oldK8sPod, newK8sPod *slim_corev1.Pod) error
 	// Check annotation updates.
 	oldAnno := oldK8sPod.ObjectMeta.Annotations
 	newAnno := newK8sPod.ObjectMeta.Annotations
-	annoChangedProxy := !k8s.AnnotationsEqual([]string{annotation.ProxyVisibility}, oldAnno, newAnno)
+	annoChangedProxy := !k8s.AnnotationsEqual([]string{annotation.ProxyVisibility, annotation.DNSVisibility}, oldAnno, newAnno)
 	annoChangedBandwidth := !k8s.AnnotationsEqual([]string{bandwidth.EgressBandwidth}, oldAnno, newAnno)
 	annoChangedNoTrack := !k8s.AnnotationsEqual([]string{annotation.NoTrack}, oldAnno, newAnno)
 	annotationsChanged := annoChangedProxy || annoChangedBandwidth || annoChangedNoTrack
@@ -357,7 +357,9 @@ func (k *K8sWatcher) updateK8sPodV1(oldK8sPod, newK8sPod *slim_corev1.Pod) error
 				if err != nil {
 					return "", nil
 				}
-				return p.ObjectMeta.Annotations[annotation.ProxyVisibility], nil
+				return policy.MergeVisibilityAnnotations(
+					p.ObjectMeta.Annotations[annotation.ProxyVisibility],
+					p.ObjectMeta.Annotations[annotation.DNSVisibility]), nil
 			})
 		}
 		if annoChangedBandwidth {
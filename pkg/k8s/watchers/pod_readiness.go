@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package watchers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/endpoint"
+)
+
+const (
+	// PodNetworkReadyCondition is the pod condition type managed by the
+	// agent. Pods may list it in their readiness gates to hold off pod
+	// readiness until the network programming for the endpoint is in place
+	// on this node, independently of the kubelet's own checks. The
+	// condition only reflects the local node: whether peer nodes have
+	// already learned about the endpoint's identity via the ipcache is not
+	// taken into account.
+	PodNetworkReadyCondition corev1.PodConditionType = "cilium.io/network-ready"
+
+	// subsysPodReadinessGate is the value for logfields.LogSubsys
+	subsysPodReadinessGate = "pod-readiness-gate"
+)
+
+// podReadinessGateControllerName returns the name of the controller
+// synchronizing the readiness gate condition for the given endpoint ID.
+func podReadinessGateControllerName(epID uint16) string {
+	return fmt.Sprintf("pod-readiness-gate-sync (%v)", epID)
+}
+
+// RunPodReadinessGateSync starts a controller that sets the
+// cilium.io/network-ready condition on the endpoint's pod once the endpoint
+// has reached the ready state, i.e. its policy has been computed and the
+// datapath has been regenerated on the local node. The condition is written
+// at most once per endpoint lifetime.
+func (epSync *EndpointSynchronizer) RunPodReadinessGateSync(e *endpoint.Endpoint) {
+	var (
+		controllerName = podReadinessGateControllerName(e.ID)
+		scopedLog      = e.Logger(subsysPodReadinessGate).WithField("controller", controllerName)
+		patched        bool
+	)
+
+	if !epSync.Clientset.IsEnabled() {
+		scopedLog.Debug("Not starting controller because k8s is disabled")
+		return
+	}
+
+	// NOTE: The controller functions do NOT hold the endpoint locks
+	e.UpdateController(controllerName,
+		controller.ControllerParams{
+			RunInterval: 5 * time.Second,
+			DoFunc: func(ctx context.Context) error {
+				if patched {
+					return nil
+				}
+
+				podName := e.GetK8sPodName()
+				namespace := e.GetK8sNamespace()
+				if podName == "" || namespace == "" {
+					scopedLog.Debug("Skipping pod readiness gate update because the endpoint has no k8s metadata")
+					return nil
+				}
+
+				if e.GetState() != endpoint.StateReady {
+					scopedLog.Debug("Skipping pod readiness gate update because the endpoint is not ready")
+					return nil
+				}
+
+				patch, err := json.Marshal(map[string]interface{}{
+					"status": map[string]interface{}{
+						"conditions": []corev1.PodCondition{
+							{
+								Type:               PodNetworkReadyCondition,
+								Status:             corev1.ConditionTrue,
+								LastTransitionTime: meta_v1.Now(),
+								Reason:             "NetworkProgrammed",
+								Message:            "Endpoint policy has been realized by Cilium on the local node",
+							},
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+
+				_, err = epSync.Clientset.CoreV1().Pods(namespace).Patch(
+					ctx, podName, k8stypes.StrategicMergePatchType, patch,
+					meta_v1.PatchOptions{}, "status")
+				if err != nil {
+					scopedLog.WithError(err).Warning("Unable to update pod readiness gate condition")
+					return err
+				}
+
+				patched = true
+				scopedLog.Debug("Pod readiness gate condition set")
+				return nil
+			},
+		})
+}
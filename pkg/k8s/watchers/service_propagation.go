@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package watchers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/k8s"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/metrics"
+)
+
+// servicePropagationSamples is the number of propagation samples retained per
+// service.
+const servicePropagationSamples = 8
+
+// servicePropagationSample is a single measurement of the time taken from
+// receiving a service or endpoints update from Kubernetes to the service
+// being fully programmed in the LB BPF maps.
+type servicePropagationSample struct {
+	completedAt time.Time
+	delay       time.Duration
+}
+
+// servicePropagation tracks the most recent propagation delays per service so
+// that rollout-related errors can be correlated with dataplane programming
+// lag. It also feeds the service implementation delay metric.
+type servicePropagation struct {
+	lock.Mutex
+	samples map[k8s.ServiceID][]servicePropagationSample
+}
+
+func newServicePropagation() *servicePropagation {
+	return &servicePropagation{
+		samples: map[k8s.ServiceID][]servicePropagationSample{},
+	}
+}
+
+// observe records the propagation delay of an update to the given service
+// that was received from Kubernetes at receivedAt and has now been programmed
+// in the datapath.
+func (p *servicePropagation) observe(id k8s.ServiceID, action string, receivedAt time.Time) {
+	if receivedAt.IsZero() {
+		return
+	}
+	delay := time.Since(receivedAt)
+	metrics.ServiceImplementationDelay.WithLabelValues(action).Observe(delay.Seconds())
+
+	p.Lock()
+	defer p.Unlock()
+	samples := append(p.samples[id], servicePropagationSample{
+		completedAt: time.Now(),
+		delay:       delay,
+	})
+	if len(samples) > servicePropagationSamples {
+		samples = samples[len(samples)-servicePropagationSamples:]
+	}
+	p.samples[id] = samples
+}
+
+// forget drops the retained samples of a deleted service.
+func (p *servicePropagation) forget(id k8s.ServiceID) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.samples, id)
+}
+
+// DebugStatus implements debug.StatusObject, listing the retained samples per
+// service with the most recent sample last.
+func (p *servicePropagation) DebugStatus() string {
+	p.Lock()
+	defer p.Unlock()
+
+	ids := make([]k8s.ServiceID, 0, len(p.samples))
+	for id := range p.samples {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	var sb strings.Builder
+	for _, id := range ids {
+		fmt.Fprintf(&sb, "%s:", id.String())
+		for _, sample := range p.samples[id] {
+			fmt.Fprintf(&sb, " %s@%s", sample.delay.Round(time.Microsecond),
+				sample.completedAt.Format(time.RFC3339))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -222,6 +222,11 @@ type K8sWatcher struct {
 	// K8sSvcCache is a cache of all Kubernetes services and endpoints
 	K8sSvcCache k8s.ServiceCache
 
+	// ServicePropagation tracks the delay between receiving a service or
+	// endpoints update from Kubernetes and the service being programmed in
+	// the LB BPF maps.
+	ServicePropagation *servicePropagation
+
 	// NodeChain is the root of a notification chain for k8s Node events.
 	// This NodeChain allows registration of subscriber.Node implementations.
 	// On k8s Node events all registered subscriber.Node implementations will
@@ -247,6 +252,12 @@ type K8sWatcher struct {
 	envoyConfigManager    envoyConfigManager
 	cgroupManager         cgroupManager
 
+	// kubeAPIServerIPsMU protects kubeAPIServerIPs.
+	kubeAPIServerIPsMU lock.RWMutex
+	// kubeAPIServerIPs maps the IPs currently associated with the
+	// reserved kube-apiserver identity to the time of their last update.
+	kubeAPIServerIPs map[netip.Prefix]time.Time
+
 	// controllersStarted is a channel that is closed when all watchers that do not depend on
 	// local node configuration have been started
 	controllersStarted chan struct{}
@@ -295,12 +306,14 @@ func NewK8sWatcher(
 	return &K8sWatcher{
 		clientset:             clientset,
 		K8sSvcCache:           k8s.NewServiceCache(datapath.LocalNodeAddressing()),
+		ServicePropagation:    newServicePropagation(),
 		endpointManager:       endpointManager,
 		nodeDiscoverManager:   nodeDiscoverManager,
 		policyManager:         policyManager,
 		policyRepository:      policyRepository,
 		svcManager:            svcManager,
 		ipcache:               ipcache,
+		kubeAPIServerIPs:      map[netip.Prefix]time.Time{},
 		controllersStarted:    make(chan struct{}),
 		stop:                  make(chan struct{}),
 		podStoreSet:           make(chan struct{}),
@@ -502,8 +515,18 @@ func (k *K8sWatcher) InitK8sSubsystem(ctx context.Context, cachesSynced chan str
 			return
 		}
 		log.Info("Waiting until all pre-existing resources have been received")
-		if err := k.WaitForCacheSyncWithTimeout(option.Config.K8sSyncTimeout, append(resources, afterNodeInitResources...)...); err != nil {
-			log.WithError(err).Fatal("Timed out waiting for pre-existing resources to be received; exiting")
+		allResources := append(resources, afterNodeInitResources...)
+		if err := k.WaitForCacheSyncWithTimeout(option.Config.K8sSyncTimeout, allResources...); err != nil {
+			if option.Config.K8sSyncTimeoutAction != option.StartupActionContinue {
+				log.WithError(err).Fatal("Timed out waiting for pre-existing resources to be received; exiting")
+			}
+			// Unblock the daemon startup so that restored endpoints keep
+			// being served while the watchers catch up in the background.
+			log.WithError(err).Warning("Timed out waiting for pre-existing resources to be received; continuing with degraded startup")
+			close(cachesSynced)
+			k.WaitForCacheSync(allResources...)
+			log.Info("All pre-existing resources have been received after degraded startup")
+			return
 		}
 		close(cachesSynced)
 	}()
@@ -611,6 +634,8 @@ func (k *K8sWatcher) k8sServiceHandler() {
 		case k8s.UpdateService:
 			if err := k.addK8sSVCs(event.ID, event.OldService, svc, event.Endpoints); err != nil {
 				scopedLog.WithError(err).Error("Unable to add/update service to implement k8s event")
+			} else {
+				k.ServicePropagation.observe(event.ID, "update", event.ReceivedAt)
 			}
 
 			if !svc.IsExternal() {
@@ -637,7 +662,10 @@ func (k *K8sWatcher) k8sServiceHandler() {
 		case k8s.DeleteService:
 			if err := k.delK8sSVCs(event.ID, event.Service, event.Endpoints); err != nil {
 				scopedLog.WithError(err).Error("Unable to delete service to implement k8s event")
+			} else {
+				k.ServicePropagation.observe(event.ID, "delete", event.ReceivedAt)
 			}
+			k.ServicePropagation.forget(event.ID)
 
 			if !svc.IsExternal() {
 				return
@@ -769,6 +797,7 @@ func genCartesianProduct(
 				besValues = append(besValues, &loadbalancer.Backend{
 					FEPortName: string(fePortName),
 					NodeName:   backend.NodeName,
+					Zone:       backend.Zone,
 					L3n4Addr: loadbalancer.L3n4Addr{
 						AddrCluster: addrCluster,
 						L4Addr:      *backendPort,
@@ -870,11 +899,20 @@ func datapathSVCs(svc *k8s.Service, endpoints *k8s.Endpoints) (svcs []loadbalanc
 	for i := range svcs {
 		svcs[i].TrafficPolicy = svc.TrafficPolicy
 		svcs[i].HealthCheckNodePort = svc.HealthCheckNodePort
+		svcs[i].HealthCheckPath = svc.HealthCheckPath
 		svcs[i].SessionAffinity = svc.SessionAffinity
 		svcs[i].SessionAffinityTimeoutSec = svc.SessionAffinityTimeoutSec
 		if svcs[i].Type == loadbalancer.SVCTypeLoadBalancer {
 			svcs[i].LoadBalancerSourceRanges = lbSrcRanges
 		}
+		if svcs[i].Type == loadbalancer.SVCTypeClusterIP {
+			svcs[i].NoHostNSAccess = svc.NoHostNSAccess
+		}
+		if svcs[i].Type == loadbalancer.SVCTypeNodePort ||
+			svcs[i].Type == loadbalancer.SVCTypeLoadBalancer {
+			svcs[i].PreserveClientIdentity = svc.PreserveClientIdentity
+		}
+		svcs[i].LoadBalancerAlgorithm = svc.LoadBalancerAlgorithm
 	}
 
 	return svcs
@@ -934,7 +972,11 @@ func (k *K8sWatcher) addK8sSVCs(svcID k8s.ServiceID, oldSvc, svc *k8s.Service, e
 			SessionAffinity:           dpSvc.SessionAffinity,
 			SessionAffinityTimeoutSec: dpSvc.SessionAffinityTimeoutSec,
 			HealthCheckNodePort:       dpSvc.HealthCheckNodePort,
+			HealthCheckPath:           dpSvc.HealthCheckPath,
 			LoadBalancerSourceRanges:  dpSvc.LoadBalancerSourceRanges,
+			NoHostNSAccess:            dpSvc.NoHostNSAccess,
+			PreserveClientIdentity:    dpSvc.PreserveClientIdentity,
+			LoadBalancerAlgorithm:     dpSvc.LoadBalancerAlgorithm,
 			Name: loadbalancer.ServiceName{
 				Name:      svcID.Name,
 				Namespace: svcID.Namespace,
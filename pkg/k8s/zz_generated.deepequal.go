@@ -39,6 +39,9 @@ func (in *Backend) DeepEqual(other *Backend) bool {
 	if in.NodeName != other.NodeName {
 		return false
 	}
+	if in.Zone != other.Zone {
+		return false
+	}
 	if in.Terminating != other.Terminating {
 		return false
 	}
@@ -291,6 +294,18 @@ func (in *Service) deepEqual(other *Service) bool {
 	if in.TopologyAware != other.TopologyAware {
 		return false
 	}
+	if in.NoHostNSAccess != other.NoHostNSAccess {
+		return false
+	}
+	if in.PreserveClientIdentity != other.PreserveClientIdentity {
+		return false
+	}
+	if in.LoadBalancerAlgorithm != other.LoadBalancerAlgorithm {
+		return false
+	}
+	if in.HealthCheckPath != other.HealthCheckPath {
+		return false
+	}
 
 	return true
 }
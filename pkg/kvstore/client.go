@@ -24,6 +24,9 @@ func initClient(ctx context.Context, module backendModule, opts *ExtraOptions) e
 	c, errChan := module.newClient(ctx, opts)
 	if c == nil {
 		err := <-errChan
+		if option.Config.KVstoreStartupAction == option.StartupActionContinue {
+			return err
+		}
 		scopedLog.WithError(err).Fatal("Unable to create kvstore client")
 	}
 
@@ -38,6 +41,12 @@ func initClient(ctx context.Context, module backendModule, opts *ExtraOptions) e
 	go func() {
 		err, isErr := <-errChan
 		if isErr && err != nil {
+			if option.Config.KVstoreStartupAction == option.StartupActionContinue {
+				// The agent continues in degraded mode, with consumers
+				// of the kvstore blocked until connectivity returns.
+				scopedLog.WithError(err).Error("Unable to connect to kvstore, continuing in degraded mode")
+				return
+			}
 			scopedLog.WithError(err).Fatal("Unable to connect to kvstore")
 		}
 		if !option.Config.JoinCluster {
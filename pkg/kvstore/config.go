@@ -6,8 +6,8 @@ package kvstore
 import (
 	"context"
 	"fmt"
-	"sync"
 
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 )
 
@@ -68,7 +68,10 @@ func getOpts(opts backendOptions) map[string]string {
 }
 
 var (
-	setupOnce sync.Once
+	// setupMutex protects setupDone. Setup may be called again after a
+	// failed attempt, but becomes a no-op once it has succeeded.
+	setupMutex lock.Mutex
+	setupDone  bool
 )
 
 func setup(ctx context.Context, selectedBackend string, opts map[string]string, goOpts *ExtraOptions) error {
@@ -91,13 +94,20 @@ func setup(ctx context.Context, selectedBackend string, opts map[string]string,
 }
 
 // Setup sets up the key-value store specified in kvStore and configures it
-// with the options provided in opts
+// with the options provided in opts. Once a call has succeeded, subsequent
+// calls are no-ops; a failed call may be retried.
 func Setup(ctx context.Context, selectedBackend string, opts map[string]string, goOpts *ExtraOptions) error {
-	var err error
+	setupMutex.Lock()
+	defer setupMutex.Unlock()
 
-	setupOnce.Do(func() {
-		err = setup(ctx, selectedBackend, opts, goOpts)
-	})
+	if setupDone {
+		return nil
+	}
 
-	return err
+	if err := setup(ctx, selectedBackend, opts, goOpts); err != nil {
+		return err
+	}
+
+	setupDone = true
+	return nil
 }
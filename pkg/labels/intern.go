@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package labels
+
+import (
+	"strings"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// internedStrings holds one canonical copy of every label key, value and
+// source string seen so far. The same handful of strings appears in
+// thousands of rules, selectors and endpoint label sets, and sharing a
+// single backing allocation between them noticeably reduces the heap of
+// agents carrying large policy repositories.
+//
+// The pool is never pruned, so only strings with bounded cardinality may be
+// interned. In particular, CIDR labels are excluded by the callers below, as
+// FQDN selectors can produce an unbounded number of unique CIDR strings over
+// the lifetime of the agent.
+var internedStrings = struct {
+	lock.RWMutex
+	strings map[string]string
+}{strings: map[string]string{}}
+
+// Intern returns a canonical copy of s so that duplicates share a single
+// backing allocation. Only strings with bounded cardinality may be interned,
+// see the comment on internedStrings.
+func Intern(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	internedStrings.RLock()
+	canon, ok := internedStrings.strings[s]
+	internedStrings.RUnlock()
+	if ok {
+		return canon
+	}
+
+	internedStrings.Lock()
+	defer internedStrings.Unlock()
+	if canon, ok = internedStrings.strings[s]; ok {
+		return canon
+	}
+	// Clone s so that the pool does not pin a larger buffer s may be
+	// pointing into, e.g. when s was sliced out of a parsed manifest.
+	canon = strings.Clone(s)
+	internedStrings.strings[canon] = canon
+	return canon
+}
+
+// highCardinalityValueKeys lists label keys whose values are generated per
+// workload or per rollout by Kubernetes controllers and therefore have
+// unbounded cardinality over the lifetime of the agent. The values of these
+// keys are not interned; their keys still are, as the keys themselves are a
+// small fixed set.
+var highCardinalityValueKeys = map[string]struct{}{
+	"pod-template-hash":                  {},
+	"pod-template-generation":            {},
+	"controller-revision-hash":           {},
+	"controller-uid":                     {},
+	"job-name":                           {},
+	"batch.kubernetes.io/controller-uid": {},
+	"batch.kubernetes.io/job-name":       {},
+	"statefulset.kubernetes.io/pod-name": {},
+	"apps.kubernetes.io/pod-index":       {},
+}
+
+// intern interns the key, value and source of the label, unless the label is
+// a CIDR label, whose unbounded cardinality would grow the pool forever.
+// Values of keys known to be generated per workload or rollout are excluded
+// for the same reason.
+func (l Label) intern() Label {
+	if l.Source == LabelSourceCIDR {
+		return l
+	}
+	l.Key = Intern(l.Key)
+	if _, ok := highCardinalityValueKeys[l.Key]; !ok {
+		l.Value = Intern(l.Value)
+	}
+	l.Source = Intern(l.Source)
+	return l
+}
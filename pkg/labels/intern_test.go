@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package labels
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *LabelsSuite) TestIntern(c *C) {
+	a := ParseLabel("k8s:app=foo")
+	b := ParseLabel("k8s:app=foo")
+
+	// Duplicate labels must share the same backing strings.
+	c.Assert(a, DeepEquals, b)
+	c.Assert(Intern("k8s"), Equals, a.Source)
+	c.Assert(Intern("app"), Equals, a.Key)
+	c.Assert(Intern("foo"), Equals, a.Value)
+
+	// CIDR labels must not be interned, their cardinality is unbounded.
+	cidr := ParseLabel("cidr:10.0.0.0/8")
+	internedStrings.RLock()
+	_, ok := internedStrings.strings[cidr.Key]
+	internedStrings.RUnlock()
+	c.Assert(ok, Equals, false)
+
+	// Values of controller-generated keys must not be interned, a new
+	// value appears with every rollout. The key itself still is.
+	hash := ParseLabel("k8s:pod-template-hash=5d69475d49")
+	internedStrings.RLock()
+	_, keyOk := internedStrings.strings[hash.Key]
+	_, valueOk := internedStrings.strings[hash.Value]
+	internedStrings.RUnlock()
+	c.Assert(keyOk, Equals, true)
+	c.Assert(valueOk, Equals, false)
+}
+
+// BenchmarkParseLabelInterned parses the same handful of labels over and
+// over, the common shape of a large policy repository. Compare allocations
+// against BenchmarkParseLabel to see the effect of interning.
+func BenchmarkParseLabelInterned(b *testing.B) {
+	strs := []string{
+		"k8s:app=foo",
+		"k8s:io.kubernetes.pod.namespace=default",
+		"k8s:io.cilium.k8s.policy.cluster=default",
+		"any:app=foo",
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, str := range strs {
+			ParseLabel(str)
+		}
+	}
+}
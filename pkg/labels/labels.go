@@ -46,6 +46,10 @@ const (
 	// of the reserved identity 8.
 	IDNameIngress = "ingress"
 
+	// IDNameDNSProxy is the label used to identify upstream DNS traffic
+	// originated by the DNS proxy. It is part of the reserved identity 9.
+	IDNameDNSProxy = "dns-proxy"
+
 	// IDNameNone is the label used to identify no endpoint or other L3 entity.
 	// It will never be assigned and this "label" is here for consistency with
 	// other Entities.
@@ -79,6 +83,10 @@ var (
 	// LabelIngress is the label used for Ingress proxies. See comment
 	// on IDNameIngress.
 	LabelIngress = Labels{IDNameIngress: NewLabel(IDNameIngress, "", LabelSourceReserved)}
+
+	// LabelDNSProxy is the label used for upstream DNS traffic originated
+	// by the DNS proxy. See comment on IDNameDNSProxy.
+	LabelDNSProxy = Labels{IDNameDNSProxy: NewLabel(IDNameDNSProxy, "", LabelSourceReserved)}
 )
 
 const (
@@ -100,6 +108,12 @@ const (
 	// LabelSourceContainer is a label imported from the container runtime
 	LabelSourceContainer = "container"
 
+	// LabelSourceNode is a label imported from the node object
+	LabelSourceNode = "node"
+
+	// LabelSourceNodeKeyPrefix is prefix of a node label
+	LabelSourceNodeKeyPrefix = LabelSourceNode + "."
+
 	// LabelSourceReserved is the label source for reserved types.
 	LabelSourceReserved = "reserved"
 
@@ -226,7 +240,7 @@ func NewLabel(key string, value string, source string) Label {
 		Key:    key,
 		Value:  value,
 		Source: source,
-	}
+	}.intern()
 }
 
 // Equals returns true if source, Key and Value are equal and false otherwise.
@@ -600,7 +614,7 @@ func parseLabel(str string, delim byte) (lbl Label) {
 			lbl.Value = next[i+1:]
 		}
 	}
-	return lbl
+	return lbl.intern()
 }
 
 // ParseSelectLabel returns a selecting label representation of the given
@@ -36,6 +36,15 @@ const (
 	SVCTrafficPolicyLocal   = SVCTrafficPolicy("Local")
 )
 
+// SVCLoadBalancingAlgorithm is the backend selection algorithm of a service
+type SVCLoadBalancingAlgorithm string
+
+const (
+	SVCLoadBalancingAlgorithmNone   = SVCLoadBalancingAlgorithm("")
+	SVCLoadBalancingAlgorithmRandom = SVCLoadBalancingAlgorithm("random")
+	SVCLoadBalancingAlgorithmMaglev = SVCLoadBalancingAlgorithm("maglev")
+)
+
 // SVCNatPolicy defines whether we need NAT46/64 translation for backends
 type SVCNatPolicy string
 
@@ -63,6 +72,8 @@ const (
 	serviceFlagNat46x64        = 1 << 9
 	serviceFlagL7LoadBalancer  = 1 << 10
 	serviceFlagLoopback        = 1 << 11
+	serviceFlagNoHostNS        = 1 << 12
+	serviceFlagPreserveClient  = 1 << 13
 )
 
 type SvcFlagParam struct {
@@ -74,6 +85,8 @@ type SvcFlagParam struct {
 	CheckSourceRange bool
 	L7LoadBalancer   bool
 	LoopbackHostport bool
+	NoHostNSAccess   bool
+	PreserveClient   bool
 }
 
 // NewSvcFlag creates service flag
@@ -118,6 +131,12 @@ func NewSvcFlag(p *SvcFlagParam) ServiceFlags {
 	if p.L7LoadBalancer {
 		flags |= serviceFlagL7LoadBalancer
 	}
+	if p.NoHostNSAccess {
+		flags |= serviceFlagNoHostNS
+	}
+	if p.PreserveClient {
+		flags |= serviceFlagPreserveClient
+	}
 
 	return flags
 }
@@ -189,6 +208,12 @@ func (s ServiceFlags) String() string {
 	if s&serviceFlagLoopback != 0 {
 		str = append(str, "loopback")
 	}
+	if s&serviceFlagNoHostNS != 0 {
+		str = append(str, "no-hostns-access")
+	}
+	if s&serviceFlagPreserveClient != 0 {
+		str = append(str, "preserve-client-identity")
+	}
 
 	return strings.Join(str, ", ")
 }
@@ -342,6 +367,9 @@ type Backend struct {
 	// Node hosting this backend. This is used to determine backends local to
 	// a node.
 	NodeName string
+	// Zone of the node hosting this backend, if known. Used for reporting
+	// per-zone backend counts in the service health server.
+	Zone string
 	L3n4Addr
 	// State of the backend for load-balancing service traffic
 	State BackendState
@@ -363,11 +391,15 @@ type SVC struct {
 	SessionAffinity           bool
 	SessionAffinityTimeoutSec uint32
 	HealthCheckNodePort       uint16      // Service health check node port
+	HealthCheckPath           string      // Service health check HTTP path, all paths if empty
 	Name                      ServiceName // Fully qualified service name
 	LoadBalancerSourceRanges  []*cidr.CIDR
 	L7LBProxyPort             uint16   // Non-zero for L7 LB services
 	L7LBFrontendPorts         []string // Non-zero for L7 LB frontend service ports
 	LoopbackHostport          bool
+	NoHostNSAccess            bool                      // ClusterIP not reachable from the host netns
+	PreserveClientIdentity    bool                      // Keep the client source address for NodePort/LB traffic
+	LoadBalancerAlgorithm     SVCLoadBalancingAlgorithm // Per-service backend selection algorithm
 }
 
 func (s *SVC) GetModel() *models.Service {
@@ -234,6 +234,11 @@ type GCFilter struct {
 	// MatchIPs is the list of IPs to remove from the conntrack table
 	MatchIPs map[netip.Addr]struct{}
 
+	// MatchPeerIPs restricts MatchIPs when non-nil: an entry is only
+	// removed when one address of the connection matches MatchIPs and the
+	// other address matches MatchPeerIPs.
+	MatchPeerIPs map[netip.Addr]struct{}
+
 	// EmitCTEntry is called, when non-nil, if filtering by ValidIPs and MatchIPs
 	// passes. It has no impact on CT GC, but can be used to iterate over valid
 	// CT entries.
@@ -309,6 +314,24 @@ func (m *Map) DumpEntries() (string, error) {
 	return DoDumpEntries(m)
 }
 
+// DumpSnapshotEntries reads the snapshot of m found in dir and writes the
+// values of the ct entries recorded in it to a string. If no snapshot of m
+// is present in dir, an empty string is returned.
+func DumpSnapshotEntries(m *Map, dir string) (string, error) {
+	var sb strings.Builder
+	cb := func(k bpf.MapKey, v bpf.MapValue) {
+		// No need to deep copy as the values are used to create new strings
+		key := k.(CtKey)
+		if !key.ToHost().Dump(&sb, true) {
+			return
+		}
+		value := v.(*CtEntry)
+		sb.WriteString(value.StringWithTimeDiff(nil))
+	}
+	err := m.DumpSnapshotWithCallbackIfExists(dir, cb)
+	return sb.String(), err
+}
+
 // newMap creates a new CT map of the specified type with the specified name.
 func newMap(mapName string, m mapType) *Map {
 	result := &Map{
@@ -514,7 +537,14 @@ func (f *GCFilter) doFiltering(srcIP, dstIP netip.Addr, srcPort, dstPort uint16,
 		_, srcIPExists := f.MatchIPs[srcIP]
 		_, dstIPExists := f.MatchIPs[dstIP]
 		if srcIPExists || dstIPExists {
-			return deleteEntry
+			if f.MatchPeerIPs == nil {
+				return deleteEntry
+			}
+			_, srcPeerExists := f.MatchPeerIPs[srcIP]
+			_, dstPeerExists := f.MatchPeerIPs[dstIP]
+			if (srcIPExists && dstPeerExists) || (dstIPExists && srcPeerExists) {
+				return deleteEntry
+			}
 		}
 	}
 
@@ -4,6 +4,7 @@
 package ctmap
 
 import (
+	"net/netip"
 	"strings"
 	"testing"
 	"time"
@@ -99,3 +100,25 @@ func (t *CTMapTestSuite) TestFilterMapsByProto(c *C) {
 	c.Assert(ctMapTCP, IsNil)
 	c.Assert(ctMapAny, IsNil)
 }
+
+func (t *CTMapTestSuite) TestDoFilteringMatchIPs(c *C) {
+	epIP := netip.MustParseAddr("10.0.0.1")
+	peerIP := netip.MustParseAddr("10.0.0.2")
+	otherIP := netip.MustParseAddr("10.0.0.3")
+	entry := &CtEntry{}
+
+	// MatchIPs alone removes any entry with a matching address.
+	filter := &GCFilter{
+		MatchIPs: map[netip.Addr]struct{}{epIP: {}},
+	}
+	c.Assert(filter.doFiltering(epIP, peerIP, 0, 0, 0, 0, entry), Equals, deleteEntry)
+	c.Assert(filter.doFiltering(otherIP, epIP, 0, 0, 0, 0, entry), Equals, deleteEntry)
+	c.Assert(filter.doFiltering(otherIP, peerIP, 0, 0, 0, 0, entry), Equals, noAction)
+
+	// MatchPeerIPs restricts removal to entries between the two sets.
+	filter.MatchPeerIPs = map[netip.Addr]struct{}{peerIP: {}}
+	c.Assert(filter.doFiltering(epIP, peerIP, 0, 0, 0, 0, entry), Equals, deleteEntry)
+	c.Assert(filter.doFiltering(peerIP, epIP, 0, 0, 0, 0, entry), Equals, deleteEntry)
+	c.Assert(filter.doFiltering(epIP, otherIP, 0, 0, 0, 0, entry), Equals, noAction)
+	c.Assert(filter.doFiltering(otherIP, peerIP, 0, 0, 0, 0, entry), Equals, noAction)
+}
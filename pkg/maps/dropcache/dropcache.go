@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dropcache
+
+import (
+	"context"
+	"fmt"
+	"unsafe"
+
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/ebpf"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+const (
+	// MapName is the name of the drop verdict cache map.
+	MapName = "cilium_drop_cache"
+
+	// MaxEntries is the upper limit of entries in the drop verdict cache
+	// map.
+	MaxEntries = 1 << 14
+)
+
+// DropCacheKey is the key of the drop verdict cache map. The destination port
+// is in network byte order.
+type DropCacheKey struct {
+	SourceIdentity uint32 `align:"src_identity"`
+	DestIdentity   uint32 `align:"dst_identity"`
+	DestPort       uint16 `align:"dport"`
+	Protocol       uint8  `align:"protocol"`
+	Pad            uint8  `align:"pad"`
+}
+
+// DropCacheVal is the value of the drop verdict cache map. Expires is the
+// ktime in nanoseconds after which the cached verdict must no longer be used,
+// Hits counts how often the cached verdict short-circuited the policy slow
+// path.
+type DropCacheVal struct {
+	Expires uint64 `align:"expires"`
+	Hits    uint32 `align:"hits"`
+	Pad     uint32 `align:"pad"`
+}
+
+// dropCacheMap is the internal representation of the drop verdict cache map.
+type dropCacheMap struct {
+	*ebpf.Map
+}
+
+// DropCacheMap is the gateway to the drop verdict cache map of the datapath,
+// allowing the datapath to short-circuit repeated identical denied policy
+// lookups until the cached entry expires. Entries are populated by the
+// datapath and invalidated from userspace whenever the policy changes.
+var DropCacheMap *dropCacheMap
+
+// InitDropCacheMap initializes the drop verdict cache map, creating it if
+// necessary.
+func InitDropCacheMap() error {
+	return initDropCacheMap(MapName, true)
+}
+
+// OpenDropCacheMap opens the existing drop verdict cache map.
+func OpenDropCacheMap() error {
+	return initDropCacheMap(MapName, false)
+}
+
+func initDropCacheMap(mapName string, create bool) error {
+	var m *ebpf.Map
+
+	if create {
+		m = ebpf.NewMap(&ebpf.MapSpec{
+			Name:       mapName,
+			Type:       ebpf.LRUHash,
+			KeySize:    uint32(unsafe.Sizeof(DropCacheKey{})),
+			ValueSize:  uint32(unsafe.Sizeof(DropCacheVal{})),
+			MaxEntries: uint32(MaxEntries),
+			Pinning:    ebpf.PinByName,
+		})
+
+		if err := m.OpenOrCreate(); err != nil {
+			return err
+		}
+	} else {
+		var err error
+
+		if m, err = ebpf.LoadRegisterMap(mapName); err != nil {
+			return err
+		}
+	}
+
+	DropCacheMap = &dropCacheMap{
+		m,
+	}
+
+	return nil
+}
+
+// NewDropCacheKey returns a new DropCacheKey object representing the (source
+// identity, destination identity, destination port, protocol) tuple.
+func NewDropCacheKey(srcIdentity, dstIdentity uint32, destPort uint16, protocol u8proto.U8proto) DropCacheKey {
+	return DropCacheKey{
+		SourceIdentity: srcIdentity,
+		DestIdentity:   dstIdentity,
+		DestPort:       byteorder.HostToNetwork16(destPort),
+		Protocol:       uint8(protocol),
+	}
+}
+
+// String returns the string representation of a drop verdict cache value.
+func (v *DropCacheVal) String() string {
+	return fmt.Sprintf("expires=%d hits=%d", v.Expires, v.Hits)
+}
+
+// Lookup returns the drop verdict cache value associated with the provided
+// (source identity, destination identity, destination port, protocol) tuple.
+func (m *dropCacheMap) Lookup(srcIdentity, dstIdentity uint32, destPort uint16, protocol u8proto.U8proto) (*DropCacheVal, error) {
+	key := NewDropCacheKey(srcIdentity, dstIdentity, destPort, protocol)
+	val := DropCacheVal{}
+
+	err := m.Map.Lookup(&key, &val)
+
+	return &val, err
+}
+
+// Delete deletes the (source identity, destination identity, destination
+// port, protocol) drop verdict cache entry.
+func (m *dropCacheMap) Delete(srcIdentity, dstIdentity uint32, destPort uint16, protocol u8proto.U8proto) error {
+	key := NewDropCacheKey(srcIdentity, dstIdentity, destPort, protocol)
+
+	return m.Map.Delete(key)
+}
+
+// Flush removes all entries from the drop verdict cache. It must be called
+// whenever the policy maps change, as any cached verdict may no longer
+// reflect the configured policy.
+func Flush() error {
+	if DropCacheMap == nil {
+		return nil
+	}
+
+	keys := []DropCacheKey{}
+	if err := DropCacheMap.IterateWithCallback(&DropCacheKey{}, &DropCacheVal{},
+		func(k, v interface{}) {
+			keys = append(keys, *k.(*DropCacheKey))
+		}); err != nil {
+		return fmt.Errorf("failed to iterate drop verdict cache: %w", err)
+	}
+
+	for i := range keys {
+		if err := DropCacheMap.Map.Delete(keys[i]); err != nil {
+			return fmt.Errorf("failed to flush drop verdict cache: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SyncStats is called periodically to sync the hit counters of the drop
+// verdict cache with the prometheus server.
+func SyncStats(ctx context.Context) error {
+	if DropCacheMap == nil {
+		return nil
+	}
+
+	hits := uint64(0)
+	if err := DropCacheMap.IterateWithCallback(&DropCacheKey{}, &DropCacheVal{},
+		func(k, v interface{}) {
+			hits += uint64(v.(*DropCacheVal).Hits)
+		}); err != nil {
+		return fmt.Errorf("failed to iterate drop verdict cache: %w", err)
+	}
+
+	// The per-entry hit counters reset when the cache is flushed or an
+	// entry is evicted, only ever account growth since the last sync.
+	newValue := float64(hits)
+	if oldValue := metrics.GetCounterValue(metrics.DropVerdictCacheHits); newValue > oldValue {
+		metrics.DropVerdictCacheHits.Add(newValue - oldValue)
+	}
+
+	return nil
+}
@@ -508,6 +508,44 @@ func (v *SockRevNat4Value) String() string {
 // map value.
 func (k SockRevNat4Key) NewValue() bpf.MapValue { return &SockRevNat4Value{} }
 
+// NewSockRevNat4Key returns a new SockRevNat4Key for the given socket cookie
+// and translated backend address.
+func NewSockRevNat4Key(cookie uint64, addr net.IP, port uint16) *SockRevNat4Key {
+	var key SockRevNat4Key
+	key.cookie = cookie
+	key.port = int16(byteorder.HostToNetwork16(port))
+	copy(key.address[:], addr.To4())
+
+	return &key
+}
+
+// GetSockRevNat4 looks up the given socket cookie and translated backend
+// address in the IPv4 reverse NAT sock map. It returns the original frontend
+// address the socket connected to before socket-based load-balancing
+// translated the destination.
+func GetSockRevNat4(cookie uint64, addr net.IP, port uint16) (net.IP, uint16, error) {
+	sockRevNat4Map := bpf.NewMap(SockRevNat4MapName,
+		bpf.MapTypeLRUHash,
+		&SockRevNat4Key{},
+		int(unsafe.Sizeof(SockRevNat4Key{})),
+		&SockRevNat4Value{},
+		int(unsafe.Sizeof(SockRevNat4Value{})),
+		MaxSockRevNat4MapEntries,
+		0,
+		0,
+		bpf.ConvertKeyValue,
+	)
+	defer sockRevNat4Map.Close()
+
+	value, err := sockRevNat4Map.Lookup(NewSockRevNat4Key(cookie, addr, port))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	val := value.(*SockRevNat4Value)
+	return val.address.IP(), byteorder.NetworkToHost16(uint16(val.port)), nil
+}
+
 // CreateSockRevNat4Map creates the reverse NAT sock map.
 func CreateSockRevNat4Map() error {
 	sockRevNat4Map := bpf.NewMap(SockRevNat4MapName,
@@ -408,6 +408,44 @@ func (v *SockRevNat6Value) String() string {
 // map value.
 func (k SockRevNat6Key) NewValue() bpf.MapValue { return &SockRevNat6Value{} }
 
+// NewSockRevNat6Key returns a new SockRevNat6Key for the given socket cookie
+// and translated backend address.
+func NewSockRevNat6Key(cookie uint64, addr net.IP, port uint16) *SockRevNat6Key {
+	var key SockRevNat6Key
+	key.cookie = cookie
+	key.port = int16(byteorder.HostToNetwork16(port))
+	copy(key.address[:], addr.To16())
+
+	return &key
+}
+
+// GetSockRevNat6 looks up the given socket cookie and translated backend
+// address in the IPv6 reverse NAT sock map. It returns the original frontend
+// address the socket connected to before socket-based load-balancing
+// translated the destination.
+func GetSockRevNat6(cookie uint64, addr net.IP, port uint16) (net.IP, uint16, error) {
+	sockRevNat6Map := bpf.NewMap(SockRevNat6MapName,
+		bpf.MapTypeLRUHash,
+		&SockRevNat6Key{},
+		int(unsafe.Sizeof(SockRevNat6Key{})),
+		&SockRevNat6Value{},
+		int(unsafe.Sizeof(SockRevNat6Value{})),
+		MaxSockRevNat6MapEntries,
+		0,
+		0,
+		bpf.ConvertKeyValue,
+	)
+	defer sockRevNat6Map.Close()
+
+	value, err := sockRevNat6Map.Lookup(NewSockRevNat6Key(cookie, addr, port))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	val := value.(*SockRevNat6Value)
+	return val.address.IP(), byteorder.NetworkToHost16(uint16(val.port)), nil
+}
+
 // CreateSockRevNat6Map creates the reverse NAT sock map.
 func CreateSockRevNat6Map() error {
 	sockRevNat6Map := bpf.NewMap(SockRevNat6MapName,
@@ -126,7 +126,8 @@ func (lbmap *LBBPFMap) upsertServiceProto(p *datapathTypes.UpsertServiceParams,
 	}
 
 	if err := updateMasterService(svcKey, len(backends), int(p.ID), p.Type, p.Local, p.NatPolicy,
-		p.SessionAffinity, p.SessionAffinityTimeoutSec, p.CheckSourceRange, p.L7LBProxyPort, p.LoopbackHostport); err != nil {
+		p.SessionAffinity, p.SessionAffinityTimeoutSec, p.CheckSourceRange, p.L7LBProxyPort, p.LoopbackHostport,
+		p.NoHostNSAccess, p.PreserveClientIdentity); err != nil {
 		deleteRevNatLocked(revNATKey)
 		return fmt.Errorf("Unable to update service %+v: %s", svcKey, err)
 	}
@@ -543,6 +544,42 @@ func (*LBBPFMap) DumpBackendMaps() ([]*loadbalancer.Backend, error) {
 	return lbBackends, nil
 }
 
+// DumpRevNatMaps dumps the reverse NAT entry IDs of the given address family
+// from the BPF maps.
+func (*LBBPFMap) DumpRevNatMaps(ipv6 bool) ([]uint16, error) {
+	ids := []uint16{}
+
+	parseRevNatEntries := func(key bpf.MapKey, value bpf.MapValue) {
+		ids = append(ids, key.(RevNatKey).ToHost().GetKey())
+	}
+
+	m := RevNat4Map
+	if ipv6 {
+		m = RevNat6Map
+	}
+	if err := m.DumpWithCallback(parseRevNatEntries); err != nil {
+		return nil, fmt.Errorf("Unable to dump lb reverse NAT map: %s", err)
+	}
+
+	return ids, nil
+}
+
+// DeleteRevNat removes the reverse NAT entry with the given ID.
+func (*LBBPFMap) DeleteRevNat(id uint16, ipv6 bool) error {
+	var key RevNatKey
+	if ipv6 {
+		key = NewRevNat6Key(id)
+	} else {
+		key = NewRevNat4Key(id)
+	}
+
+	if err := deleteRevNatLocked(key); err != nil {
+		return fmt.Errorf("Unable to delete reverse NAT entry %d: %s", id, err)
+	}
+
+	return nil
+}
+
 // IsMaglevLookupTableRecreated returns true if the maglev lookup BPF map
 // was recreated due to the changed M param.
 func (*LBBPFMap) IsMaglevLookupTableRecreated(ipv6 bool) bool {
@@ -554,7 +591,8 @@ func (*LBBPFMap) IsMaglevLookupTableRecreated(ipv6 bool) bool {
 
 func updateMasterService(fe ServiceKey, activeBackends int, revNATID int, svcType loadbalancer.SVCType,
 	svcLocal bool, svcNatPolicy loadbalancer.SVCNatPolicy, sessionAffinity bool,
-	sessionAffinityTimeoutSec uint32, checkSourceRange bool, l7lbProxyPort uint16, loopbackHostport bool) error {
+	sessionAffinityTimeoutSec uint32, checkSourceRange bool, l7lbProxyPort uint16, loopbackHostport bool,
+	noHostNSAccess, preserveClientIdentity bool) error {
 
 	// isRoutable denotes whether this service can be accessed from outside the cluster.
 	isRoutable := !fe.IsSurrogate() &&
@@ -573,6 +611,8 @@ func updateMasterService(fe ServiceKey, activeBackends int, revNATID int, svcTyp
 		CheckSourceRange: checkSourceRange,
 		L7LoadBalancer:   l7lbProxyPort != 0,
 		LoopbackHostport: loopbackHostport,
+		NoHostNSAccess:   noHostNSAccess,
+		PreserveClient:   preserveClientIdentity,
 	})
 	zeroValue.SetFlags(flag.UInt16())
 	if sessionAffinity {
@@ -48,6 +48,7 @@ type policyFlag uint8
 
 const (
 	policyFlagDeny = 1 << iota
+	policyFlagAudit
 )
 
 // PolicyEntryFlags is a new type used to define the flags used in the policy
@@ -67,12 +68,24 @@ func (pef PolicyEntryFlags) IsDeny() bool {
 	return pef.is(policyFlagDeny)
 }
 
+func (pef PolicyEntryFlags) IsAudit() bool {
+	return pef.is(policyFlagAudit)
+}
+
 // String returns the string implementation of PolicyEntryFlags.
 func (pef PolicyEntryFlags) String() string {
+	var str []string
+
 	if pef.IsDeny() {
-		return "Deny"
+		str = append(str, "Deny")
+	} else {
+		str = append(str, "Allow")
 	}
-	return "Allow"
+	if pef.IsAudit() {
+		str = append(str, "Audit")
+	}
+
+	return strings.Join(str, ", ")
 }
 
 var (
@@ -141,7 +154,8 @@ func (pe *PolicyEntry) GetFlags() uint8 {
 }
 
 type PolicyEntryFlagParam struct {
-	IsDeny bool
+	IsDeny  bool
+	IsAudit bool
 }
 
 // NewPolicyEntryFlag returns a PolicyEntryFlags from the PolicyEntryFlagParam.
@@ -151,6 +165,9 @@ func NewPolicyEntryFlag(p *PolicyEntryFlagParam) PolicyEntryFlags {
 	if p.IsDeny {
 		flags |= policyFlagDeny
 	}
+	if p.IsAudit {
+		flags |= policyFlagAudit
+	}
 
 	return flags
 }
@@ -291,32 +308,32 @@ func newEntry(proxyPort uint16, flags PolicyEntryFlags) PolicyEntry {
 
 // AllowKey pushes an entry into the PolicyMap for the given PolicyKey k.
 // Returns an error if the update of the PolicyMap fails.
-func (pm *PolicyMap) AllowKey(k PolicyKey, proxyPort uint16) error {
-	return pm.Allow(k.Identity, k.DestPort, u8proto.U8proto(k.Nexthdr), trafficdirection.TrafficDirection(k.TrafficDirection), proxyPort)
+func (pm *PolicyMap) AllowKey(k PolicyKey, proxyPort uint16, audit bool) error {
+	return pm.Allow(k.Identity, k.DestPort, u8proto.U8proto(k.Nexthdr), trafficdirection.TrafficDirection(k.TrafficDirection), proxyPort, audit)
 }
 
 // Allow pushes an entry into the PolicyMap to allow traffic in the given
 // `trafficDirection` for identity `id` with destination port `dport` over
 // protocol `proto`. It is assumed that `dport` and `proxyPort` are in host byte-order.
-func (pm *PolicyMap) Allow(id uint32, dport uint16, proto u8proto.U8proto, trafficDirection trafficdirection.TrafficDirection, proxyPort uint16) error {
+func (pm *PolicyMap) Allow(id uint32, dport uint16, proto u8proto.U8proto, trafficDirection trafficdirection.TrafficDirection, proxyPort uint16, audit bool) error {
 	key := newKey(id, dport, proto, trafficDirection)
-	pef := NewPolicyEntryFlag(&PolicyEntryFlagParam{})
+	pef := NewPolicyEntryFlag(&PolicyEntryFlagParam{IsAudit: audit})
 	entry := newEntry(proxyPort, pef)
 	return pm.Update(&key, &entry)
 }
 
 // DenyKey pushes an entry into the PolicyMap for the given PolicyKey k.
 // Returns an error if the update of the PolicyMap fails.
-func (pm *PolicyMap) DenyKey(k PolicyKey) error {
-	return pm.Deny(k.Identity, k.DestPort, u8proto.U8proto(k.Nexthdr), trafficdirection.TrafficDirection(k.TrafficDirection))
+func (pm *PolicyMap) DenyKey(k PolicyKey, audit bool) error {
+	return pm.Deny(k.Identity, k.DestPort, u8proto.U8proto(k.Nexthdr), trafficdirection.TrafficDirection(k.TrafficDirection), audit)
 }
 
 // Deny pushes an entry into the PolicyMap to deny traffic in the given
 // `trafficDirection` for identity `id` with destination port `dport` over
 // protocol `proto`. It is assumed that `dport` is in host byte-order.
-func (pm *PolicyMap) Deny(id uint32, dport uint16, proto u8proto.U8proto, trafficDirection trafficdirection.TrafficDirection) error {
+func (pm *PolicyMap) Deny(id uint32, dport uint16, proto u8proto.U8proto, trafficDirection trafficdirection.TrafficDirection, audit bool) error {
 	key := newKey(id, dport, proto, trafficDirection)
-	pef := NewPolicyEntryFlag(&PolicyEntryFlagParam{IsDeny: true})
+	pef := NewPolicyEntryFlag(&PolicyEntryFlagParam{IsDeny: true, IsAudit: audit})
 	entry := newEntry(0, pef)
 	return pm.Update(&key, &entry)
 }
@@ -70,7 +70,7 @@ func (pm *PolicyMapPrivilegedTestSuite) TestPolicyMapDumpToSlice(c *C) {
 	c.Assert(testMap, NotNil)
 
 	fooEntry := newKey(1, 1, 1, 1)
-	err := testMap.AllowKey(fooEntry, 0)
+	err := testMap.AllowKey(fooEntry, 0, false)
 	c.Assert(err, IsNil)
 
 	dump, err := testMap.DumpToSlice()
@@ -84,7 +84,7 @@ func (pm *PolicyMapPrivilegedTestSuite) TestPolicyMapDumpToSlice(c *C) {
 
 	// Special case: allow-all entry
 	barEntry := newKey(0, 0, 0, 0)
-	err = testMap.AllowKey(barEntry, 0)
+	err = testMap.AllowKey(barEntry, 0, false)
 	c.Assert(err, IsNil)
 
 	dump, err = testMap.DumpToSlice()
@@ -106,7 +106,7 @@ func (pm *PolicyMapPrivilegedTestSuite) TestDenyPolicyMapDumpToSlice(c *C) {
 
 	fooEntry := newKey(1, 1, 1, 1)
 	fooValue := newEntry(0, NewPolicyEntryFlag(&PolicyEntryFlagParam{IsDeny: true}))
-	err := testMap.DenyKey(fooEntry)
+	err := testMap.DenyKey(fooEntry, false)
 	c.Assert(err, IsNil)
 
 	dump, err := testMap.DumpToSlice()
@@ -121,7 +121,7 @@ func (pm *PolicyMapPrivilegedTestSuite) TestDenyPolicyMapDumpToSlice(c *C) {
 
 	// Special case: deny-all entry
 	barEntry := newKey(0, 0, 0, 0)
-	err = testMap.DenyKey(barEntry)
+	err = testMap.DenyKey(barEntry, false)
 	c.Assert(err, IsNil)
 
 	dump, err = testMap.DumpToSlice()
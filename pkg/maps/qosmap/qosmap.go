@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package qosmap
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/ebpf"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+const (
+	// PolicyMapName is the name of the QoS policy map.
+	PolicyMapName = "cilium_qos_policy"
+
+	// MaxPolicyEntries is the upper limit of entries in the QoS policy map.
+	MaxPolicyEntries = 1 << 14
+)
+
+// QoSPolicyKey is the key of the QoS policy map. The destination port is in
+// network byte order.
+type QoSPolicyKey struct {
+	Identity uint32 `align:"identity"`
+	DestPort uint16 `align:"dport"`
+	Protocol uint8  `align:"protocol"`
+	Pad      uint8  `align:"pad"`
+}
+
+// QoSPolicyVal is the value of the QoS policy map.
+type QoSPolicyVal struct {
+	DSCP uint8    `align:"dscp"`
+	Pad  [3]uint8 `align:"pad"`
+}
+
+// qosPolicyMap is the internal representation of the QoS policy map.
+type qosPolicyMap struct {
+	*ebpf.Map
+}
+
+// QoSPolicyMap is the gateway to the QoS policy map of the datapath, marking
+// egress traffic of the matching (identity, destination port, protocol) tuple
+// with the configured DSCP value.
+var QoSPolicyMap *qosPolicyMap
+
+// InitQoSPolicyMap initializes the QoS policy map, creating it if necessary.
+func InitQoSPolicyMap() error {
+	return initQoSPolicyMap(PolicyMapName, true)
+}
+
+// OpenQoSPolicyMap opens the existing QoS policy map.
+func OpenQoSPolicyMap() error {
+	return initQoSPolicyMap(PolicyMapName, false)
+}
+
+func initQoSPolicyMap(policyMapName string, create bool) error {
+	var m *ebpf.Map
+
+	if create {
+		m = ebpf.NewMap(&ebpf.MapSpec{
+			Name:       policyMapName,
+			Type:       ebpf.Hash,
+			KeySize:    uint32(unsafe.Sizeof(QoSPolicyKey{})),
+			ValueSize:  uint32(unsafe.Sizeof(QoSPolicyVal{})),
+			MaxEntries: uint32(MaxPolicyEntries),
+			Pinning:    ebpf.PinByName,
+		})
+
+		if err := m.OpenOrCreate(); err != nil {
+			return err
+		}
+	} else {
+		var err error
+
+		if m, err = ebpf.LoadRegisterMap(policyMapName); err != nil {
+			return err
+		}
+	}
+
+	QoSPolicyMap = &qosPolicyMap{
+		m,
+	}
+
+	return nil
+}
+
+// NewQoSPolicyKey returns a new QoSPolicyKey object representing the
+// (identity, destination port, protocol) tuple.
+func NewQoSPolicyKey(identity uint32, destPort uint16, protocol u8proto.U8proto) QoSPolicyKey {
+	return QoSPolicyKey{
+		Identity: identity,
+		DestPort: byteorder.HostToNetwork16(destPort),
+		Protocol: uint8(protocol),
+	}
+}
+
+// String returns the string representation of a QoS policy value.
+func (v *QoSPolicyVal) String() string {
+	return fmt.Sprintf("dscp=%d", v.DSCP)
+}
+
+// Lookup returns the QoS policy value associated with the provided (identity,
+// destination port, protocol) tuple.
+func (m *qosPolicyMap) Lookup(identity uint32, destPort uint16, protocol u8proto.U8proto) (*QoSPolicyVal, error) {
+	key := NewQoSPolicyKey(identity, destPort, protocol)
+	val := QoSPolicyVal{}
+
+	err := m.Map.Lookup(&key, &val)
+
+	return &val, err
+}
+
+// Update updates the (identity, destination port, protocol) QoS policy entry
+// with the provided DSCP value.
+func (m *qosPolicyMap) Update(identity uint32, destPort uint16, protocol u8proto.U8proto, dscp uint8) error {
+	key := NewQoSPolicyKey(identity, destPort, protocol)
+	val := QoSPolicyVal{DSCP: dscp}
+
+	return m.Map.Update(key, val, 0)
+}
+
+// Delete deletes the (identity, destination port, protocol) QoS policy entry.
+func (m *qosPolicyMap) Delete(identity uint32, destPort uint16, protocol u8proto.U8proto) error {
+	key := NewQoSPolicyKey(identity, destPort, protocol)
+
+	return m.Map.Delete(key)
+}
@@ -23,11 +23,13 @@ type CounterVec interface {
 	WithLabelValues(lvls ...string) prometheus.Counter
 	GetMetricWithLabelValues(lvs ...string) (prometheus.Counter, error)
 	With(labels prometheus.Labels) prometheus.Counter
+	DeleteLabelValues(lvls ...string) bool
 	prometheus.Collector
 }
 
 type GaugeVec interface {
 	WithLabelValues(lvls ...string) prometheus.Gauge
+	DeleteLabelValues(lvls ...string) bool
 	prometheus.Collector
 }
 
@@ -81,6 +83,8 @@ func (cv *counterVec) GetMetricWithLabelValues(lvs ...string) (prometheus.Counte
 
 func (cv *counterVec) With(labels prometheus.Labels) prometheus.Counter { return NoOpCounter }
 
+func (cv *counterVec) DeleteLabelValues(lvls ...string) bool { return false }
+
 // Observer
 
 type observer struct{}
@@ -133,3 +137,5 @@ type gaugeVec struct {
 func (gv *gaugeVec) WithLabelValues(lvls ...string) prometheus.Gauge {
 	return NoOpGauge
 }
+
+func (gv *gaugeVec) DeleteLabelValues(lvls ...string) bool { return false }
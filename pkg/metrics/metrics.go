@@ -174,12 +174,18 @@ const (
 	// LabelMapName is the label for the BPF map name
 	LabelMapName = "map_name"
 
+	// LabelResource is the label for the type of LB map resource
+	LabelResource = "resource"
+
 	// LabelVersion is the label for the version number
 	LabelVersion = "version"
 
 	// LabelDirection is the label for traffic direction
 	LabelDirection = "direction"
 
+	// LabelK8sNamespace is the label for Kubernetes namespaces
+	LabelK8sNamespace = "namespace"
+
 	// LabelSourceCluster is the label for source cluster name
 	LabelSourceCluster = "source_cluster"
 
@@ -207,6 +213,13 @@ const (
 	LabelPeerEndpoint = "endpoint"
 	LabelPeerNode     = "node"
 
+	// LabelFQDNSelector is the label for a ToFQDNs selector
+	LabelFQDNSelector = "selector"
+
+	// LabelEvictionPolicy marks the eviction policy that removed a cache
+	// entry
+	LabelEvictionPolicy = "policy"
+
 	LabelTrafficHTTP = "http"
 	LabelTrafficICMP = "icmp"
 
@@ -222,6 +235,10 @@ var (
 	// to the cilium-agent
 	APIInteractions = NoOpObserverVec
 
+	// APIOperationInteractions is the total time taken to process an API
+	// call made to the cilium-agent, labeled by swagger operation
+	APIOperationInteractions = NoOpObserverVec
+
 	// Status
 
 	// NodeConnectivityStatus is the connectivity status between local node to
@@ -249,6 +266,11 @@ var (
 	// endpoints, labeled by span name and status ("success" or "failure")
 	EndpointRegenerationTimeStats = NoOpObserverVec
 
+	// IPCachePropagationDelay is the delay between publication of an
+	// IP->Identity mapping by a remote agent and its insertion into the
+	// local ipcache
+	IPCachePropagationDelay = NoOpObserverVec
+
 	// EndpointPropagationDelay is the delay between creation of local CiliumEndpoint
 	// and update for that CiliumEndpoint received through CiliumEndpointSlice.
 	// Measure of local CEP roundtrip time with CiliumEndpointSlice feature enabled.
@@ -340,6 +362,14 @@ var (
 	// tagged by drop reason and direction(ingress/egress)
 	DropBytes = NoOpCounterVec
 
+	// DropNotificationsSuppressed is the total number of drop notifications
+	// suppressed by per drop reason sampling, tagged by drop reason
+	DropNotificationsSuppressed = NoOpCounterVec
+
+	// DropVerdictCacheHits is the total number of datapath drops
+	// short-circuited by the drop verdict cache
+	DropVerdictCacheHits = NoOpCounter
+
 	// ForwardCount is the total forwarded packets,
 	// tagged by ingress/egress direction
 	ForwardCount = NoOpCounterVec
@@ -379,6 +409,36 @@ var (
 	// ServicesCount number of services
 	ServicesCount = NoOpCounterVec
 
+	// ServiceImplementationDelay is a distribution of times taken from
+	// receiving a service or endpoints update from Kubernetes to the
+	// service being fully programmed in the LB BPF maps. This reflects the
+	// dataplane programming lag perceived during rollouts.
+	ServiceImplementationDelay = NoOpObserverVec
+
+	// ServiceTopologySpillover is the number of times topology aware hints
+	// were bypassed because too few backends remained in the local zone
+	ServiceTopologySpillover = NoOpCounter
+
+	// LBMapInconsistencies is the number of inconsistencies between the
+	// desired service state and the BPF LB maps found by the verifier,
+	// labeled by resource type and whether the entry was repaired
+	LBMapInconsistencies = NoOpCounterVec
+
+	// Endpoint traffic accounting. Aggregated per namespace rather than
+	// per pod to bound the metric cardinality. Disabled by default.
+
+	// EndpointNetworkBytes is the number of bytes forwarded to and from
+	// endpoints, labeled by namespace and direction
+	EndpointNetworkBytes = NoOpCounterVec
+
+	// EndpointNetworkPackets is the number of packets forwarded to and
+	// from endpoints, labeled by namespace and direction
+	EndpointNetworkPackets = NoOpCounterVec
+
+	// EndpointNetworkDrops is the number of packets to and from endpoints
+	// dropped by the datapath, labeled by namespace and direction
+	EndpointNetworkDrops = NoOpCounterVec
+
 	// Errors and warnings
 
 	// ErrorsWarnings is the number of errors and warnings in cilium-agent instances
@@ -390,6 +450,10 @@ var (
 	// ControllerRunsDuration the duration of the controller process in seconds
 	ControllerRunsDuration = NoOpObserverVec
 
+	// ControllersStuck is the number of controllers whose current run has
+	// exceeded the watchdog timeout
+	ControllersStuck = NoOpGauge
+
 	// subprocess, labeled by Subsystem
 	SubprocessStart = NoOpCounterVec
 
@@ -461,6 +525,39 @@ var (
 	// the admission semaphore.
 	FQDNSemaphoreRejectedTotal = NoOpCounter
 
+	// FQDNRateLimitRejectedTotal is the total number of DNS requests dropped
+	// by the DNS proxy because the endpoint exceeded its query rate limit.
+	FQDNRateLimitRejectedTotal = NoOpCounter
+
+	// FQDNResponseMismatchTotal is the total number of DNS responses discarded
+	// by the DNS proxy because the response did not echo the DNS 0x20 encoding
+	// of the forwarded query.
+	FQDNResponseMismatchTotal = NoOpCounter
+
+	// FQDNSelectorIPs is the number of IPs currently selected by each ToFQDNs
+	// selector, up to the per-selector cardinality limit.
+	FQDNSelectorIPs = NoOpGaugeVec
+
+	// FQDNSelectorIdentityAllocations is the number of identity allocations
+	// triggered by DNS responses, per ToFQDNs selector.
+	FQDNSelectorIdentityAllocations = NoOpCounterVec
+
+	// FQDNSelectorResponsesMatched is the number of DNS responses that matched
+	// each ToFQDNs selector.
+	FQDNSelectorResponsesMatched = NoOpCounterVec
+
+	// FQDNIdentityAllocationFailures is the number of DNS responses for which
+	// CIDR identity allocation failed, per configured failure action.
+	FQDNIdentityAllocationFailures = NoOpCounterVec
+
+	// FQDNCacheEvictionsTotal is the number of DNS cache entries evicted by
+	// the cache size limits, per eviction policy.
+	FQDNCacheEvictionsTotal = NoOpCounterVec
+
+	// FQDNIdentityReferenceLeaks is the number of leaked CIDR identity
+	// references detected and repaired by the FQDN identity reconciler.
+	FQDNIdentityReferenceLeaks = NoOpCounter
+
 	// IPCacheErrorsTotal is the total number of IPCache events handled in
 	// the IPCache subsystem that resulted in errors.
 	IPCacheErrorsTotal = NoOpCounterVec
@@ -476,6 +573,10 @@ var (
 	// bpf map.
 	BPFMapOps = NoOpCounterVec
 
+	// BPFMapsSweeperReclaimedTotal is the total number of orphaned BPF
+	// objects removed by the map sweeper.
+	BPFMapsSweeperReclaimedTotal = NoOpCounter
+
 	// TriggerPolicyUpdateTotal is the metric to count total number of
 	// policy update triggers
 	TriggerPolicyUpdateTotal = NoOpCounterVec
@@ -526,12 +627,14 @@ var (
 
 type Configuration struct {
 	APIInteractionsEnabled                  bool
+	APIOperationInteractionsEnabled         bool
 	NodeConnectivityStatusEnabled           bool
 	NodeConnectivityLatencyEnabled          bool
 	EndpointRegenerationCountEnabled        bool
 	EndpointStateCountEnabled               bool
 	EndpointRegenerationTimeStatsEnabled    bool
 	EndpointPropagationDelayEnabled         bool
+	IPCachePropagationDelayEnabled          bool
 	PolicyCountEnabled                      bool
 	PolicyRegenerationCountEnabled          bool
 	PolicyRegenerationTimeStatsEnabled      bool
@@ -554,6 +657,8 @@ type Configuration struct {
 	NoOpObserverVecEnabled                  bool
 	DropCountEnabled                        bool
 	DropBytesEnabled                        bool
+	DropNotificationsSuppressedEnabled      bool
+	DropVerdictCacheHitsEnabled             bool
 	NoOpCounterVecEnabled                   bool
 	ForwardBytesEnabled                     bool
 	ConntrackGCRunsEnabled                  bool
@@ -563,9 +668,16 @@ type Configuration struct {
 	ConntrackDumpResetsEnabled              bool
 	SignalsHandledEnabled                   bool
 	ServicesCountEnabled                    bool
+	ServiceImplementationDelayEnabled       bool
+	ServiceTopologySpilloverEnabled         bool
+	LBMapInconsistenciesEnabled             bool
+	EndpointNetworkBytesEnabled             bool
+	EndpointNetworkPacketsEnabled           bool
+	EndpointNetworkDropsEnabled             bool
 	ErrorsWarningsEnabled                   bool
 	ControllerRunsEnabled                   bool
 	ControllerRunsDurationEnabled           bool
+	ControllersStuckEnabled                 bool
 	SubprocessStartEnabled                  bool
 	KubernetesEventProcessedEnabled         bool
 	KubernetesEventReceivedEnabled          bool
@@ -585,8 +697,17 @@ type Configuration struct {
 	FQDNActiveIPs                           bool
 	FQDNActiveZombiesConnections            bool
 	FQDNSemaphoreRejectedTotal              bool
+	FQDNRateLimitRejectedTotal              bool
+	FQDNResponseMismatchTotal               bool
+	FQDNSelectorIPs                         bool
+	FQDNSelectorIdentityAllocations         bool
+	FQDNSelectorResponsesMatched            bool
+	FQDNIdentityAllocationFailures          bool
+	FQDNCacheEvictionsTotal                 bool
+	FQDNIdentityReferenceLeaks              bool
 	BPFSyscallDurationEnabled               bool
 	BPFMapOps                               bool
+	BPFMapsSweeperReclaimedTotal            bool
 	BPFMapPressure                          bool
 	TriggerPolicyUpdateTotal                bool
 	TriggerPolicyUpdateFolds                bool
@@ -605,6 +726,7 @@ type Configuration struct {
 func DefaultMetrics() map[string]struct{} {
 	return map[string]struct{}{
 		Namespace + "_" + SubsystemAgent + "_api_process_time_seconds":               {},
+		Namespace + "_" + SubsystemAgent + "_api_operation_process_time_seconds":     {},
 		Namespace + "_endpoint_regenerations_total":                                  {},
 		Namespace + "_endpoint_state":                                                {},
 		Namespace + "_endpoint_regeneration_time_stats_seconds":                      {},
@@ -626,6 +748,8 @@ func DefaultMetrics() map[string]struct{} {
 		Namespace + "_proxy_upstream_reply_seconds":                                  {},
 		Namespace + "_drop_count_total":                                              {},
 		Namespace + "_drop_bytes_total":                                              {},
+		Namespace + "_drop_notifications_suppressed_total":                           {},
+		Namespace + "_drop_verdict_cache_hits_total":                                 {},
 		Namespace + "_forward_count_total":                                           {},
 		Namespace + "_forward_bytes_total":                                           {},
 		Namespace + "_endpoint_propagation_delay_seconds":                            {},
@@ -638,9 +762,13 @@ func DefaultMetrics() map[string]struct{} {
 		Namespace + "_" + SubsystemDatapath + "_conntrack_gc_duration_seconds":       {},
 		Namespace + "_" + SubsystemDatapath + "_signals_handled_total":               {},
 		Namespace + "_services_events_total":                                         {},
+		Namespace + "_service_implementation_delay":                                  {},
+		Namespace + "_service_topology_spillover_total":                              {},
+		Namespace + "_lbmap_inconsistencies_total":                                   {},
 		Namespace + "_errors_warnings_total":                                         {},
 		Namespace + "_controllers_runs_total":                                        {},
 		Namespace + "_controllers_runs_duration_seconds":                             {},
+		Namespace + "_controllers_stuck":                                             {},
 		Namespace + "_subprocess_start_total":                                        {},
 		Namespace + "_kubernetes_events_total":                                       {},
 		Namespace + "_kubernetes_events_received_total":                              {},
@@ -653,6 +781,7 @@ func DefaultMetrics() map[string]struct{} {
 		Namespace + "_" + SubsystemKVStore + "_events_queue_seconds":                 {},
 		Namespace + "_" + SubsystemKVStore + "_quorum_errors_total":                  {},
 		Namespace + "_" + SubsystemIPCache + "_errors_total":                         {},
+		Namespace + "_" + SubsystemIPCache + "_propagation_delay_seconds":            {},
 		Namespace + "_" + SubsystemFQDN + "_gc_deletions_total":                      {},
 		Namespace + "_" + SubsystemBPF + "_map_ops_total":                            {},
 		Namespace + "_" + SubsystemTriggers + "_policy_update_total":                 {},
@@ -690,6 +819,17 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, APIInteractions)
 			c.APIInteractionsEnabled = true
 
+		case Namespace + "_" + SubsystemAgent + "_api_operation_process_time_seconds":
+			APIOperationInteractions = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemAgent,
+				Name:      "api_operation_process_time_seconds",
+				Help:      "Duration of processed API calls labeled by swagger operation and return code.",
+			}, []string{LabelOperation, LabelAPIReturnCode})
+
+			collectors = append(collectors, APIOperationInteractions)
+			c.APIOperationInteractionsEnabled = true
+
 		case Namespace + "_endpoint_regenerations_total":
 			EndpointRegenerationTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -925,6 +1065,27 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, DropBytes)
 			c.DropBytesEnabled = true
 
+		case Namespace + "_drop_notifications_suppressed_total":
+			DropNotificationsSuppressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "drop_notifications_suppressed_total",
+				Help:      "Total drop notifications suppressed by sampling, tagged by drop reason",
+			},
+				[]string{"reason"})
+
+			collectors = append(collectors, DropNotificationsSuppressed)
+			c.DropNotificationsSuppressedEnabled = true
+
+		case Namespace + "_drop_verdict_cache_hits_total":
+			DropVerdictCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "drop_verdict_cache_hits_total",
+				Help:      "Total datapath drops short-circuited by the drop verdict cache",
+			})
+
+			collectors = append(collectors, DropVerdictCacheHits)
+			c.DropVerdictCacheHitsEnabled = true
+
 		case Namespace + "_forward_count_total":
 			ForwardCount = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -1037,6 +1198,66 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, ServicesCount)
 			c.ServicesCountEnabled = true
 
+		case Namespace + "_service_implementation_delay":
+			ServiceImplementationDelay = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Namespace: Namespace,
+				Name:      "service_implementation_delay",
+				Help:      "Time between a service or endpoints update received from Kubernetes and the service being fully programmed in the LB BPF maps",
+			}, []string{LabelAction})
+
+			collectors = append(collectors, ServiceImplementationDelay)
+			c.ServiceImplementationDelayEnabled = true
+
+		case Namespace + "_service_topology_spillover_total":
+			ServiceTopologySpillover = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "service_topology_spillover_total",
+				Help:      "Number of times topology aware hints were bypassed because too few backends remained in the local zone",
+			})
+
+			collectors = append(collectors, ServiceTopologySpillover)
+			c.ServiceTopologySpilloverEnabled = true
+
+		case Namespace + "_lbmap_inconsistencies_total":
+			LBMapInconsistencies = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "lbmap_inconsistencies_total",
+				Help:      "Number of inconsistencies between the desired service state and the BPF LB maps found by the verifier, labeled by resource type and whether the entry was repaired",
+			}, []string{LabelResource, LabelAction})
+
+			collectors = append(collectors, LBMapInconsistencies)
+			c.LBMapInconsistenciesEnabled = true
+
+		case Namespace + "_endpoint_network_bytes_total":
+			EndpointNetworkBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "endpoint_network_bytes_total",
+				Help:      "Number of bytes forwarded to and from endpoints, aggregated per namespace and direction",
+			}, []string{LabelK8sNamespace, LabelDirection})
+
+			collectors = append(collectors, EndpointNetworkBytes)
+			c.EndpointNetworkBytesEnabled = true
+
+		case Namespace + "_endpoint_network_packets_total":
+			EndpointNetworkPackets = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "endpoint_network_packets_total",
+				Help:      "Number of packets forwarded to and from endpoints, aggregated per namespace and direction",
+			}, []string{LabelK8sNamespace, LabelDirection})
+
+			collectors = append(collectors, EndpointNetworkPackets)
+			c.EndpointNetworkPacketsEnabled = true
+
+		case Namespace + "_endpoint_network_drops_total":
+			EndpointNetworkDrops = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Name:      "endpoint_network_drops_total",
+				Help:      "Number of packets to and from endpoints dropped by the datapath, aggregated per namespace and direction",
+			}, []string{LabelK8sNamespace, LabelDirection})
+
+			collectors = append(collectors, EndpointNetworkDrops)
+			c.EndpointNetworkDropsEnabled = true
+
 		case Namespace + "_errors_warnings_total":
 			ErrorsWarnings = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -1067,6 +1288,16 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, ControllerRunsDuration)
 			c.ControllerRunsDurationEnabled = true
 
+		case Namespace + "_controllers_stuck":
+			ControllersStuck = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "controllers_stuck",
+				Help:      "Number of controllers whose current run has exceeded the watchdog timeout",
+			})
+
+			collectors = append(collectors, ControllersStuck)
+			c.ControllersStuckEnabled = true
+
 		case Namespace + "_subprocess_start_total":
 			SubprocessStart = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -1262,6 +1493,94 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, FQDNSemaphoreRejectedTotal)
 			c.FQDNSemaphoreRejectedTotal = true
 
+		case Namespace + "_" + SubsystemFQDN + "_rate_limit_rejected_total":
+			FQDNRateLimitRejectedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemFQDN,
+				Name:      "rate_limit_rejected_total",
+				Help:      "Number of DNS requests dropped by the DNS Proxy because the endpoint exceeded its query rate limit",
+			})
+
+			collectors = append(collectors, FQDNRateLimitRejectedTotal)
+			c.FQDNRateLimitRejectedTotal = true
+
+		case Namespace + "_" + SubsystemFQDN + "_response_mismatch_total":
+			FQDNResponseMismatchTotal = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemFQDN,
+				Name:      "response_mismatch_total",
+				Help:      "Number of DNS responses discarded by the DNS Proxy because the response did not echo the DNS 0x20 encoding of the query",
+			})
+
+			collectors = append(collectors, FQDNResponseMismatchTotal)
+			c.FQDNResponseMismatchTotal = true
+
+		case Namespace + "_" + SubsystemFQDN + "_selector_ips":
+			FQDNSelectorIPs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemFQDN,
+				Name:      "selector_ips",
+				Help:      "Number of IPs currently selected by each ToFQDNs selector, up to the per-selector cardinality limit",
+			}, []string{LabelFQDNSelector})
+
+			collectors = append(collectors, FQDNSelectorIPs)
+			c.FQDNSelectorIPs = true
+
+		case Namespace + "_" + SubsystemFQDN + "_selector_identity_allocations_total":
+			FQDNSelectorIdentityAllocations = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemFQDN,
+				Name:      "selector_identity_allocations_total",
+				Help:      "Number of identity allocations triggered by DNS responses, per ToFQDNs selector",
+			}, []string{LabelFQDNSelector})
+
+			collectors = append(collectors, FQDNSelectorIdentityAllocations)
+			c.FQDNSelectorIdentityAllocations = true
+
+		case Namespace + "_" + SubsystemFQDN + "_selector_responses_matched_total":
+			FQDNSelectorResponsesMatched = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemFQDN,
+				Name:      "selector_responses_matched_total",
+				Help:      "Number of DNS responses that matched each ToFQDNs selector",
+			}, []string{LabelFQDNSelector})
+
+			collectors = append(collectors, FQDNSelectorResponsesMatched)
+			c.FQDNSelectorResponsesMatched = true
+
+		case Namespace + "_" + SubsystemFQDN + "_identity_allocation_failures_total":
+			FQDNIdentityAllocationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemFQDN,
+				Name:      "identity_allocation_failures_total",
+				Help:      "Number of DNS responses for which CIDR identity allocation failed, per configured failure action",
+			}, []string{LabelAction})
+
+			collectors = append(collectors, FQDNIdentityAllocationFailures)
+			c.FQDNIdentityAllocationFailures = true
+
+		case Namespace + "_" + SubsystemFQDN + "_cache_evictions_total":
+			FQDNCacheEvictionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemFQDN,
+				Name:      "cache_evictions_total",
+				Help:      "Number of DNS cache entries evicted by the cache size limits, per eviction policy",
+			}, []string{LabelEvictionPolicy})
+
+			collectors = append(collectors, FQDNCacheEvictionsTotal)
+			c.FQDNCacheEvictionsTotal = true
+
+		case Namespace + "_" + SubsystemFQDN + "_identity_reference_leaks_total":
+			FQDNIdentityReferenceLeaks = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemFQDN,
+				Name:      "identity_reference_leaks_total",
+				Help:      "Number of leaked CIDR identity references detected and repaired by the FQDN identity reconciler",
+			})
+
+			collectors = append(collectors, FQDNIdentityReferenceLeaks)
+			c.FQDNIdentityReferenceLeaks = true
+
 		case Namespace + "_" + SubsystemBPF + "_syscall_duration_seconds":
 			BPFSyscallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 				Namespace: Namespace,
@@ -1284,6 +1603,17 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, BPFMapOps)
 			c.BPFMapOps = true
 
+		case Namespace + "_" + SubsystemBPF + "_maps_sweeper_reclaimed_total":
+			BPFMapsSweeperReclaimedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemBPF,
+				Name:      "maps_sweeper_reclaimed_total",
+				Help:      "Total number of orphaned BPF objects removed by the map sweeper",
+			})
+
+			collectors = append(collectors, BPFMapsSweeperReclaimedTotal)
+			c.BPFMapsSweeperReclaimedTotal = true
+
 		case Namespace + "_" + SubsystemBPF + "_map_pressure":
 			c.BPFMapPressure = true
 
@@ -1431,6 +1761,18 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, EndpointPropagationDelay)
 			c.EndpointPropagationDelayEnabled = true
 
+		case Namespace + "_" + SubsystemIPCache + "_propagation_delay_seconds":
+			IPCachePropagationDelay = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemIPCache,
+				Name:      "propagation_delay_seconds",
+				Help:      "Delay between publication of an IP->Identity mapping by a remote agent and its insertion into the local ipcache",
+				Buckets:   []float64{.05, .1, 1, 5, 30, 60, 120, 240, 300, 600},
+			}, []string{})
+
+			collectors = append(collectors, IPCachePropagationDelay)
+			c.IPCachePropagationDelayEnabled = true
+
 		case Namespace + "_node_connectivity_status":
 			NodeConnectivityStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 				Namespace: Namespace,
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/cilium/cilium/pkg/spanstat"
@@ -50,6 +51,33 @@ func getShortPath(s string) string {
 	return s[:idxSum-1]
 }
 
+// APIOperationHelper is intended to be a middleware executing after routing,
+// tracking the duration of API calls per swagger operation.
+type APIOperationHelper struct {
+	Next      http.Handler
+	Histogram prometheus.ObserverVec
+}
+
+// ServeHTTP implements the http.Handler interface. It observes the duration
+// of the API call in the histogram, labeled by the matched swagger operation
+// and the returned status code.
+func (m *APIOperationHelper) ServeHTTP(r http.ResponseWriter, req *http.Request) {
+	var operation string
+	if route := middleware.MatchedRouteFrom(req); route != nil && route.Operation != nil {
+		operation = route.Operation.ID
+	}
+	if operation == "" {
+		m.Next.ServeHTTP(r, req)
+		return
+	}
+
+	duration := spanstat.Start()
+	rw := &ResponderWrapper{ResponseWriter: r}
+	m.Next.ServeHTTP(rw, req)
+	took := float64(duration.End(true).Total().Seconds())
+	m.Histogram.WithLabelValues(operation, strconv.Itoa(rw.code)).Observe(took)
+}
+
 // ServeHTTP implements the http.Handler interface. It records the timestamp
 // this API call began at, then chains to the next handler.
 func (m *APIEventTSHelper) ServeHTTP(r http.ResponseWriter, req *http.Request) {
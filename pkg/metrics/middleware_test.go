@@ -160,3 +160,22 @@ func (s *MetricsSuite) Test_getShortPath(c *C) {
 		c.Assert(got, Equals, tt.want)
 	}
 }
+
+func (s *MetricsSuite) TestAPIOperationHelperMiddleware(c *C) {
+	req, err := http.NewRequest(http.MethodGet, "https://10.0.0.0/v1/endpoint/id:00000000", nil)
+	c.Assert(err, Equals, nil)
+	hist := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_api_op_hist"}, []string{LabelOperation, LabelAPIReturnCode})
+	var called bool
+	middleware := &APIOperationHelper{
+		Next: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}),
+		Histogram: hist,
+	}
+	middleware.ServeHTTP(httptest.NewRecorder(), req)
+	// Requests without a matched swagger route pass through without being
+	// observed.
+	c.Assert(called, Equals, true)
+	c.Assert(testutil.CollectAndCount(hist, "test_api_op_hist"), Equals, 0)
+}
@@ -66,6 +66,9 @@ type Agent struct {
 
 	events        *ebpf.Map
 	monitorEvents *perf.Reader
+
+	// dropSampler rate limits drop notifications per drop reason
+	dropSampler *dropSampler
 }
 
 // NewAgent starts a new monitor agent instance which distributes monitor events
@@ -84,6 +87,7 @@ func NewAgent(ctx context.Context) *Agent {
 		listeners:        make(map[listener.MonitorListener]struct{}),
 		consumers:        make(map[consumer.MonitorConsumer]struct{}),
 		perfReaderCancel: func() {}, // no-op to avoid doing null checks everywhere
+		dropSampler:      newDropSampler(),
 	}
 }
 
@@ -155,6 +159,16 @@ func (a *Agent) SendEvent(typ int, event interface{}) error {
 	return nil
 }
 
+// SetDropSampling replaces the per drop reason sampling limits applied to
+// drop notifications before distribution. It may be called at runtime to
+// adjust sampling while the monitor is running.
+func (a *Agent) SetDropSampling(limits map[uint8]uint64) {
+	if a == nil {
+		return
+	}
+	a.dropSampler.configure(limits)
+}
+
 // Context returns the underlying context of this monitor instance. It can be
 // used to derive other contexts which should be stopped when the monitor is
 // stopped.
@@ -351,6 +365,9 @@ func (a *Agent) processPerfRecord(scopedLog *logrus.Entry, record perf.Record) {
 		})
 
 	} else {
+		if a.dropSampler.suppress(record.RawSample) {
+			return
+		}
 		a.notifyPerfEventLocked(record.RawSample, record.CPU)
 		a.sendToListenersLocked(&payload.Payload{
 			Data: record.RawSample,
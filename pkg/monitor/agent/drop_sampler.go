@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package agent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/monitor/api"
+)
+
+// dropSampleHeaderLen is the minimum number of bytes of a perf record
+// required to determine whether it is a drop notification and which drop
+// reason it carries.
+const dropSampleHeaderLen = 2
+
+// dropSampler rate limits drop notifications on a per drop reason basis
+// before they are distributed to listeners and consumers. Drop reasons
+// without a configured limit are never suppressed. Suppressed notifications
+// are accounted in the cilium_drop_notifications_suppressed_total metric.
+type dropSampler struct {
+	lock.Mutex
+	windows map[uint8]*dropSampleWindow
+}
+
+// dropSampleWindow tracks the drop notifications observed for a single drop
+// reason within the current one second window.
+type dropSampleWindow struct {
+	// limit is the maximum number of notifications emitted per second. A
+	// limit of zero suppresses all notifications for the reason.
+	limit uint64
+	count uint64
+	start time.Time
+}
+
+func newDropSampler() *dropSampler {
+	return &dropSampler{
+		windows: make(map[uint8]*dropSampleWindow),
+	}
+}
+
+// configure replaces the set of per drop reason limits. Suppression state of
+// reasons which remain configured is retained.
+func (s *dropSampler) configure(limits map[uint8]uint64) {
+	s.Lock()
+	defer s.Unlock()
+
+	for reason, w := range s.windows {
+		if limit, ok := limits[reason]; ok {
+			w.limit = limit
+		} else {
+			delete(s.windows, reason)
+		}
+	}
+	for reason, limit := range limits {
+		if _, ok := s.windows[reason]; !ok {
+			s.windows[reason] = &dropSampleWindow{limit: limit}
+		}
+	}
+}
+
+// allow returns true if a drop notification with the given reason may be
+// emitted. Suppressed notifications are counted in metrics.
+func (s *dropSampler) allow(reason uint8) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	w, ok := s.windows[reason]
+	if !ok {
+		return true
+	}
+
+	now := time.Now()
+	if now.Sub(w.start) >= time.Second {
+		w.start = now
+		w.count = 0
+	}
+	w.count++
+	if w.count > w.limit {
+		metrics.DropNotificationsSuppressed.WithLabelValues(api.DropReason(reason)).Inc()
+		return false
+	}
+	return true
+}
+
+// suppress returns true if the given raw perf sample is a drop notification
+// which exceeds the configured sampling limit for its drop reason and must
+// not be distributed.
+func (s *dropSampler) suppress(rawSample []byte) bool {
+	if len(rawSample) < dropSampleHeaderLen || rawSample[0] != byte(api.MessageTypeDrop) {
+		return false
+	}
+	return !s.allow(rawSample[1])
+}
+
+// ParseDropSamplingSpec parses a comma-separated list of <drop reason
+// code>=<limit> pairs, e.g. "133=10,163=5", where the limit is the maximum
+// number of drop notifications emitted per second for the given drop reason.
+func ParseDropSamplingSpec(spec string) (map[uint8]uint64, error) {
+	limits := make(map[uint8]uint64)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		reasonStr, limitStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid drop sampling entry %q, expected <reason>=<limit>", entry)
+		}
+		reason, err := strconv.ParseUint(strings.TrimSpace(reasonStr), 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid drop reason code %q: %w", reasonStr, err)
+		}
+		limit, err := strconv.ParseUint(strings.TrimSpace(limitStr), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid drop sampling limit %q: %w", limitStr, err)
+		}
+		limits[uint8(reason)] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package agent
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/monitor/api"
+)
+
+func (m *ListenerSuite) TestParseDropSamplingSpec(c *C) {
+	limits, err := ParseDropSamplingSpec("133=10, 163=0")
+	c.Assert(err, IsNil)
+	c.Assert(limits, DeepEquals, map[uint8]uint64{133: 10, 163: 0})
+
+	limits, err = ParseDropSamplingSpec("")
+	c.Assert(err, IsNil)
+	c.Assert(limits, HasLen, 0)
+
+	_, err = ParseDropSamplingSpec("133")
+	c.Assert(err, NotNil)
+
+	_, err = ParseDropSamplingSpec("foo=10")
+	c.Assert(err, NotNil)
+
+	_, err = ParseDropSamplingSpec("133=bar")
+	c.Assert(err, NotNil)
+}
+
+func (m *ListenerSuite) TestDropSampler(c *C) {
+	s := newDropSampler()
+	s.configure(map[uint8]uint64{133: 2})
+
+	drop := []byte{byte(api.MessageTypeDrop), 133}
+	c.Assert(s.suppress(drop), Equals, false)
+	c.Assert(s.suppress(drop), Equals, false)
+	c.Assert(s.suppress(drop), Equals, true)
+
+	// Unconfigured drop reasons are never suppressed.
+	other := []byte{byte(api.MessageTypeDrop), 134}
+	c.Assert(s.suppress(other), Equals, false)
+
+	// Non-drop messages are never suppressed.
+	trace := []byte{byte(api.MessageTypeTrace), 133}
+	c.Assert(s.suppress(trace), Equals, false)
+
+	// Removing the limit stops suppression.
+	s.configure(nil)
+	c.Assert(s.suppress(drop), Equals, false)
+}
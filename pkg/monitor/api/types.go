@@ -215,23 +215,31 @@ const (
 	AgentNotifyIPCacheDeleted
 	AgentNotifyServiceUpserted
 	AgentNotifyServiceDeleted
+	AgentNotifyEgressGatewayPolicyApplied
+	AgentNotifyEgressGatewayPolicyDeleted
+	AgentNotifyDNSLookup
+	AgentNotifyConnectionsTerminated
 )
 
 // AgentNotifications is a map of all supported agent notification types.
 var AgentNotifications = map[AgentNotification]string{
-	AgentNotifyUnspec:                    "unspecified",
-	AgentNotifyGeneric:                   "Message",
-	AgentNotifyStart:                     "Cilium agent started",
-	AgentNotifyEndpointRegenerateSuccess: "Endpoint regenerated",
-	AgentNotifyEndpointCreated:           "Endpoint created",
-	AgentNotifyEndpointDeleted:           "Endpoint deleted",
-	AgentNotifyEndpointRegenerateFail:    "Failed endpoint regeneration",
-	AgentNotifyIPCacheDeleted:            "IPCache entry deleted",
-	AgentNotifyIPCacheUpserted:           "IPCache entry upserted",
-	AgentNotifyPolicyUpdated:             "Policy updated",
-	AgentNotifyPolicyDeleted:             "Policy deleted",
-	AgentNotifyServiceDeleted:            "Service deleted",
-	AgentNotifyServiceUpserted:           "Service upserted",
+	AgentNotifyUnspec:                     "unspecified",
+	AgentNotifyGeneric:                    "Message",
+	AgentNotifyStart:                      "Cilium agent started",
+	AgentNotifyEndpointRegenerateSuccess:  "Endpoint regenerated",
+	AgentNotifyEndpointCreated:            "Endpoint created",
+	AgentNotifyEndpointDeleted:            "Endpoint deleted",
+	AgentNotifyEndpointRegenerateFail:     "Failed endpoint regeneration",
+	AgentNotifyIPCacheDeleted:             "IPCache entry deleted",
+	AgentNotifyIPCacheUpserted:            "IPCache entry upserted",
+	AgentNotifyPolicyUpdated:              "Policy updated",
+	AgentNotifyPolicyDeleted:              "Policy deleted",
+	AgentNotifyServiceDeleted:             "Service deleted",
+	AgentNotifyServiceUpserted:            "Service upserted",
+	AgentNotifyEgressGatewayPolicyApplied: "Egress gateway policy applied",
+	AgentNotifyEgressGatewayPolicyDeleted: "Egress gateway policy deleted",
+	AgentNotifyDNSLookup:                  "DNS lookup",
+	AgentNotifyConnectionsTerminated:      "Connections terminated",
 }
 
 func resolveAgentType(t AgentNotification) string {
@@ -489,6 +497,110 @@ func ServiceDeleteMessage(id uint32) AgentNotifyMessage {
 	}
 }
 
+// EgressGatewayNotification structures egress gateway policy entry
+// notifications. It records which gateway and egress IP a source IP will use
+// for traffic towards a destination CIDR. The notification is emitted when the
+// datapath entry is programmed or removed, not per packet steered through the
+// gateway.
+type EgressGatewayNotification struct {
+	SourceIP        string `json:"source_ip"`
+	DestinationCIDR string `json:"destination_cidr"`
+	EgressIP        string `json:"egress_ip"`
+	GatewayIP       string `json:"gateway_ip"`
+}
+
+// EgressGatewayPolicyAppliedMessage constructs an agent notification message
+// for an egress gateway policy entry programmed into the datapath
+func EgressGatewayPolicyAppliedMessage(sourceIP, destinationCIDR, egressIP, gatewayIP string) AgentNotifyMessage {
+	notification := EgressGatewayNotification{
+		SourceIP:        sourceIP,
+		DestinationCIDR: destinationCIDR,
+		EgressIP:        egressIP,
+		GatewayIP:       gatewayIP,
+	}
+
+	return AgentNotifyMessage{
+		Type:         AgentNotifyEgressGatewayPolicyApplied,
+		Notification: notification,
+	}
+}
+
+// EgressGatewayPolicyDeletedMessage constructs an agent notification message
+// for an egress gateway policy entry removed from the datapath
+func EgressGatewayPolicyDeletedMessage(sourceIP, destinationCIDR, egressIP, gatewayIP string) AgentNotifyMessage {
+	notification := EgressGatewayNotification{
+		SourceIP:        sourceIP,
+		DestinationCIDR: destinationCIDR,
+		EgressIP:        egressIP,
+		GatewayIP:       gatewayIP,
+	}
+
+	return AgentNotifyMessage{
+		Type:         AgentNotifyEgressGatewayPolicyDeleted,
+		Notification: notification,
+	}
+}
+
+// DNSLookupNotification structures DNS lookups observed by the DNS proxy.
+// It records which endpoint looked up which name, the addresses the response
+// carried and the ToFQDNs selectors the name matched at the time of the
+// lookup.
+type DNSLookupNotification struct {
+	EndpointID uint64   `json:"endpoint_id"`
+	QName      string   `json:"qname"`
+	IPs        []string `json:"ips,omitempty"`
+	TTL        uint32   `json:"ttl"`
+	Selectors  []string `json:"selectors,omitempty"`
+}
+
+// DNSLookupMessage constructs an agent notification message for a DNS lookup
+// observed by the DNS proxy
+func DNSLookupMessage(endpointID uint64, qname string, ips []string, ttl uint32, selectors []string) AgentNotifyMessage {
+	notification := DNSLookupNotification{
+		EndpointID: endpointID,
+		QName:      qname,
+		IPs:        ips,
+		TTL:        ttl,
+		Selectors:  selectors,
+	}
+
+	return AgentNotifyMessage{
+		Type:         AgentNotifyDNSLookup,
+		Notification: notification,
+	}
+}
+
+// ConnectionsTerminatedReasonPolicy denotes connections terminated because a
+// policy change no longer allows them.
+const ConnectionsTerminatedReasonPolicy = "policy-change"
+
+// ConnectionsTerminatedNotification structures notifications about
+// established connections actively terminated by the agent, e.g. when a
+// policy change flushes their conntrack entries. It allows distinguishing
+// connections killed by network policy from application failures.
+type ConnectionsTerminatedNotification struct {
+	EndpointID     uint64 `json:"endpoint_id"`
+	Count          int    `json:"count"`
+	Reason         string `json:"reason"`
+	PolicyRevision uint64 `json:"policy_revision,omitempty"`
+}
+
+// ConnectionsTerminatedMessage constructs an agent notification message for
+// established connections terminated by the agent
+func ConnectionsTerminatedMessage(endpointID uint64, count int, reason string, policyRevision uint64) AgentNotifyMessage {
+	notification := ConnectionsTerminatedNotification{
+		EndpointID:     endpointID,
+		Count:          count,
+		Reason:         reason,
+		PolicyRevision: policyRevision,
+	}
+
+	return AgentNotifyMessage{
+		Type:         AgentNotifyConnectionsTerminated,
+		Notification: notification,
+	}
+}
+
 const (
 	// PolicyIngress is the value of Flags&PolicyNotifyFlagDirection for ingress traffic
 	PolicyIngress = 1
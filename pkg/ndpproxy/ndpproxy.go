@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ndpproxy
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/mdlayher/ndp"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "ndp-proxy")
+
+// proxyDevice is an uplink device on which neighbor solicitations are
+// answered.
+type proxyDevice struct {
+	iface *net.Interface
+	conn  *ndp.Conn
+}
+
+// NDPProxy answers IPv6 neighbor solicitations received on the configured
+// uplink devices for local pod addresses, so that pods are reachable from
+// L2-adjacent nodes on native routing fabrics without the fabric having to
+// route the pod CIDR explicitly. When an address is added, an unsolicited
+// neighbor advertisement is sent out to prime the neighbor caches of
+// adjacent hosts.
+type NDPProxy struct {
+	mutex lock.RWMutex
+
+	// addrs is the set of local pod IPv6 addresses being proxied.
+	addrs map[netip.Addr]struct{}
+
+	devices []*proxyDevice
+
+	closed chan struct{}
+}
+
+// NewNDPProxy returns an NDPProxy answering neighbor solicitations on the
+// given devices. Start must be called to open the devices and begin serving.
+func NewNDPProxy() *NDPProxy {
+	return &NDPProxy{
+		addrs:  make(map[netip.Addr]struct{}),
+		closed: make(chan struct{}),
+	}
+}
+
+// Start opens an NDP connection on each of the given devices and starts
+// answering neighbor solicitations for proxied addresses.
+func (p *NDPProxy) Start(devices []string) error {
+	for _, device := range devices {
+		iface, err := net.InterfaceByName(device)
+		if err != nil {
+			return fmt.Errorf("unable to find NDP proxy device %s: %w", device, err)
+		}
+		conn, _, err := ndp.Dial(iface, ndp.LinkLocal)
+		if err != nil {
+			return fmt.Errorf("unable to open NDP connection on %s: %w", device, err)
+		}
+		pd := &proxyDevice{
+			iface: iface,
+			conn:  conn,
+		}
+		p.devices = append(p.devices, pd)
+		go p.serve(pd)
+
+		log.WithField(logfields.Device, device).Info("Answering neighbor solicitations for local pod IPv6 addresses")
+	}
+	return nil
+}
+
+// Close stops answering neighbor solicitations and closes all devices.
+func (p *NDPProxy) Close() {
+	close(p.closed)
+	for _, pd := range p.devices {
+		pd.conn.Close()
+	}
+}
+
+// AddIP starts proxying neighbor solicitations for the given pod address and
+// sends an unsolicited neighbor advertisement on all devices to prime the
+// neighbor caches of adjacent hosts. Non-IPv6 addresses are ignored.
+func (p *NDPProxy) AddIP(addr netip.Addr) {
+	if !addr.Is6() || addr.Is4In6() {
+		return
+	}
+
+	p.mutex.Lock()
+	p.addrs[addr] = struct{}{}
+	p.mutex.Unlock()
+
+	ip := net.IP(addr.AsSlice())
+	snm, err := ndp.SolicitedNodeMulticast(ip)
+	if err != nil {
+		log.WithError(err).WithField(logfields.IPAddr, addr).
+			Warning("Unable to compute solicited-node multicast address")
+		return
+	}
+
+	for _, pd := range p.devices {
+		// Join the solicited-node multicast group of the address so that
+		// solicitations for it are received.
+		if err := pd.conn.JoinGroup(snm); err != nil {
+			log.WithError(err).WithField(logfields.Device, pd.iface.Name).
+				Warning("Unable to join solicited-node multicast group")
+			continue
+		}
+		if err := pd.conn.WriteTo(p.advertisement(pd, ip, false), nil, net.IPv6linklocalallnodes); err != nil {
+			log.WithError(err).WithField(logfields.Device, pd.iface.Name).
+				Warning("Unable to send unsolicited neighbor advertisement")
+		}
+	}
+}
+
+// RemoveIP stops proxying neighbor solicitations for the given pod address.
+func (p *NDPProxy) RemoveIP(addr netip.Addr) {
+	if !addr.Is6() || addr.Is4In6() {
+		return
+	}
+
+	p.mutex.Lock()
+	delete(p.addrs, addr)
+	p.mutex.Unlock()
+
+	snm, err := ndp.SolicitedNodeMulticast(net.IP(addr.AsSlice()))
+	if err != nil {
+		return
+	}
+	for _, pd := range p.devices {
+		pd.conn.LeaveGroup(snm)
+	}
+}
+
+// proxies returns true when neighbor solicitations for addr are answered.
+func (p *NDPProxy) proxies(addr netip.Addr) bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	_, ok := p.addrs[addr]
+	return ok
+}
+
+// advertisement builds the neighbor advertisement for target, carrying the
+// link-layer address of the device so that traffic for the pod is directed
+// to this node.
+func (p *NDPProxy) advertisement(pd *proxyDevice, target net.IP, solicited bool) *ndp.NeighborAdvertisement {
+	return &ndp.NeighborAdvertisement{
+		Solicited:     solicited,
+		Override:      true,
+		TargetAddress: target,
+		Options: []ndp.Option{
+			&ndp.LinkLayerAddress{
+				Direction: ndp.Target,
+				Addr:      pd.iface.HardwareAddr,
+			},
+		},
+	}
+}
+
+// serve answers neighbor solicitations for proxied addresses on one device.
+func (p *NDPProxy) serve(pd *proxyDevice) {
+	for {
+		msg, _, from, err := pd.conn.ReadFrom()
+		if err != nil {
+			select {
+			case <-p.closed:
+				return
+			default:
+				// Unparseable or foreign ICMPv6 messages are not fatal.
+				continue
+			}
+		}
+
+		ns, ok := msg.(*ndp.NeighborSolicitation)
+		if !ok {
+			continue
+		}
+		target, ok := netip.AddrFromSlice(ns.TargetAddress)
+		if !ok || !p.proxies(target.Unmap()) {
+			continue
+		}
+
+		if err := pd.conn.WriteTo(p.advertisement(pd, ns.TargetAddress, true), nil, from); err != nil {
+			log.WithError(err).WithField(logfields.Device, pd.iface.Name).
+				Warning("Unable to send neighbor advertisement")
+		}
+	}
+}
@@ -56,6 +56,7 @@ type IPCache interface {
 	Upsert(ip string, hostIP net.IP, hostKey uint8, k8sMeta *ipcache.K8sMetadata, newIdentity ipcache.Identity) (bool, error)
 	Delete(IP string, source source.Source) bool
 	UpsertLabels(prefix netip.Prefix, lbls labels.Labels, src source.Source, rid ipcacheTypes.ResourceID)
+	RemoveLabels(prefix netip.Prefix, lbls labels.Labels, rid ipcacheTypes.ResourceID)
 }
 
 // Configuration is the set of configuration options the node manager depends
@@ -397,6 +398,15 @@ func (m *Manager) NodeUpdated(n nodeTypes.Node) {
 
 	var ipsAdded, healthIPsAdded, ingressIPsAdded []string
 
+	// When node label ingestion is enabled, the node labels become part of
+	// the ipcache metadata of the node IPs, so that node label selectors
+	// in policies can match the resulting node identity.
+	var nodeLabels labels.Labels
+	if option.Config.EnableNodeSelectorLabels {
+		nodeLabels = labels.Map2Labels(n.Labels, labels.LabelSourceNode)
+	}
+	var nodeIPPrefixes []netip.Prefix
+
 	// helper function with the required logic to skip IPCache interactions
 	skipIPCache := func(address nodeTypes.Address) bool {
 		return m.legacyNodeIpBehavior() && address.Type != addressing.NodeCiliumInternalIP
@@ -445,7 +455,8 @@ func (m *Manager) NodeUpdated(n nodeTypes.Node) {
 			Source: n.Source,
 		})
 		resource := ipcacheTypes.NewResourceID(ipcacheTypes.ResourceKindNode, "", n.Name)
-		m.upsertIntoIDMD(prefix, remoteHostIdentity, resource)
+		m.upsertIntoIDMD(prefix, remoteHostIdentity, nodeLabels, resource)
+		nodeIPPrefixes = append(nodeIPPrefixes, prefix)
 
 		// Upsert() will return true if the ipcache entry is owned by
 		// the source of the node update that triggered this node
@@ -536,6 +547,18 @@ func (m *Manager) NodeUpdated(n nodeTypes.Node) {
 		// Delete the old ingress IP addresses if they have changed in this node.
 		m.deleteIPCache(oldNode.Source, []net.IP{oldNode.IPv4IngressIP, oldNode.IPv6IngressIP}, ingressIPsAdded)
 
+		// Remove node labels that are no longer present on the node from
+		// the ipcache metadata of the node IPs.
+		if option.Config.EnableNodeSelectorLabels {
+			oldNodeLabels := labels.Map2Labels(oldNode.Labels, labels.LabelSourceNode)
+			if staleLabels := oldNodeLabels.Remove(nodeLabels); len(staleLabels) > 0 {
+				resource := ipcacheTypes.NewResourceID(ipcacheTypes.ResourceKindNode, "", n.Name)
+				for _, prefix := range nodeIPPrefixes {
+					m.ipcache.RemoveLabels(prefix, staleLabels, resource)
+				}
+			}
+		}
+
 		entry.mutex.Unlock()
 	} else {
 		m.metricEventsReceived.WithLabelValues("add", string(n.Source)).Inc()
@@ -556,12 +579,23 @@ func (m *Manager) NodeUpdated(n nodeTypes.Node) {
 
 // upsertIntoIDMD upserts the given CIDR into the ipcache.identityMetadata
 // (IDMD) map. The given node identity determines which labels are associated
-// with the CIDR.
-func (m *Manager) upsertIntoIDMD(prefix netip.Prefix, id identity.NumericIdentity, rid ipcacheTypes.ResourceID) {
+// with the CIDR. The given node labels are associated in addition, causing a
+// distinct identity to be allocated for the node so that node label selectors
+// in policies can match it.
+func (m *Manager) upsertIntoIDMD(prefix netip.Prefix, id identity.NumericIdentity, nodeLabels labels.Labels, rid ipcacheTypes.ResourceID) {
+	nodeIdentityLabels := func(idLabels labels.Labels) labels.Labels {
+		if len(nodeLabels) == 0 {
+			return idLabels
+		}
+		lbls := make(labels.Labels, len(idLabels)+len(nodeLabels))
+		lbls.MergeLabels(idLabels)
+		lbls.MergeLabels(nodeLabels)
+		return lbls
+	}
 	if id == identity.ReservedIdentityHost {
-		m.ipcache.UpsertLabels(prefix, labels.LabelHost, source.Local, rid)
+		m.ipcache.UpsertLabels(prefix, nodeIdentityLabels(labels.LabelHost), source.Local, rid)
 	} else {
-		m.ipcache.UpsertLabels(prefix, labels.LabelRemoteNode, source.CustomResource, rid)
+		m.ipcache.UpsertLabels(prefix, nodeIdentityLabels(labels.LabelRemoteNode), source.CustomResource, rid)
 	}
 }
 
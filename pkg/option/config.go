@@ -91,6 +91,22 @@ const (
 	// BPFRoot is the Path to BPF filesystem
 	BPFRoot = "bpf-root"
 
+	// BPFOrphanGCInterval is the interval between periodic sweeps for
+	// orphaned BPF resources left behind by previous agent runs.
+	BPFOrphanGCInterval = "bpf-orphan-gc-interval"
+
+	// BPFOrphanGCDryRun makes the orphaned BPF resource sweeper report the
+	// resources it would remove without removing them.
+	BPFOrphanGCDryRun = "bpf-orphan-gc-dry-run"
+
+	// LBMapVerifyInterval is the interval between periodic cross-checks of
+	// the BPF LB maps against the desired service state.
+	LBMapVerifyInterval = "lbmap-verify-interval"
+
+	// LBMapVerifyRepair makes the LB map verifier repair the
+	// inconsistencies it finds instead of only reporting them.
+	LBMapVerifyRepair = "lbmap-verify-repair"
+
 	// CertsDirectory is the root directory used to find out certificates used
 	// in L7 HTTPs policy enforcement.
 	CertsDirectory = "certificates-directory"
@@ -135,6 +151,11 @@ const (
 	// EnablePolicy enables policy enforcement in the agent.
 	EnablePolicy = "enable-policy"
 
+	// EnablePolicyConntrackFlush enables flushing of the conntrack and NAT
+	// entries of established connections that a policy change no longer
+	// allows, so that new deny policies take effect immediately.
+	EnablePolicyConntrackFlush = "enable-policy-conntrack-flush"
+
 	// EnableExternalIPs enables implementation of k8s services with externalIPs in datapath
 	EnableExternalIPs = "enable-external-ips"
 
@@ -171,6 +192,44 @@ const (
 	// ProxyMaxConnectionDuration specifies the max_connection_duration setting for the proxy in seconds
 	ProxyMaxConnectionDuration = "proxy-max-connection-duration-seconds"
 
+	// CIDRZone is the name of the option to configure zone identities
+	// mapping external CIDR sets to stable user-reserved identities
+	CIDRZone = "cidr-zone"
+
+	// CIDRZoneFeed is the name of the option to refresh zone CIDRs from
+	// published IP range feeds
+	CIDRZoneFeed = "cidr-zone-feed"
+
+	// CIDRGroup is the name of the option to define named CIDR groups
+	// which policy rules may reference via cidrGroupRef
+	CIDRGroup = "cidr-group"
+
+	// QoSDSCPPolicy is the name of the option to configure DSCP marking
+	// policies for egress traffic of selected identities
+	QoSDSCPPolicy = "qos-dscp-policy"
+
+	// EnableMultiNetwork is the name of the option to enable awareness of
+	// secondary pod networks requested via NetworkAttachmentDefinitions
+	EnableMultiNetwork = "enable-multi-network"
+
+	// DropVerdictCacheTTL is the name of the option to configure for how
+	// long the datapath may cache and short-circuit repeated identical
+	// drop verdicts
+	DropVerdictCacheTTL = "drop-verdict-cache-ttl"
+
+	// DatapathConfigDriftCheck is the name of the option to periodically
+	// check the loaded datapath for configuration drift
+	DatapathConfigDriftCheck = "datapath-config-drift-check"
+
+	// DatapathConfigDriftReconcile is the name of the option to
+	// reinitialize the datapath when configuration drift is detected
+	DatapathConfigDriftReconcile = "datapath-config-drift-reconcile"
+
+	// SysctlReconcile is the name of the option to periodically re-apply
+	// the sysctl settings required by Cilium when they are reverted
+	// externally
+	SysctlReconcile = "sysctl-reconcile"
+
 	// FixedIdentityMapping is the key-value for the fixed identity mapping
 	// which allows to use reserved label for fixed identities
 	FixedIdentityMapping = "fixed-identity-mapping"
@@ -212,6 +271,21 @@ const (
 	// K8sSyncTimeout is the timeout since last event was received to synchronize all resources with k8s.
 	K8sSyncTimeoutName = "k8s-sync-timeout"
 
+	// K8sSyncTimeoutActionName is the name of the option selecting the
+	// behavior when the Kubernetes caches do not synchronize within
+	// K8sSyncTimeout.
+	K8sSyncTimeoutActionName = "k8s-sync-timeout-action"
+
+	// StartupActionFail aborts agent startup when a startup dependency is
+	// not ready within its timeout. It is the default.
+	StartupActionFail = "fail"
+
+	// StartupActionContinue continues agent startup in degraded mode when
+	// a startup dependency is not ready within its timeout, retrying the
+	// dependency in the background. Restored endpoints keep being served
+	// in the meantime.
+	StartupActionContinue = "continue"
+
 	// AllocatorListTimeout is the timeout to list initial allocator state.
 	AllocatorListTimeoutName = "allocator-list-timeout"
 
@@ -304,6 +378,11 @@ const (
 
 	EnableServiceTopology = "enable-service-topology"
 
+	// ServiceTopologySpilloverThreshold is the minimum fraction of a
+	// service's backends which must remain after topology aware hints
+	// filtering. Below the threshold traffic spills over to all zones.
+	ServiceTopologySpilloverThreshold = "service-topology-spillover-threshold"
+
 	// EnableIdentityMark enables setting the mark field with the identity for
 	// local traffic. This may be disabled if chaining modes and Cilium use
 	// conflicting marks.
@@ -415,6 +494,10 @@ const (
 	// TracePayloadlen length of payload to capture when tracing
 	TracePayloadlen = "trace-payloadlen"
 
+	// ValidateConfig validates the agent configuration and exits with a
+	// structured report without starting the agent
+	ValidateConfig = "validate-config"
+
 	// Version prints the version information
 	Version = "version"
 
@@ -452,6 +535,22 @@ const (
 	// for each FQDN name in an endpoint's FQDN cache
 	ToFQDNsMaxIPsPerHost = "tofqdns-endpoint-max-ip-per-hostname"
 
+	// ToFQDNsEndpointMaxEntries caps the number of DNS names retained in an
+	// endpoint's FQDN cache. 0 disables the cap.
+	ToFQDNsEndpointMaxEntries = "tofqdns-endpoint-max-entries"
+
+	// ToFQDNsCacheEvictionPolicy selects which names are evicted first when
+	// an endpoint's FQDN cache exceeds tofqdns-endpoint-max-entries.
+	ToFQDNsCacheEvictionPolicy = "tofqdns-cache-eviction-policy"
+
+	// ToFQDNsNamespaceIPsQuota bounds the total number of IPs tracked in
+	// the FQDN caches of a namespace's endpoints. 0 disables the quota.
+	ToFQDNsNamespaceIPsQuota = "tofqdns-namespace-ips-quota"
+
+	// ToFQDNsNamespaceIPsQuotaOverrides overrides ToFQDNsNamespaceIPsQuota
+	// for individual namespaces, e.g. tenant-a=5000,tenant-b=100.
+	ToFQDNsNamespaceIPsQuotaOverrides = "tofqdns-namespace-ips-quota-overrides"
+
 	// ToFQDNsMaxDeferredConnectionDeletes defines the maximum number of IPs to
 	// retain for expired DNS lookups with still-active connections"
 	ToFQDNsMaxDeferredConnectionDeletes = "tofqdns-max-deferred-connection-deletes"
@@ -469,6 +568,20 @@ const (
 	// endpoints that are larger than 512 Bytes or the EDNS0 option, if present.
 	ToFQDNsEnableDNSCompression = "tofqdns-enable-dns-compression"
 
+	// ToFQDNsEnableGlobalCache shares DNS lookups observed by this agent
+	// with all agents in the cluster via the kvstore.
+	ToFQDNsEnableGlobalCache = "tofqdns-enable-global-cache"
+
+	// ToFQDNsPreResolve proactively resolves toFQDNs matchNames when a
+	// policy is imported and periodically re-resolves them honoring the
+	// TTL of the responses.
+	ToFQDNsPreResolve = "tofqdns-pre-resolve"
+
+	// ToFQDNsFilterResponseIPs restricts A/AAAA records in DNS responses to
+	// the IPs that are already plumbed into policy before the response is
+	// released to the endpoint.
+	ToFQDNsFilterResponseIPs = "tofqdns-filter-response-ips"
+
 	// DNSProxyConcurrencyLimit limits parallel processing of DNS messages in
 	// DNS proxy at any given point in time.
 	DNSProxyConcurrencyLimit = "dnsproxy-concurrency-limit"
@@ -478,6 +591,88 @@ const (
 	// been reached.
 	DNSProxyConcurrencyProcessingGracePeriod = "dnsproxy-concurrency-processing-grace-period"
 
+	// DNSProxyEndpointConcurrencyLimit limits parallel processing of DNS
+	// messages per endpoint in the DNS proxy at any given point in time.
+	DNSProxyEndpointConcurrencyLimit = "dnsproxy-endpoint-concurrency-limit"
+
+	// DNSProxyEndpointMaxQPS is the maximum rate of DNS queries of a single
+	// endpoint handled by the DNS proxy, 0 disables the limit.
+	DNSProxyEndpointMaxQPS = "dnsproxy-endpoint-max-qps"
+
+	// DNSProxyEndpointMaxBurst is the number of DNS queries of a single
+	// endpoint allowed to burst beyond DNSProxyEndpointMaxQPS.
+	DNSProxyEndpointMaxBurst = "dnsproxy-endpoint-max-burst"
+
+	// DNSProxyLockCount is the number of mutexes serializing the handling of
+	// DNS responses in the daemon, sharded by response IP.
+	DNSProxyLockCount = "dnsproxy-lock-count"
+
+	// DNSProxyLockShardByEndpoint includes the endpoint ID in the DNS
+	// response lock shard hash, so that endpoints resolving the same names
+	// do not contend on the same shard.
+	DNSProxyLockShardByEndpoint = "dnsproxy-lock-shard-by-endpoint"
+
+	// DNSProxySocketHandover hands the DNS proxy listening sockets over to
+	// the next agent instance on restart, so that DNS resolution for
+	// endpoints with toFQDNs rules keeps working while the agent restarts.
+	DNSProxySocketHandover = "dnsproxy-socket-handover"
+
+	// DNSProxyBindEndpointNetNS additionally binds the DNS proxy listening
+	// sockets inside the network namespace of each endpoint, avoiding
+	// host-namespace port conflicts and allowing the proxy to receive
+	// queries in chaining setups where the redirect approach fails.
+	DNSProxyBindEndpointNetNS = "dnsproxy-bind-endpoint-netns"
+
+	// DNSProxyEnableDNS0x20Encoding enables DNS 0x20 encoding of queries
+	// forwarded upstream by the DNS proxy and verification of the encoding
+	// in responses, hardening against off-path response spoofing.
+	DNSProxyEnableDNS0x20Encoding = "dnsproxy-enable-dns-0x20-encoding"
+
+	// DNSProxyEDNSClientSubnetMode controls how EDNS0 Client Subnet options
+	// in queries forwarded upstream by the DNS proxy are handled: preserved,
+	// stripped or rewritten to the all-zero subnet.
+	DNSProxyEDNSClientSubnetMode = "dnsproxy-edns-client-subnet-mode"
+
+	// DNSProxyUseReservedIdentity makes connections from the DNS proxy to
+	// upstream DNS servers carry the reserved dns-proxy identity instead of
+	// the identity of the endpoint on whose behalf the query is made.
+	DNSProxyUseReservedIdentity = "dnsproxy-use-reserved-identity"
+
+	// DNSProxyEnableDNSOverTLS makes the DNS proxy forward proxied queries
+	// to upstream DNS servers over DNS-over-TLS (RFC 7858).
+	DNSProxyEnableDNSOverTLS = "dnsproxy-enable-dns-over-tls"
+
+	// DNSProxyDNSOverTLSServerName is the name the certificate of upstream
+	// DNS-over-TLS servers is validated against.
+	DNSProxyDNSOverTLSServerName = "dnsproxy-dns-over-tls-server-name"
+
+	// DNSProxyDNSOverTLSCAFile is a CA bundle used to validate the
+	// certificate of upstream DNS-over-TLS servers instead of the system
+	// roots.
+	DNSProxyDNSOverTLSCAFile = "dnsproxy-dns-over-tls-ca-file"
+
+	// DNSProxyDNSOverTLSNamespaceUpstreams maps a namespace to the
+	// DNS-over-TLS upstream its endpoints' proxied queries are forwarded
+	// to, overriding the originally intended DNS server.
+	DNSProxyDNSOverTLSNamespaceUpstreams = "dnsproxy-dns-over-tls-namespace-upstreams"
+
+	// DNSProxyDoHListenPort is the port the DNS proxy terminates
+	// DNS-over-HTTPS traffic from endpoints on. 0 disables DoH
+	// interception.
+	DNSProxyDoHListenPort = "dnsproxy-doh-listen-port"
+
+	// DNSProxyDoHCertFile is the TLS certificate the DoH listener presents
+	// to endpoints.
+	DNSProxyDoHCertFile = "dnsproxy-doh-cert-file"
+
+	// DNSProxyDoHKeyFile is the TLS key for DNSProxyDoHCertFile.
+	DNSProxyDoHKeyFile = "dnsproxy-doh-key-file"
+
+	// DNSProxyDoHResolverIPs is the list of well-known DNS-over-HTTPS
+	// resolver IPs whose HTTPS traffic from endpoints is redirected to the
+	// DoH listener.
+	DNSProxyDoHResolverIPs = "dnsproxy-doh-resolver-ips"
+
 	// MTUName is the name of the MTU option
 	MTUName = "mtu"
 
@@ -531,6 +726,10 @@ const (
 	// MonitorAggregationFlags configures TCP flags used by monitor aggregation.
 	MonitorAggregationFlags = "monitor-aggregation-flags"
 
+	// MonitorDropSampling configures per drop reason sampling of drop
+	// notifications.
+	MonitorDropSampling = "monitor-drop-sampling"
+
 	// ciliumEnvPrefix is the prefix used for environment variables
 	ciliumEnvPrefix = "CILIUM_"
 
@@ -644,6 +843,10 @@ const (
 	// use of the CEP CRD
 	DisableCiliumEndpointCRDName = "disable-endpoint-crd"
 
+	// EnablePodNetworkReadinessGate is the name of the option to enable
+	// management of the cilium.io/network-ready pod readiness gate condition
+	EnablePodNetworkReadinessGate = "enable-pod-network-readiness-gate"
+
 	// MaxCtrlIntervalName and MaxCtrlIntervalNameEnv allow configuration
 	// of MaxControllerInterval.
 	MaxCtrlIntervalName = "max-controller-interval"
@@ -685,12 +888,23 @@ const (
 	// IPv6MCastDevice is the name of the option to select IPv6 multicast device
 	IPv6MCastDevice = "ipv6-mcast-device"
 
+	// NDPProxyDevices is the list of devices on which neighbor solicitations
+	// for local pod IPv6 addresses are answered.
+	NDPProxyDevices = "ndp-proxy-devices"
+
 	// EnableMonitor is the name of the option to enable the monitor socket
 	EnableMonitorName = "enable-monitor"
 
 	// MonitorQueueSizeName is the name of the option MonitorQueueSize
 	MonitorQueueSizeName = "monitor-queue-size"
 
+	// IPFIXCollectorAddress is the address of the IPFIX collector sampled
+	// flows are exported to
+	IPFIXCollectorAddress = "ipfix-collector-address"
+
+	// IPFIXSampleRate is the sampling interval of the IPFIX flow export
+	IPFIXSampleRate = "ipfix-sample-rate"
+
 	//FQDNRejectResponseCode is the name for the option for dns-proxy reject response code
 	FQDNRejectResponseCode = "tofqdns-dns-reject-response-code"
 
@@ -707,6 +921,29 @@ const (
 	// FQDNProxyResponseMaxDelay is the maximum time the proxy holds back a response
 	FQDNProxyResponseMaxDelay = "tofqdns-proxy-response-max-delay"
 
+	// ToFQDNsProxyAsyncPolicyUpdates expands DNS responses into ToFQDNs
+	// policy asynchronously off the DNS response path
+	ToFQDNsProxyAsyncPolicyUpdates = "tofqdns-proxy-async-policy-updates"
+
+	// ToFQDNsPolicyUpdateBatchWindow is the window over which queued DNS
+	// responses are accumulated before being expanded into ToFQDNs policy
+	// with a single update
+	ToFQDNsPolicyUpdateBatchWindow = "tofqdns-policy-update-batch-window"
+
+	// FQDNIdentityAllocationFailureAction is the name of the option selecting
+	// the behavior of the DNS proxy when allocating CIDR identities for a DNS
+	// response fails
+	FQDNIdentityAllocationFailureAction = "tofqdns-identity-allocation-failure-action"
+
+	// FQDNIdentityAllocationFailureActionFailOpen releases the DNS response to
+	// the endpoint even though identity allocation failed. It is the default.
+	FQDNIdentityAllocationFailureActionFailOpen = "fail-open"
+
+	// FQDNIdentityAllocationFailureActionFailClosed refuses the DNS response
+	// when identity allocation failed, so that the endpoint never connects to
+	// IPs the policy could not be updated for.
+	FQDNIdentityAllocationFailureActionFailClosed = "fail-closed"
+
 	// FQDNRegexCompileLRUSize is the size of the FQDN regex compilation LRU.
 	// Useful for heavy but repeated FQDN MatchName or MatchPattern use.
 	FQDNRegexCompileLRUSize = "fqdn-regex-compile-lru-size"
@@ -735,6 +972,10 @@ const (
 	// EnableWireguardUserspaceFallback is the name of the option that enables the fallback to wireguard userspace mode
 	EnableWireguardUserspaceFallback = "enable-wireguard-userspace-fallback"
 
+	// EncryptionBypassIdentities is the name of the option holding numeric
+	// security identities whose traffic bypasses node-to-node encryption
+	EncryptionBypassIdentities = "encryption-bypass-identities"
+
 	// KVstoreLeaseTTL is the time-to-live for lease in kvstore.
 	KVstoreLeaseTTL = "kvstore-lease-ttl"
 
@@ -749,6 +990,15 @@ const (
 	// KVstoreConnectivityTimeout is the timeout when performing kvstore operations
 	KVstoreConnectivityTimeout = "kvstore-connectivity-timeout"
 
+	// KVstoreStartupTimeout is the timeout for the initial kvstore
+	// connection during agent startup. Zero waits indefinitely.
+	KVstoreStartupTimeout = "kvstore-startup-timeout"
+
+	// KVstoreStartupAction is the name of the option selecting the
+	// behavior when the kvstore cannot be reached within
+	// KVstoreStartupTimeout during agent startup.
+	KVstoreStartupAction = "kvstore-startup-action"
+
 	// IPAllocationTimeout is the timeout when allocating CIDRs
 	IPAllocationTimeout = "ip-allocation-timeout"
 
@@ -769,6 +1019,9 @@ const (
 	// EnableHealthCheckNodePort is the name of the EnableHealthCheckNodePort option
 	EnableHealthCheckNodePort = "enable-health-check-nodeport"
 
+	// EnableHealthCheckProxyProtocol is the name of the EnableHealthCheckProxyProtocol option
+	EnableHealthCheckProxyProtocol = "enable-health-check-proxy-protocol"
+
 	// PolicyQueueSize is the size of the queues utilized by the policy
 	// repository.
 	PolicyQueueSize = "policy-queue-size"
@@ -824,6 +1077,10 @@ const (
 	// IPAM is the IPAM method to use
 	IPAM = "ipam"
 
+	// IPAMDelegateEndpoint is the gRPC endpoint of the external IPAM
+	// delegate used with the delegated-grpc IPAM mode
+	IPAMDelegateEndpoint = "ipam-delegate-endpoint"
+
 	// XDPModeNative for loading progs with XDPModeLinkDriver
 	XDPModeNative = "native"
 
@@ -878,6 +1135,16 @@ const (
 	// identity allocation
 	IdentityAllocationModeCRD = "crd"
 
+	// IdentityAllocationModeDoubleWriteReadKVstore writes identities to
+	// both the kvstore and CRDs while reading from the kvstore, for
+	// online migration between identity allocation modes
+	IdentityAllocationModeDoubleWriteReadKVstore = "doublewrite-readkvstore"
+
+	// IdentityAllocationModeDoubleWriteReadCRD writes identities to both
+	// the kvstore and CRDs while reading from CRDs, for online migration
+	// between identity allocation modes
+	IdentityAllocationModeDoubleWriteReadCRD = "doublewrite-readcrd"
+
 	// DisableCNPStatusUpdates disables updating of CNP NodeStatus in the CNP
 	// CRD.
 	DisableCNPStatusUpdates = "disable-cnp-status-updates"
@@ -969,6 +1236,10 @@ const (
 	// HubbleSkipUnknownCGroupIDs specifies if events with unknown cgroup ids should be skipped
 	HubbleSkipUnknownCGroupIDs = "hubble-skip-unknown-cgroup-ids"
 
+	// HubbleHostNetworkPodAttribution attributes socket events of
+	// host-network pods to the owning pod via their cgroup ids
+	HubbleHostNetworkPodAttribution = "hubble-host-network-pod-attribution"
+
 	// DisableIptablesFeederRules specifies which chains will be excluded
 	// when installing the feeder rules
 	DisableIptablesFeederRules = "disable-iptables-feeder-rules"
@@ -1067,6 +1338,11 @@ const (
 	// EnableICMPRules enables ICMP-based rule support for Cilium Network Policies.
 	EnableICMPRules = "enable-icmp-rules"
 
+	// EnableNodeSelectorLabels enables ingestion of node labels into node
+	// identities, which allows FromNodes/ToNodes rules in Cilium Network
+	// Policies.
+	EnableNodeSelectorLabels = "enable-node-selector-labels"
+
 	// BypassIPAvailabilityUponRestore bypasses the IP availability error
 	// within IPAM upon endpoint restore and allows the use of the restored IP
 	// regardless of whether it's available in the pool.
@@ -1118,6 +1394,10 @@ const (
 var (
 	FQDNRejectOptions = []string{FQDNProxyDenyWithNameError, FQDNProxyDenyWithRefused}
 
+	FQDNIdentityAllocationFailureOptions = []string{FQDNIdentityAllocationFailureActionFailOpen, FQDNIdentityAllocationFailureActionFailClosed}
+
+	StartupActionOptions = []string{StartupActionFail, StartupActionContinue}
+
 	// MonitorAggregationFlagsDefault ensure that all TCP flags trigger
 	// monitor notifications even under medium monitor aggregation.
 	MonitorAggregationFlagsDefault = []string{"syn", "fin", "rst"}
@@ -1460,6 +1740,11 @@ type DaemonConfig struct {
 	// DisableCiliumEndpointCRD disables the use of CiliumEndpoint CRD
 	DisableCiliumEndpointCRD bool
 
+	// EnablePodNetworkReadinessGate enables setting the
+	// cilium.io/network-ready pod condition once the endpoint's network
+	// programming is in place on the local node
+	EnablePodNetworkReadinessGate bool
+
 	// MaxControllerInterval is the maximum value for a controller's
 	// RunInterval. Zero means unlimited.
 	MaxControllerInterval int
@@ -1579,6 +1864,10 @@ type DaemonConfig struct {
 	// IPv6MCastDevice is the name of device that joins IPv6's solicitation multicast group
 	IPv6MCastDevice string
 
+	// NDPProxyDevices is the list of devices on which neighbor solicitations
+	// for local pod IPv6 addresses are answered.
+	NDPProxyDevices []string
+
 	// EnableL7Proxy is the option to enable L7 proxy
 	EnableL7Proxy bool
 
@@ -1594,13 +1883,30 @@ type DaemonConfig struct {
 	// EnableWireguardUserspaceFallback enables the fallback to the userspace implementation
 	EnableWireguardUserspaceFallback bool
 
+	// EncryptionBypassIdentities is a list of numeric security identities
+	// whose traffic is not carried over the node-to-node encryption tunnel,
+	// e.g. high-throughput workloads such as storage replication
+	EncryptionBypassIdentities []int
+
 	// MonitorQueueSize is the size of the monitor event queue
 	MonitorQueueSize int
 
+	// IPFIXCollectorAddress is the address of the IPFIX collector sampled
+	// flows are exported to. The export is disabled when empty.
+	IPFIXCollectorAddress string
+
+	// IPFIXSampleRate makes the IPFIX flow export cover one of every
+	// IPFIXSampleRate observed trace events.
+	IPFIXSampleRate int
+
 	// CLI options
 
 	BPFRoot                       string
 	BPFSocketLBHostnsOnly         bool
+	BPFOrphanGCInterval           time.Duration
+	BPFOrphanGCDryRun             bool
+	LBMapVerifyInterval           time.Duration
+	LBMapVerifyRepair             bool
 	CGroupRoot                    string
 	BPFCompileDebug               string
 	CompilerFlags                 []string
@@ -1614,27 +1920,72 @@ type DaemonConfig struct {
 	EnableHostServicesUDP         bool
 	EnableHostServicesPeer        bool
 	EnablePolicy                  string
+	EnablePolicyConntrackFlush    bool
 	EnableTracing                 bool
 	EnableUnreachableRoutes       bool
 	EnvoyLog                      string
 	DisableEnvoyVersionCheck      bool
 	FixedIdentityMapping          map[string]string
 	FixedIdentityMappingValidator func(val string) (string, error) `json:"-"`
-	IPv4Range                     string
-	IPv6Range                     string
-	IPv4ServiceRange              string
-	IPv6ServiceRange              string
-	K8sSyncTimeout                time.Duration
-	AllocatorListTimeout          time.Duration
-	K8sWatcherEndpointSelector    string
-	KVStore                       string
-	KVStoreOpt                    map[string]string
-	LabelPrefixFile               string
-	Labels                        []string
-	LogDriver                     []string
-	LogOpt                        map[string]string
-	Logstash                      bool
-	LogSystemLoadConfig           bool
+
+	// CIDRZones is the list of zone specifications mapping external CIDR
+	// sets to stable user-reserved identities
+	CIDRZones []string
+
+	// CIDRZoneFeeds is the list of feed specifications refreshing zone
+	// CIDRs from published IP range feeds
+	CIDRZoneFeeds []string
+
+	// CIDRGroups is the list of named CIDR group specifications which
+	// policy rules may reference via cidrGroupRef
+	CIDRGroups []string
+
+	// QoSDSCPPolicies is the list of DSCP marking policies for egress
+	// traffic of selected identities
+	QoSDSCPPolicies []string
+
+	// EnableMultiNetwork enables awareness of secondary pod networks
+	// requested via NetworkAttachmentDefinitions, labeling endpoint
+	// identities with their network attachments so policies can be scoped
+	// by network
+	EnableMultiNetwork bool
+
+	// DropVerdictCacheTTL is the duration for which the datapath may
+	// cache and short-circuit repeated identical drop verdicts. A zero
+	// value disables the drop verdict cache.
+	DropVerdictCacheTTL time.Duration
+
+	// DatapathConfigDriftCheck enables the periodic check comparing the
+	// rendered datapath configuration against the configuration the
+	// loaded datapath was built from, reporting drift in the agent
+	// status.
+	DatapathConfigDriftCheck bool
+
+	// DatapathConfigDriftReconcile reinitializes the datapath when
+	// configuration drift is detected instead of only reporting it.
+	DatapathConfigDriftReconcile bool
+
+	// SysctlReconcile periodically re-applies the sysctl settings
+	// required by Cilium when they are reverted externally and reports
+	// drift in the agent status.
+	SysctlReconcile bool
+
+	IPv4Range                  string
+	IPv6Range                  string
+	IPv4ServiceRange           string
+	IPv6ServiceRange           string
+	K8sSyncTimeout             time.Duration
+	K8sSyncTimeoutAction       string
+	AllocatorListTimeout       time.Duration
+	K8sWatcherEndpointSelector string
+	KVStore                    string
+	KVStoreOpt                 map[string]string
+	LabelPrefixFile            string
+	Labels                     []string
+	LogDriver                  []string
+	LogOpt                     map[string]string
+	Logstash                   bool
+	LogSystemLoadConfig        bool
 
 	// Masquerade specifies whether or not to masquerade packets from endpoints
 	// leaving the host.
@@ -1652,6 +2003,7 @@ type DaemonConfig struct {
 	IPMasqAgentConfigPath      string
 	InstallIptRules            bool
 	MonitorAggregation         string
+	MonitorDropSampling        string
 	PreAllocateMaps            bool
 	IPv6NodeAddr               string
 	IPv4NodeAddr               string
@@ -1682,6 +2034,22 @@ type DaemonConfig struct {
 	// for each FQDN name in an endpoint's FQDN cache
 	ToFQDNsMaxIPsPerHost int
 
+	// ToFQDNsEndpointMaxEntries caps the number of DNS names retained in an
+	// endpoint's FQDN cache. 0 disables the cap.
+	ToFQDNsEndpointMaxEntries int
+
+	// ToFQDNsCacheEvictionPolicy selects which names are evicted first when
+	// an endpoint's FQDN cache exceeds ToFQDNsEndpointMaxEntries.
+	ToFQDNsCacheEvictionPolicy string
+
+	// ToFQDNsNamespaceIPsQuota bounds the total number of IPs tracked in
+	// the FQDN caches of a namespace's endpoints. 0 disables the quota.
+	ToFQDNsNamespaceIPsQuota int
+
+	// ToFQDNsNamespaceIPsQuotaOverrides overrides ToFQDNsNamespaceIPsQuota
+	// for individual namespaces.
+	ToFQDNsNamespaceIPsQuotaOverrides map[string]string
+
 	// ToFQDNsMaxIPsPerHost defines the maximum number of IPs to retain for
 	// expired DNS lookups with still-active connections
 	ToFQDNsMaxDeferredConnectionDeletes int
@@ -1699,6 +2067,23 @@ type DaemonConfig struct {
 	// datapath is updated with the new IP information.
 	FQDNProxyResponseMaxDelay time.Duration
 
+	// ToFQDNsProxyAsyncPolicyUpdates expands DNS responses into ToFQDNs
+	// policy asynchronously instead of delaying the response until the
+	// datapath is updated with the new IP information.
+	ToFQDNsProxyAsyncPolicyUpdates bool
+
+	// ToFQDNsPolicyUpdateBatchWindow is the window over which queued DNS
+	// responses are accumulated before being expanded into ToFQDNs policy
+	// with a single update, so that bursts of responses coalesce. 0
+	// disables batching. Only effective with
+	// ToFQDNsProxyAsyncPolicyUpdates.
+	ToFQDNsPolicyUpdateBatchWindow time.Duration
+
+	// FQDNIdentityAllocationFailureAction selects the behavior when
+	// allocating CIDR identities for a DNS response fails: release the
+	// response anyway (fail-open) or refuse it (fail-closed).
+	FQDNIdentityAllocationFailureAction string
+
 	// FQDNRegexCompileLRUSize is the size of the FQDN regex compilation LRU.
 	// Useful for heavy but repeated FQDN MatchName or MatchPattern use.
 	FQDNRegexCompileLRUSize int
@@ -1710,6 +2095,20 @@ type DaemonConfig struct {
 	// endpoints that are larger than 512 Bytes or the EDNS0 option, if present.
 	ToFQDNsEnableDNSCompression bool
 
+	// ToFQDNsEnableGlobalCache shares DNS lookups observed by this agent
+	// with all agents in the cluster via the kvstore.
+	ToFQDNsEnableGlobalCache bool
+
+	// ToFQDNsPreResolve proactively resolves toFQDNs matchNames when a
+	// policy is imported and periodically re-resolves them honoring the
+	// TTL of the responses.
+	ToFQDNsPreResolve bool
+
+	// ToFQDNsFilterResponseIPs restricts A/AAAA records in DNS responses to
+	// the IPs that are already plumbed into policy before the response is
+	// released to the endpoint.
+	ToFQDNsFilterResponseIPs bool
+
 	// DNSProxyConcurrencyLimit limits parallel processing of DNS messages in
 	// DNS proxy at any given point in time.
 	DNSProxyConcurrencyLimit int
@@ -1719,6 +2118,88 @@ type DaemonConfig struct {
 	// been reached.
 	DNSProxyConcurrencyProcessingGracePeriod time.Duration
 
+	// DNSProxyEndpointConcurrencyLimit limits parallel processing of DNS
+	// messages per endpoint in the DNS proxy at any given point in time.
+	DNSProxyEndpointConcurrencyLimit int
+
+	// DNSProxyEndpointMaxQPS is the maximum rate of DNS queries of a single
+	// endpoint handled by the DNS proxy, 0 disables the limit.
+	DNSProxyEndpointMaxQPS float64
+
+	// DNSProxyEndpointMaxBurst is the number of DNS queries of a single
+	// endpoint allowed to burst beyond DNSProxyEndpointMaxQPS.
+	DNSProxyEndpointMaxBurst int
+
+	// DNSProxyLockCount is the number of mutexes serializing the handling of
+	// DNS responses in the daemon, sharded by response IP.
+	DNSProxyLockCount int
+
+	// DNSProxyLockShardByEndpoint includes the endpoint ID in the DNS
+	// response lock shard hash, so that endpoints resolving the same names
+	// do not contend on the same shard.
+	DNSProxyLockShardByEndpoint bool
+
+	// DNSProxySocketHandover hands the DNS proxy listening sockets over to
+	// the next agent instance on restart, so that DNS resolution for
+	// endpoints with toFQDNs rules keeps working while the agent restarts.
+	DNSProxySocketHandover bool
+
+	// DNSProxyBindEndpointNetNS additionally binds the DNS proxy listening
+	// sockets inside the network namespace of each endpoint, avoiding
+	// host-namespace port conflicts and allowing the proxy to receive
+	// queries in chaining setups where the redirect approach fails.
+	DNSProxyBindEndpointNetNS bool
+
+	// DNSProxyEnableDNS0x20Encoding enables DNS 0x20 encoding of queries
+	// forwarded upstream by the DNS proxy and verification of the encoding
+	// in responses, hardening against off-path response spoofing.
+	DNSProxyEnableDNS0x20Encoding bool
+
+	// DNSProxyEDNSClientSubnetMode controls how EDNS0 Client Subnet options
+	// in queries forwarded upstream by the DNS proxy are handled: preserved,
+	// stripped or rewritten to the all-zero subnet.
+	DNSProxyEDNSClientSubnetMode string
+
+	// DNSProxyUseReservedIdentity makes connections from the DNS proxy to
+	// upstream DNS servers carry the reserved dns-proxy identity instead of
+	// the identity of the endpoint on whose behalf the query is made.
+	DNSProxyUseReservedIdentity bool
+
+	// DNSProxyEnableDNSOverTLS makes the DNS proxy forward proxied queries
+	// to upstream DNS servers over DNS-over-TLS (RFC 7858).
+	DNSProxyEnableDNSOverTLS bool
+
+	// DNSProxyDNSOverTLSServerName is the name the certificate of upstream
+	// DNS-over-TLS servers is validated against.
+	DNSProxyDNSOverTLSServerName string
+
+	// DNSProxyDNSOverTLSCAFile is a CA bundle used to validate the
+	// certificate of upstream DNS-over-TLS servers instead of the system
+	// roots.
+	DNSProxyDNSOverTLSCAFile string
+
+	// DNSProxyDNSOverTLSNamespaceUpstreams maps a namespace to the
+	// DNS-over-TLS upstream its endpoints' proxied queries are forwarded
+	// to, overriding the originally intended DNS server.
+	DNSProxyDNSOverTLSNamespaceUpstreams map[string]string
+
+	// DNSProxyDoHListenPort is the port the DNS proxy terminates
+	// DNS-over-HTTPS traffic from endpoints on. 0 disables DoH
+	// interception.
+	DNSProxyDoHListenPort int
+
+	// DNSProxyDoHCertFile is the TLS certificate the DoH listener presents
+	// to endpoints.
+	DNSProxyDoHCertFile string
+
+	// DNSProxyDoHKeyFile is the TLS key for DNSProxyDoHCertFile.
+	DNSProxyDoHKeyFile string
+
+	// DNSProxyDoHResolverIPs is the list of well-known DNS-over-HTTPS
+	// resolver IPs whose HTTPS traffic from endpoints is redirected to the
+	// DoH listener.
+	DNSProxyDoHResolverIPs []string
+
 	// EnableXTSocketFallback allows disabling of kernel's ip_early_demux
 	// sysctl option if `xt_socket` kernel module is not available.
 	EnableXTSocketFallback bool
@@ -1747,6 +2228,10 @@ type DaemonConfig struct {
 	// cilium
 	EnableHealthCheckNodePort bool
 
+	// EnableHealthCheckProxyProtocol enables accepting the PROXY protocol
+	// on connections to the healthcheck nodePort server
+	EnableHealthCheckProxyProtocol bool
+
 	// KVstoreKeepAliveInterval is the interval in which the lease is being
 	// renewed. This must be set to a value lesser than the LeaseTTL ideally
 	// by a factor of 3.
@@ -1766,6 +2251,16 @@ type DaemonConfig struct {
 	// KVstoreConnectivityTimeout is the timeout when performing kvstore operations
 	KVstoreConnectivityTimeout time.Duration
 
+	// KVstoreStartupTimeout is the timeout for the initial kvstore
+	// connection during agent startup. Zero waits indefinitely.
+	KVstoreStartupTimeout time.Duration
+
+	// KVstoreStartupAction selects the behavior when the kvstore cannot be
+	// reached within KVstoreStartupTimeout during agent startup: abort
+	// startup (fail) or continue in degraded mode and keep retrying the
+	// connection in the background (continue).
+	KVstoreStartupAction string
+
 	// IPAllocationTimeout is the timeout when allocating CIDRs
 	IPAllocationTimeout time.Duration
 
@@ -1968,6 +2463,12 @@ type DaemonConfig struct {
 
 	EnableServiceTopology bool
 
+	// ServiceTopologySpilloverThreshold is the minimum fraction of a
+	// service's backends which must remain after topology aware hints
+	// filtering. Below the threshold traffic spills over to all zones.
+	// A value of 0 disables spillover.
+	ServiceTopologySpilloverThreshold float64
+
 	// Selection of BPF main clock source (ktime vs jiffies)
 	ClockSource BPFClockSource
 
@@ -1992,6 +2493,10 @@ type DaemonConfig struct {
 	// IPAM is the IPAM method to use
 	IPAM string
 
+	// IPAMDelegateEndpoint is the gRPC endpoint of the external IPAM
+	// delegate used with the delegated-grpc IPAM mode
+	IPAMDelegateEndpoint string
+
 	// Enable chaining with another CNI plugin.
 	CNIChainingMode string
 
@@ -2116,6 +2621,10 @@ type DaemonConfig struct {
 	// HubbleSkipUnknownCGroupIDs specifies if events with unknown cgroup ids should be skipped
 	HubbleSkipUnknownCGroupIDs bool
 
+	// HubbleHostNetworkPodAttribution attributes socket events of
+	// host-network pods to the owning pod via their cgroup ids
+	HubbleHostNetworkPodAttribution bool
+
 	// EndpointStatus enables population of information in the
 	// CiliumEndpoint.Status resource
 	EndpointStatus map[string]struct{}
@@ -2234,6 +2743,11 @@ type DaemonConfig struct {
 	// EnableICMPRules enables ICMP-based rule support for Cilium Network Policies.
 	EnableICMPRules bool
 
+	// EnableNodeSelectorLabels enables ingestion of node labels into node
+	// identities, which allows FromNodes/ToNodes rules in Cilium Network
+	// Policies.
+	EnableNodeSelectorLabels bool
+
 	// BypassIPAvailabilityUponRestore bypasses the IP availability error
 	// within IPAM upon endpoint restore and allows the use of the restored IP
 	// regardless of whether it's available in the pool.
@@ -2278,43 +2792,46 @@ type DaemonConfig struct {
 var (
 	// Config represents the daemon configuration
 	Config = &DaemonConfig{
-		CreationTime:                 time.Now(),
-		Opts:                         NewIntOptions(&DaemonOptionLibrary),
-		Monitor:                      &models.MonitorStatus{Cpus: int64(runtime.NumCPU()), Npages: 64, Pagesize: int64(os.Getpagesize()), Lost: 0, Unknown: 0},
-		IPv6ClusterAllocCIDR:         defaults.IPv6ClusterAllocCIDR,
-		IPv6ClusterAllocCIDRBase:     defaults.IPv6ClusterAllocCIDRBase,
-		EnableHostIPRestore:          defaults.EnableHostIPRestore,
-		EnableHealthChecking:         defaults.EnableHealthChecking,
-		EnableEndpointHealthChecking: defaults.EnableEndpointHealthChecking,
-		EnableHealthCheckNodePort:    defaults.EnableHealthCheckNodePort,
-		EnableIPv4:                   defaults.EnableIPv4,
-		EnableIPv6:                   defaults.EnableIPv6,
-		EnableIPv6NDP:                defaults.EnableIPv6NDP,
-		EnableSCTP:                   defaults.EnableSCTP,
-		EnableL7Proxy:                defaults.EnableL7Proxy,
-		EndpointStatus:               make(map[string]struct{}),
-		DNSMaxIPsPerRestoredRule:     defaults.DNSMaxIPsPerRestoredRule,
-		ToFQDNsMaxIPsPerHost:         defaults.ToFQDNsMaxIPsPerHost,
-		KVstorePeriodicSync:          defaults.KVstorePeriodicSync,
-		KVstoreConnectivityTimeout:   defaults.KVstoreConnectivityTimeout,
-		IPAllocationTimeout:          defaults.IPAllocationTimeout,
-		IdentityChangeGracePeriod:    defaults.IdentityChangeGracePeriod,
-		IdentityRestoreGracePeriod:   defaults.IdentityRestoreGracePeriod,
-		FixedIdentityMapping:         make(map[string]string),
-		KVStoreOpt:                   make(map[string]string),
-		LogOpt:                       make(map[string]string),
-		LoopbackIPv4:                 defaults.LoopbackIPv4,
-		ForceLocalPolicyEvalAtSource: defaults.ForceLocalPolicyEvalAtSource,
-		EnableEndpointRoutes:         defaults.EnableEndpointRoutes,
-		AnnotateK8sNode:              defaults.AnnotateK8sNode,
-		K8sServiceCacheSize:          defaults.K8sServiceCacheSize,
-		AutoCreateCiliumNodeResource: defaults.AutoCreateCiliumNodeResource,
-		IdentityAllocationMode:       IdentityAllocationModeKVstore,
-		AllowICMPFragNeeded:          defaults.AllowICMPFragNeeded,
-		EnableWellKnownIdentities:    defaults.EnableWellKnownIdentities,
-		K8sEnableK8sEndpointSlice:    defaults.K8sEnableEndpointSlice,
-		AllocatorListTimeout:         defaults.AllocatorListTimeout,
-		EnableICMPRules:              defaults.EnableICMPRules,
+		CreationTime:                         time.Now(),
+		Opts:                                 NewIntOptions(&DaemonOptionLibrary),
+		Monitor:                              &models.MonitorStatus{Cpus: int64(runtime.NumCPU()), Npages: 64, Pagesize: int64(os.Getpagesize()), Lost: 0, Unknown: 0},
+		IPv6ClusterAllocCIDR:                 defaults.IPv6ClusterAllocCIDR,
+		IPv6ClusterAllocCIDRBase:             defaults.IPv6ClusterAllocCIDRBase,
+		EnableHostIPRestore:                  defaults.EnableHostIPRestore,
+		EnableHealthChecking:                 defaults.EnableHealthChecking,
+		EnableEndpointHealthChecking:         defaults.EnableEndpointHealthChecking,
+		EnableHealthCheckNodePort:            defaults.EnableHealthCheckNodePort,
+		EnableIPv4:                           defaults.EnableIPv4,
+		EnableIPv6:                           defaults.EnableIPv6,
+		EnableIPv6NDP:                        defaults.EnableIPv6NDP,
+		EnableSCTP:                           defaults.EnableSCTP,
+		EnableL7Proxy:                        defaults.EnableL7Proxy,
+		EndpointStatus:                       make(map[string]struct{}),
+		DNSMaxIPsPerRestoredRule:             defaults.DNSMaxIPsPerRestoredRule,
+		ToFQDNsMaxIPsPerHost:                 defaults.ToFQDNsMaxIPsPerHost,
+		KVstorePeriodicSync:                  defaults.KVstorePeriodicSync,
+		KVstoreConnectivityTimeout:           defaults.KVstoreConnectivityTimeout,
+		IPAllocationTimeout:                  defaults.IPAllocationTimeout,
+		IdentityChangeGracePeriod:            defaults.IdentityChangeGracePeriod,
+		IdentityRestoreGracePeriod:           defaults.IdentityRestoreGracePeriod,
+		FixedIdentityMapping:                 make(map[string]string),
+		KVStoreOpt:                           make(map[string]string),
+		LogOpt:                               make(map[string]string),
+		DNSProxyDNSOverTLSNamespaceUpstreams: make(map[string]string),
+		ToFQDNsNamespaceIPsQuotaOverrides:    make(map[string]string),
+		LoopbackIPv4:                         defaults.LoopbackIPv4,
+		ForceLocalPolicyEvalAtSource:         defaults.ForceLocalPolicyEvalAtSource,
+		EnableEndpointRoutes:                 defaults.EnableEndpointRoutes,
+		AnnotateK8sNode:                      defaults.AnnotateK8sNode,
+		K8sServiceCacheSize:                  defaults.K8sServiceCacheSize,
+		AutoCreateCiliumNodeResource:         defaults.AutoCreateCiliumNodeResource,
+		IdentityAllocationMode:               IdentityAllocationModeKVstore,
+		AllowICMPFragNeeded:                  defaults.AllowICMPFragNeeded,
+		EnableWellKnownIdentities:            defaults.EnableWellKnownIdentities,
+		K8sEnableK8sEndpointSlice:            defaults.K8sEnableEndpointSlice,
+		AllocatorListTimeout:                 defaults.AllocatorListTimeout,
+		EnableICMPRules:                      defaults.EnableICMPRules,
+		EnableNodeSelectorLabels:             defaults.EnableNodeSelectorLabels,
 
 		K8sEnableLeasesFallbackDiscovery: defaults.K8sEnableLeasesFallbackDiscovery,
 		APIRateLimit:                     make(map[string]string),
@@ -2787,6 +3304,10 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EnableL2NeighDiscovery = vp.GetBool(EnableL2NeighDiscovery)
 	c.AutoCreateCiliumNodeResource = vp.GetBool(AutoCreateCiliumNodeResource)
 	c.BPFRoot = vp.GetString(BPFRoot)
+	c.BPFOrphanGCInterval = vp.GetDuration(BPFOrphanGCInterval)
+	c.BPFOrphanGCDryRun = vp.GetBool(BPFOrphanGCDryRun)
+	c.LBMapVerifyInterval = vp.GetDuration(LBMapVerifyInterval)
+	c.LBMapVerifyRepair = vp.GetBool(LBMapVerifyRepair)
 	c.CertDirectory = vp.GetString(CertsDirectory)
 	c.CGroupRoot = vp.GetString(CGroupRoot)
 	c.ClusterID = vp.GetUint32(ClusterIDName)
@@ -2806,12 +3327,24 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.SRv6EncapMode = vp.GetString(SRv6EncapModeName)
 	c.EnableSCTP = vp.GetBool(EnableSCTPName)
 	c.IPv6MCastDevice = vp.GetString(IPv6MCastDevice)
+	c.NDPProxyDevices = vp.GetStringSlice(NDPProxyDevices)
 	c.EnableIPSec = vp.GetBool(EnableIPSecName)
 	c.EnableWireguard = vp.GetBool(EnableWireguard)
 	c.EnableWireguardUserspaceFallback = vp.GetBool(EnableWireguardUserspaceFallback)
+
+	encryptionBypassIDs := vp.GetStringSlice(EncryptionBypassIdentities)
+	c.EncryptionBypassIdentities = make([]int, 0, len(encryptionBypassIDs))
+	for _, idStr := range encryptionBypassIDs {
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			log.WithError(err).Fatalf("Cannot parse security identity integer from --%s option", EncryptionBypassIdentities)
+		}
+		c.EncryptionBypassIdentities = append(c.EncryptionBypassIdentities, id)
+	}
 	c.EnableWellKnownIdentities = vp.GetBool(EnableWellKnownIdentities)
 	c.EnableXDPPrefilter = vp.GetBool(EnableXDPPrefilter)
 	c.DisableCiliumEndpointCRD = vp.GetBool(DisableCiliumEndpointCRDName)
+	c.EnablePodNetworkReadinessGate = vp.GetBool(EnablePodNetworkReadinessGate)
 	c.EgressMasqueradeInterfaces = vp.GetString(EgressMasqueradeInterfaces)
 	c.BPFSocketLBHostnsOnly = vp.GetBool(BPFSocketLBHostnsOnly)
 	c.EnableSocketLB = vp.GetBool(EnableHostReachableServices) || vp.GetBool(EnableSocketLB)
@@ -2824,8 +3357,10 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EnableHealthChecking = vp.GetBool(EnableHealthChecking)
 	c.EnableEndpointHealthChecking = vp.GetBool(EnableEndpointHealthChecking)
 	c.EnableHealthCheckNodePort = vp.GetBool(EnableHealthCheckNodePort)
+	c.EnableHealthCheckProxyProtocol = vp.GetBool(EnableHealthCheckProxyProtocol)
 	c.EnableLocalNodeRoute = vp.GetBool(EnableLocalNodeRoute)
 	c.EnablePolicy = strings.ToLower(vp.GetString(EnablePolicy))
+	c.EnablePolicyConntrackFlush = vp.GetBool(EnablePolicyConntrackFlush)
 	c.EnableExternalIPs = vp.GetBool(EnableExternalIPs)
 	c.EnableL7Proxy = vp.GetBool(EnableL7Proxy)
 	c.EnableTracing = vp.GetBool(EnableTracing)
@@ -2841,6 +3376,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.KubeProxyReplacement = vp.GetString(KubeProxyReplacement)
 	c.EnableSessionAffinity = vp.GetBool(EnableSessionAffinity)
 	c.EnableServiceTopology = vp.GetBool(EnableServiceTopology)
+	c.ServiceTopologySpilloverThreshold = vp.GetFloat64(ServiceTopologySpilloverThreshold)
 	c.EnableBandwidthManager = vp.GetBool(EnableBandwidthManager)
 	c.EnableBBR = vp.GetBool(EnableBBR)
 	c.EnableRecorder = vp.GetBool(EnableRecorder)
@@ -2861,6 +3397,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.IdentityChangeGracePeriod = vp.GetDuration(IdentityChangeGracePeriod)
 	c.IdentityRestoreGracePeriod = vp.GetDuration(IdentityRestoreGracePeriod)
 	c.IPAM = vp.GetString(IPAM)
+	c.IPAMDelegateEndpoint = vp.GetString(IPAMDelegateEndpoint)
 	c.IPv4Range = vp.GetString(IPv4Range)
 	c.IPv4NodeAddr = vp.GetString(IPv4NodeAddr)
 	c.IPv4ServiceRange = vp.GetString(IPv4ServiceRange)
@@ -2875,6 +3412,17 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.K8sServiceCacheSize = uint(vp.GetInt(K8sServiceCacheSize))
 	c.K8sEventHandover = vp.GetBool(K8sEventHandover)
 	c.K8sSyncTimeout = vp.GetDuration(K8sSyncTimeoutName)
+	c.K8sSyncTimeoutAction = vp.GetString(K8sSyncTimeoutActionName)
+	switch c.K8sSyncTimeoutAction {
+	case StartupActionFail, StartupActionContinue:
+	case "":
+		// Not all binaries populating the configuration register the
+		// flag, in which case the key is unset and the default applies.
+		c.K8sSyncTimeoutAction = StartupActionFail
+	default:
+		log.Fatalf("Invalid value for --%s: %s (must be one of %v)",
+			K8sSyncTimeoutActionName, c.K8sSyncTimeoutAction, StartupActionOptions)
+	}
 	c.AllocatorListTimeout = vp.GetDuration(AllocatorListTimeoutName)
 	c.K8sWatcherEndpointSelector = vp.GetString(K8sWatcherEndpointSelector)
 	c.KeepConfig = vp.GetBool(KeepConfig)
@@ -2883,6 +3431,16 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.KVstoreKeepAliveInterval = c.KVstoreLeaseTTL / defaults.KVstoreKeepAliveIntervalFactor
 	c.KVstorePeriodicSync = vp.GetDuration(KVstorePeriodicSync)
 	c.KVstoreConnectivityTimeout = vp.GetDuration(KVstoreConnectivityTimeout)
+	c.KVstoreStartupTimeout = vp.GetDuration(KVstoreStartupTimeout)
+	c.KVstoreStartupAction = vp.GetString(KVstoreStartupAction)
+	switch c.KVstoreStartupAction {
+	case StartupActionFail, StartupActionContinue:
+	case "":
+		c.KVstoreStartupAction = StartupActionFail
+	default:
+		log.Fatalf("Invalid value for --%s: %s (must be one of %v)",
+			KVstoreStartupAction, c.KVstoreStartupAction, StartupActionOptions)
+	}
 	c.KVstoreMaxConsecutiveQuorumErrors = vp.GetInt(KVstoreMaxConsecutiveQuorumErrorsName)
 	c.IPAllocationTimeout = vp.GetDuration(IPAllocationTimeout)
 	c.LabelPrefixFile = vp.GetString(LabelPrefixFile)
@@ -2909,7 +3467,10 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EnableMonitor = vp.GetBool(EnableMonitorName)
 	c.MonitorAggregation = vp.GetString(MonitorAggregationName)
 	c.MonitorAggregationInterval = vp.GetDuration(MonitorAggregationInterval)
+	c.MonitorDropSampling = vp.GetString(MonitorDropSampling)
 	c.MonitorQueueSize = vp.GetInt(MonitorQueueSizeName)
+	c.IPFIXCollectorAddress = vp.GetString(IPFIXCollectorAddress)
+	c.IPFIXSampleRate = vp.GetInt(IPFIXSampleRate)
 	c.MTU = vp.GetInt(MTUName)
 	c.PProf = vp.GetBool(PProf)
 	c.PProfPort = vp.GetInt(PProfPort)
@@ -3064,7 +3625,17 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.DNSMaxIPsPerRestoredRule = vp.GetInt(DNSMaxIPsPerRestoredRule)
 	c.DNSPolicyUnloadOnShutdown = vp.GetBool(DNSPolicyUnloadOnShutdown)
 	c.FQDNRegexCompileLRUSize = vp.GetInt(FQDNRegexCompileLRUSize)
+	c.ToFQDNsProxyAsyncPolicyUpdates = vp.GetBool(ToFQDNsProxyAsyncPolicyUpdates)
+	c.ToFQDNsPolicyUpdateBatchWindow = vp.GetDuration(ToFQDNsPolicyUpdateBatchWindow)
 	c.ToFQDNsMaxIPsPerHost = vp.GetInt(ToFQDNsMaxIPsPerHost)
+	c.ToFQDNsEndpointMaxEntries = vp.GetInt(ToFQDNsEndpointMaxEntries)
+	c.ToFQDNsCacheEvictionPolicy = vp.GetString(ToFQDNsCacheEvictionPolicy)
+	c.ToFQDNsNamespaceIPsQuota = vp.GetInt(ToFQDNsNamespaceIPsQuota)
+	if m, err := command.GetStringMapStringE(vp, ToFQDNsNamespaceIPsQuotaOverrides); err != nil {
+		log.Fatalf("unable to parse %s: %s", ToFQDNsNamespaceIPsQuotaOverrides, err)
+	} else {
+		c.ToFQDNsNamespaceIPsQuotaOverrides = m
+	}
 	if maxZombies := vp.GetInt(ToFQDNsMaxDeferredConnectionDeletes); maxZombies >= 0 {
 		c.ToFQDNsMaxDeferredConnectionDeletes = vp.GetInt(ToFQDNsMaxDeferredConnectionDeletes)
 	} else {
@@ -3080,8 +3651,33 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.ToFQDNsProxyPort = vp.GetInt(ToFQDNsProxyPort)
 	c.ToFQDNsPreCache = vp.GetString(ToFQDNsPreCache)
 	c.ToFQDNsEnableDNSCompression = vp.GetBool(ToFQDNsEnableDNSCompression)
+	c.ToFQDNsEnableGlobalCache = vp.GetBool(ToFQDNsEnableGlobalCache)
+	c.ToFQDNsPreResolve = vp.GetBool(ToFQDNsPreResolve)
+	c.ToFQDNsFilterResponseIPs = vp.GetBool(ToFQDNsFilterResponseIPs)
 	c.DNSProxyConcurrencyLimit = vp.GetInt(DNSProxyConcurrencyLimit)
 	c.DNSProxyConcurrencyProcessingGracePeriod = vp.GetDuration(DNSProxyConcurrencyProcessingGracePeriod)
+	c.DNSProxyEndpointConcurrencyLimit = vp.GetInt(DNSProxyEndpointConcurrencyLimit)
+	c.DNSProxyEndpointMaxQPS = vp.GetFloat64(DNSProxyEndpointMaxQPS)
+	c.DNSProxyEndpointMaxBurst = vp.GetInt(DNSProxyEndpointMaxBurst)
+	c.DNSProxyLockCount = vp.GetInt(DNSProxyLockCount)
+	c.DNSProxyLockShardByEndpoint = vp.GetBool(DNSProxyLockShardByEndpoint)
+	c.DNSProxySocketHandover = vp.GetBool(DNSProxySocketHandover)
+	c.DNSProxyBindEndpointNetNS = vp.GetBool(DNSProxyBindEndpointNetNS)
+	c.DNSProxyEnableDNS0x20Encoding = vp.GetBool(DNSProxyEnableDNS0x20Encoding)
+	c.DNSProxyEDNSClientSubnetMode = vp.GetString(DNSProxyEDNSClientSubnetMode)
+	c.DNSProxyUseReservedIdentity = vp.GetBool(DNSProxyUseReservedIdentity)
+	c.DNSProxyEnableDNSOverTLS = vp.GetBool(DNSProxyEnableDNSOverTLS)
+	c.DNSProxyDNSOverTLSServerName = vp.GetString(DNSProxyDNSOverTLSServerName)
+	c.DNSProxyDNSOverTLSCAFile = vp.GetString(DNSProxyDNSOverTLSCAFile)
+	if m, err := command.GetStringMapStringE(vp, DNSProxyDNSOverTLSNamespaceUpstreams); err != nil {
+		log.Fatalf("unable to parse %s: %s", DNSProxyDNSOverTLSNamespaceUpstreams, err)
+	} else {
+		c.DNSProxyDNSOverTLSNamespaceUpstreams = m
+	}
+	c.DNSProxyDoHListenPort = vp.GetInt(DNSProxyDoHListenPort)
+	c.DNSProxyDoHCertFile = vp.GetString(DNSProxyDoHCertFile)
+	c.DNSProxyDoHKeyFile = vp.GetString(DNSProxyDoHKeyFile)
+	c.DNSProxyDoHResolverIPs = vp.GetStringSlice(DNSProxyDoHResolverIPs)
 
 	// Convert IP strings into net.IPNet types
 	subnets, invalid := ip.ParseCIDRs(vp.GetStringSlice(IPv4PodSubnets))
@@ -3134,6 +3730,16 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 		c.FixedIdentityMapping = m
 	}
 
+	c.CIDRZones = vp.GetStringSlice(CIDRZone)
+	c.CIDRZoneFeeds = vp.GetStringSlice(CIDRZoneFeed)
+	c.CIDRGroups = vp.GetStringSlice(CIDRGroup)
+	c.QoSDSCPPolicies = vp.GetStringSlice(QoSDSCPPolicy)
+	c.EnableMultiNetwork = vp.GetBool(EnableMultiNetwork)
+	c.DropVerdictCacheTTL = vp.GetDuration(DropVerdictCacheTTL)
+	c.DatapathConfigDriftCheck = vp.GetBool(DatapathConfigDriftCheck)
+	c.DatapathConfigDriftReconcile = vp.GetBool(DatapathConfigDriftReconcile)
+	c.SysctlReconcile = vp.GetBool(SysctlReconcile)
+
 	c.ConntrackGCInterval = vp.GetDuration(ConntrackGCInterval)
 
 	if m, err := command.GetStringMapStringE(vp, KVStoreOpt); err != nil {
@@ -3197,11 +3803,12 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	case "":
 		c.IdentityAllocationMode = IdentityAllocationModeKVstore
 
-	case IdentityAllocationModeKVstore, IdentityAllocationModeCRD:
+	case IdentityAllocationModeKVstore, IdentityAllocationModeCRD,
+		IdentityAllocationModeDoubleWriteReadKVstore, IdentityAllocationModeDoubleWriteReadCRD:
 		// c.IdentityAllocationMode is set above
 
 	default:
-		log.Fatalf("Invalid identity allocation mode %q. It must be one of %s or %s", c.IdentityAllocationMode, IdentityAllocationModeKVstore, IdentityAllocationModeCRD)
+		log.Fatalf("Invalid identity allocation mode %q. It must be one of %s, %s, %s or %s", c.IdentityAllocationMode, IdentityAllocationModeKVstore, IdentityAllocationModeCRD, IdentityAllocationModeDoubleWriteReadKVstore, IdentityAllocationModeDoubleWriteReadCRD)
 	}
 	if c.KVStore == "" {
 		if c.IdentityAllocationMode != IdentityAllocationModeCRD {
@@ -3256,6 +3863,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.HubbleRecorderStoragePath = vp.GetString(HubbleRecorderStoragePath)
 	c.HubbleRecorderSinkQueueSize = vp.GetInt(HubbleRecorderSinkQueueSize)
 	c.HubbleSkipUnknownCGroupIDs = vp.GetBool(HubbleSkipUnknownCGroupIDs)
+	c.HubbleHostNetworkPodAttribution = vp.GetBool(HubbleHostNetworkPodAttribution)
 
 	c.DisableIptablesFeederRules = vp.GetStringSlice(DisableIptablesFeederRules)
 	c.EnableCiliumEndpointSlice = vp.GetBool(EnableCiliumEndpointSlice)
@@ -3272,6 +3880,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EndpointGCInterval = vp.GetDuration(EndpointGCInterval)
 	c.DisableCNPStatusUpdates = vp.GetBool(DisableCNPStatusUpdates)
 	c.EnableICMPRules = vp.GetBool(EnableICMPRules)
+	c.EnableNodeSelectorLabels = vp.GetBool(EnableNodeSelectorLabels)
 	c.BypassIPAvailabilityUponRestore = vp.GetBool(BypassIPAvailabilityUponRestore)
 	c.EnableK8sTerminatingEndpoint = vp.GetBool(EnableK8sTerminatingEndpoint)
 
@@ -3296,9 +3905,12 @@ func (c *DaemonConfig) additionalMetrics() []string {
 		return "+" + metrics.Namespace + "_" + name
 	}
 	var m []string
-	if c.DNSProxyConcurrencyLimit > 0 {
+	if c.DNSProxyConcurrencyLimit > 0 || c.DNSProxyEndpointConcurrencyLimit > 0 {
 		m = append(m, addMetric(metrics.SubsystemFQDN+"_semaphore_rejected_total"))
 	}
+	if c.DNSProxyEnableDNS0x20Encoding {
+		m = append(m, addMetric(metrics.SubsystemFQDN+"_response_mismatch_total"))
+	}
 	return m
 }
 
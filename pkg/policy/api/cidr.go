@@ -36,10 +36,17 @@ func (c CIDR) MatchesAll() bool {
 // communication  is allowed, along with an optional list of subnets within that
 // CIDR prefix to/from which outside communication is not allowed.
 type CIDRRule struct {
-	// CIDR is a CIDR prefix / IP Block.
+	// CIDR is a CIDR prefix / IP Block. Required unless CIDRGroupRef is
+	// set.
 	//
-	// +kubebuilder:validation:Required
-	Cidr CIDR `json:"cidr"`
+	// +kubebuilder:validation:Optional
+	Cidr CIDR `json:"cidr,omitempty"`
+
+	// CIDRGroupRef is a reference to a named CIDR group from which the list
+	// of prefixes is taken. Only one of Cidr or CIDRGroupRef may be set.
+	//
+	// +kubebuilder:validation:Optional
+	CIDRGroupRef CIDRGroupRef `json:"cidrGroupRef,omitempty"`
 
 	// ExceptCIDRs is a list of IP blocks which the endpoint subject to the rule
 	// is not allowed to initiate connections to. These CIDR prefixes should be
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// CIDRGroupRef is a reference to a named CIDR group. A CIDRRule may reference
+// a group instead of an inline CIDR prefix; the reference is expanded to the
+// group's members when the rule is sanitized.
+type CIDRGroupRef string
+
+var cidrGroups = struct {
+	lock.RWMutex
+	groups map[CIDRGroupRef][]CIDR
+}{
+	groups: map[CIDRGroupRef][]CIDR{},
+}
+
+// SetCIDRGroup sets the members of the named CIDR group, replacing any
+// previous members. Rules referencing the group pick up the new members on
+// their next import.
+func SetCIDRGroup(name CIDRGroupRef, cidrs []CIDR) {
+	cidrGroups.Lock()
+	defer cidrGroups.Unlock()
+	cidrGroups.groups[name] = cidrs
+}
+
+// DeleteCIDRGroup removes the named CIDR group.
+func DeleteCIDRGroup(name CIDRGroupRef) {
+	cidrGroups.Lock()
+	defer cidrGroups.Unlock()
+	delete(cidrGroups.groups, name)
+}
+
+// ParseCIDRGroupSpec parses a CIDR group specification of the form
+// <name>=<cidr>[;<cidr>...], e.g. "corp=10.0.0.0/8;172.16.0.0/12".
+func ParseCIDRGroupSpec(spec string) (CIDRGroupRef, []CIDR, error) {
+	name, cidrList, ok := strings.Cut(spec, "=")
+	if !ok || name == "" {
+		return "", nil, fmt.Errorf("invalid CIDR group specification %q, expected <name>=<cidr>[;<cidr>...]", spec)
+	}
+
+	var cidrs []CIDR
+	for _, c := range strings.Split(cidrList, ";") {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(c); err != nil {
+			return "", nil, fmt.Errorf("invalid CIDR %q for CIDR group %s: %w", c, name, err)
+		}
+		cidrs = append(cidrs, CIDR(c))
+	}
+	if len(cidrs) == 0 {
+		return "", nil, fmt.Errorf("CIDR group %s does not specify any CIDRs", name)
+	}
+
+	return CIDRGroupRef(name), cidrs, nil
+}
+
+// GetCIDRGroup returns the members of the named CIDR group.
+func GetCIDRGroup(name CIDRGroupRef) ([]CIDR, bool) {
+	cidrGroups.RLock()
+	defer cidrGroups.RUnlock()
+	cidrs, ok := cidrGroups.groups[name]
+	return cidrs, ok
+}
+
+// ExpandCIDRGroupRefs returns a copy of the slice in which entries referencing
+// a named CIDR group are replaced by one generated CIDRRule per group member.
+// Except clauses of a referencing entry are carried over to the members which
+// contain them. The slice is returned unchanged, preserving nil, when no
+// entry carries a group reference.
+func (s CIDRRuleSlice) ExpandCIDRGroupRefs() (CIDRRuleSlice, error) {
+	hasRefs := false
+	for _, rule := range s {
+		if rule.CIDRGroupRef != "" {
+			hasRefs = true
+			break
+		}
+	}
+	if !hasRefs {
+		return s, nil
+	}
+
+	expanded := make(CIDRRuleSlice, 0, len(s))
+	for _, rule := range s {
+		if rule.CIDRGroupRef == "" {
+			expanded = append(expanded, rule)
+			continue
+		}
+		if rule.Cidr != "" {
+			return nil, fmt.Errorf("only one of cidr or cidrGroupRef may be set")
+		}
+
+		cidrs, ok := GetCIDRGroup(rule.CIDRGroupRef)
+		if !ok {
+			return nil, fmt.Errorf("unknown CIDR group %q", rule.CIDRGroupRef)
+		}
+
+		for _, cidr := range cidrs {
+			member := CIDRRule{
+				Cidr:      cidr,
+				Generated: true,
+			}
+			for _, except := range rule.ExceptCIDRs {
+				if cidrContains(cidr, except) {
+					member.ExceptCIDRs = append(member.ExceptCIDRs, except)
+				}
+			}
+			expanded = append(expanded, member)
+		}
+	}
+	return expanded, nil
+}
+
+// cidrContains returns true if the exception prefix falls within the given
+// CIDR prefix.
+func cidrContains(cidr, except CIDR) bool {
+	_, cidrNet, err := net.ParseCIDR(string(cidr))
+	if err != nil {
+		return false
+	}
+	exceptAddr, _, err := net.ParseCIDR(string(except))
+	if err != nil {
+		return false
+	}
+	return cidrNet.Contains(exceptAddr)
+}
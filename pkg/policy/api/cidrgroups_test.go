@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+//go:build !privileged_tests
+
+package api
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/checker"
+)
+
+func (s *PolicyAPITestSuite) TestExpandCIDRGroupRefs(c *C) {
+	SetCIDRGroup("corp", []CIDR{"10.0.0.0/8", "172.16.0.0/12"})
+	defer DeleteCIDRGroup("corp")
+
+	rules := CIDRRuleSlice{
+		{CIDRGroupRef: "corp", ExceptCIDRs: []CIDR{"10.1.0.0/16"}},
+		{Cidr: "192.168.0.0/16"},
+	}
+
+	expanded, err := rules.ExpandCIDRGroupRefs()
+	c.Assert(err, IsNil)
+	c.Assert(expanded, HasLen, 3)
+
+	// Members of the group are marked as generated and except clauses are
+	// only carried over to the member containing them.
+	c.Assert(expanded[0].Cidr, Equals, CIDR("10.0.0.0/8"))
+	c.Assert(expanded[0].Generated, Equals, true)
+	c.Assert(expanded[0].ExceptCIDRs, checker.DeepEquals, []CIDR{"10.1.0.0/16"})
+	c.Assert(expanded[1].Cidr, Equals, CIDR("172.16.0.0/12"))
+	c.Assert(expanded[1].ExceptCIDRs, HasLen, 0)
+
+	// Inline rules are passed through unchanged.
+	c.Assert(expanded[2].Cidr, Equals, CIDR("192.168.0.0/16"))
+	c.Assert(expanded[2].Generated, Equals, false)
+}
+
+func (s *PolicyAPITestSuite) TestExpandCIDRGroupRefsErrors(c *C) {
+	// Unknown group
+	_, err := CIDRRuleSlice{{CIDRGroupRef: "unknown"}}.ExpandCIDRGroupRefs()
+	c.Assert(err, NotNil)
+
+	// Cidr and CIDRGroupRef are mutually exclusive
+	SetCIDRGroup("corp", []CIDR{"10.0.0.0/8"})
+	defer DeleteCIDRGroup("corp")
+	_, err = CIDRRuleSlice{{Cidr: "10.0.0.0/8", CIDRGroupRef: "corp"}}.ExpandCIDRGroupRefs()
+	c.Assert(err, NotNil)
+}
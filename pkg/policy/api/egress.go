@@ -74,6 +74,19 @@ type EgressCommonRule struct {
 	// +kubebuilder:validation:Optional
 	ToEntities EntitySlice `json:"toEntities,omitempty"`
 
+	// ToNodes is a list of nodes identified by an EndpointSelector to
+	// which the endpoints subject to the rule are allowed to communicate.
+	// The selectors are matched against the labels of the node, prefixed
+	// with the "node" source. This requires the agent to run with the
+	// "enable-node-selector-labels" flag set.
+	//
+	// Example:
+	// Any endpoint with the label "role=trainer" is allowed to initiate
+	// connections to nodes carrying the label "pool=gpu".
+	//
+	// +kubebuilder:validation:Optional
+	ToNodes []EndpointSelector `json:"toNodes,omitempty"`
+
 	// ToServices is a list of services to which the endpoint subject
 	// to the rule is allowed to initiate connections.
 	// Currently Cilium only supports toServices for K8s services without
@@ -116,9 +129,9 @@ type EgressCommonRule struct {
 //     rule is the ToRequires member; the effects of any Requires field in any
 //     rule will apply to all other rules as well.
 //
-//   - ToEndpoints, ToCIDR, ToCIDRSet, ToEntities, ToServices and ToGroups are
-//     mutually exclusive. Only one of these members may be present within an
-//     individual rule.
+//   - ToEndpoints, ToCIDR, ToCIDRSet, ToEntities, ToNodes, ToServices and
+//     ToGroups are mutually exclusive. Only one of these members may be
+//     present within an individual rule.
 type EgressRule struct {
 	EgressCommonRule `json:",inline"`
 
@@ -182,9 +195,9 @@ type EgressRule struct {
 //     rule is the ToRequires member; the effects of any Requires field in any
 //     rule will apply to all other rules as well.
 //
-//   - ToEndpoints, ToCIDR, ToCIDRSet, ToEntities, ToServices and ToGroups are
-//     mutually exclusive. Only one of these members may be present within an
-//     individual rule.
+//   - ToEndpoints, ToCIDR, ToCIDRSet, ToEntities, ToNodes, ToServices and
+//     ToGroups are mutually exclusive. Only one of these members may be
+//     present within an individual rule.
 type EgressDenyRule struct {
 	EgressCommonRule `json:",inline"`
 
@@ -285,7 +298,7 @@ func (e *EgressCommonRule) getDestinationEndpointSelectorsWithRequirements(
 	requirements []slim_metav1.LabelSelectorRequirement,
 ) EndpointSelectorSlice {
 
-	res := make(EndpointSelectorSlice, 0, len(e.ToEndpoints)+len(e.aggregatedSelectors))
+	res := make(EndpointSelectorSlice, 0, len(e.ToEndpoints)+len(e.ToNodes)+len(e.aggregatedSelectors))
 
 	if len(requirements) > 0 && len(e.ToEndpoints) > 0 {
 		for idx := range e.ToEndpoints {
@@ -300,6 +313,11 @@ func (e *EgressCommonRule) getDestinationEndpointSelectorsWithRequirements(
 	} else {
 		res = append(res, e.ToEndpoints...)
 	}
+
+	// ToRequires only constrains endpoint peers, node selectors are added
+	// without folding the requirements in.
+	res = append(res, e.ToNodes...)
+
 	return append(res, e.aggregatedSelectors...)
 }
 
@@ -12,7 +12,7 @@ import (
 // individual identities.  Entities are used to describe "outside of cluster",
 // "host", etc.
 //
-// +kubebuilder:validation:Enum=all;world;cluster;host;init;ingress;unmanaged;remote-node;health;none;kube-apiserver
+// +kubebuilder:validation:Enum=all;world;cluster;host;init;ingress;unmanaged;remote-node;health;none;kube-apiserver;dns-proxy
 type Entity string
 
 const (
@@ -50,6 +50,10 @@ const (
 
 	// EntityNone is an entity that represents the kube-apiserver.
 	EntityKubeAPIServer Entity = "kube-apiserver"
+
+	// EntityDNSProxy is an entity that represents upstream DNS traffic
+	// originated by the DNS proxy.
+	EntityDNSProxy Entity = "dns-proxy"
 )
 
 var (
@@ -71,6 +75,8 @@ var (
 
 	endpointSelectorKubeAPIServer = NewESFromLabels(labels.LabelKubeAPIServer[labels.IDNameKubeAPIServer])
 
+	endpointSelectorDNSProxy = NewESFromLabels(labels.NewLabel(labels.IDNameDNSProxy, "", labels.LabelSourceReserved))
+
 	// EntitySelectorMapping maps special entity names that come in
 	// policies to selectors
 	EntitySelectorMapping = map[Entity]EndpointSelectorSlice{
@@ -84,6 +90,7 @@ var (
 		EntityUnmanaged:     {endpointSelectorUnmanaged},
 		EntityNone:          {EndpointSelectorNone},
 		EntityKubeAPIServer: {endpointSelectorKubeAPIServer},
+		EntityDNSProxy:      {endpointSelectorDNSProxy},
 
 		// EntityCluster is populated with an empty entry to allow the
 		// cilium client importing this package to perform basic rule
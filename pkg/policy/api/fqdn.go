@@ -5,7 +5,9 @@ package api
 
 import (
 	"fmt"
+	"net"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/cilium/cilium/pkg/fqdn/dns"
@@ -60,6 +62,24 @@ type FQDNSelector struct {
 	//
 	// +kubebuilder:validation:Pattern=`^([-a-zA-Z0-9_*]+[.]?)+$`
 	MatchPattern string `json:"matchPattern,omitempty"`
+
+	// MinTTL is the time, in seconds, that DNS mappings matching this
+	// selector are retained for at least, overriding the global
+	// tofqdns-min-ttl value for the matched names. This allows pinning
+	// long-lived mappings for applications holding connections open.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MinTTL uint32 `json:"minTTL,omitempty"`
+
+	// MaxTTL is the time, in seconds, that DNS mappings matching this
+	// selector are retained for at most, expiring mappings aggressively
+	// even when the DNS response carries a larger TTL. When both are set,
+	// MaxTTL must not be smaller than MinTTL.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxTTL uint32 `json:"maxTTL,omitempty"`
 }
 
 func (s *FQDNSelector) String() string {
@@ -71,6 +91,14 @@ func (s *FQDNSelector) String() string {
 	str.WriteString(s.MatchName)
 	str.WriteString(mm)
 	str.WriteString(s.MatchPattern)
+	if s.MinTTL != 0 {
+		str.WriteString(", MinTTL: ")
+		str.WriteString(strconv.FormatUint(uint64(s.MinTTL), 10))
+	}
+	if s.MaxTTL != 0 {
+		str.WriteString(", MaxTTL: ")
+		str.WriteString(strconv.FormatUint(uint64(s.MaxTTL), 10))
+	}
 	return str.String()
 }
 
@@ -88,6 +116,9 @@ func (s *FQDNSelector) sanitize() error {
 	if len(s.MatchPattern) > 0 && !allowedPatternChars.MatchString(s.MatchPattern) {
 		return fmt.Errorf("Invalid characters in MatchPattern: \"%s\". Only 0-9, a-z, A-Z and ., - and * characters are allowed", s.MatchPattern)
 	}
+	if s.MaxTTL != 0 && s.MaxTTL < s.MinTTL {
+		return fmt.Errorf("MaxTTL %d must not be smaller than MinTTL %d", s.MaxTTL, s.MinTTL)
+	}
 	_, err := matchpattern.Validate(s.MatchPattern)
 	return err
 }
@@ -108,7 +139,43 @@ func (s *FQDNSelector) ToRegex() (*regexp.Regexp, error) {
 }
 
 // PortRuleDNS is a list of allowed DNS lookups.
-type PortRuleDNS FQDNSelector
+type PortRuleDNS struct {
+	// MatchName matches literal DNS names. A trailing "." is automatically added
+	// when missing.
+	//
+	// +kubebuilder:validation:Pattern=`^([-a-zA-Z0-9_]+[.]?)+$`
+	MatchName string `json:"matchName,omitempty"`
+
+	// MatchPattern allows using wildcards to match DNS names. All wildcards are
+	// case insensitive. The wildcards are:
+	// - "*" matches 0 or more DNS valid characters, and may occur anywhere in
+	// the pattern. As a special case a "*" as the leftmost character, without a
+	// following "." matches all subdomains as well as the name to the right.
+	// A trailing "." is automatically added when missing.
+	//
+	// Examples:
+	// `*.cilium.io` matches subomains of cilium at that level
+	//   www.cilium.io and blog.cilium.io match, cilium.io and google.com do not
+	// `*cilium.io` matches cilium.io and all subdomains ends with "cilium.io"
+	//   except those containing "." separator, subcilium.io and sub-cilium.io match,
+	//   www.cilium.io and blog.cilium.io does not
+	// sub*.cilium.io matches subdomains of cilium where the subdomain component
+	// begins with "sub"
+	//   sub.cilium.io and subdomain.cilium.io match, www.cilium.io,
+	//   blog.cilium.io, cilium.io and google.com do not
+	//
+	// +kubebuilder:validation:Pattern=`^([-a-zA-Z0-9_*]+[.]?)+$`
+	MatchPattern string `json:"matchPattern,omitempty"`
+
+	// AllowedCIDRs restricts the addresses that responses matching this rule
+	// may carry. A and AAAA records with an address outside of all listed
+	// CIDRs are stripped from the response before it is delivered to the
+	// endpoint, so that the endpoint never learns the disallowed addresses.
+	// An empty list leaves responses unmodified.
+	//
+	// +kubebuilder:validation:Optional
+	AllowedCIDRs []CIDR `json:"allowedCIDRs,omitempty"`
+}
 
 // Sanitize checks that the matchName in the portRule can be compiled as a
 // regex. It does not check that a DNS name is a valid DNS name.
@@ -120,6 +187,13 @@ func (r *PortRuleDNS) Sanitize() error {
 	if len(r.MatchPattern) > 0 && !allowedPatternChars.MatchString(r.MatchPattern) {
 		return fmt.Errorf("Invalid characters in MatchPattern: \"%s\". Only 0-9, a-z, A-Z and ., - and * characters are allowed", r.MatchPattern)
 	}
+
+	for _, cidr := range r.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(string(cidr)); err != nil {
+			return fmt.Errorf("Unable to parse AllowedCIDRs entry %q: %s", cidr, err)
+		}
+	}
+
 	_, err := matchpattern.Validate(r.MatchPattern)
 	return err
 }
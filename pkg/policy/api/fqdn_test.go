@@ -22,6 +22,9 @@ func (s *PolicyAPITestSuite) TestFQDNSelectorSanitize(c *C) {
 		{MatchPattern: "*._cilium.io"},
 		{MatchPattern: "*cilium.io"},
 		{MatchPattern: "cilium.io"},
+		{MatchName: "cilium.io", MinTTL: 60},
+		{MatchName: "cilium.io", MaxTTL: 30},
+		{MatchName: "cilium.io", MinTTL: 60, MaxTTL: 600},
 	} {
 		err := accept.sanitize()
 		c.Assert(err, IsNil, Commentf("FQDNSelector %+v was rejected but it should be valid", accept))
@@ -31,6 +34,7 @@ func (s *PolicyAPITestSuite) TestFQDNSelectorSanitize(c *C) {
 		{MatchName: "a{1,2}.cilium.io."},
 		{MatchPattern: "[a-z]*.cilium.io."},
 		{MatchName: "cilium.io", MatchPattern: "*cilium.io"},
+		{MatchName: "cilium.io", MinTTL: 600, MaxTTL: 60},
 	} {
 		err := reject.sanitize()
 		c.Assert(err, Not(IsNil), Commentf("FQDNSelector %+v was accepted but it should be invalid", reject))
@@ -50,6 +54,7 @@ func (s *PolicyAPITestSuite) TestPortRuleDNSSanitize(c *C) {
 		{MatchPattern: "*._cilium.io"},
 		{MatchPattern: "*cilium.io"},
 		{MatchPattern: "cilium.io"},
+		{MatchName: "cilium.io", AllowedCIDRs: []CIDR{"10.0.0.0/8", "fd00::/8"}},
 	} {
 		err := accept.Sanitize()
 		c.Assert(err, IsNil, Commentf("PortRuleDNS %+v was rejected but it should be valid", accept))
@@ -59,6 +64,8 @@ func (s *PolicyAPITestSuite) TestPortRuleDNSSanitize(c *C) {
 		{MatchName: "a{1,2}.cilium.io."},
 		{MatchPattern: "[a-z]*.cilium.io."},
 		{MatchName: "a{1,2}.cilium.io.", MatchPattern: "[a-z]*.cilium.io."},
+		{MatchName: "cilium.io", AllowedCIDRs: []CIDR{"10.0.0.1"}},
+		{MatchName: "cilium.io", AllowedCIDRs: []CIDR{"not-a-cidr"}},
 	} {
 		err := reject.Sanitize()
 		c.Assert(err, Not(IsNil), Commentf("PortRuleDNS %+v was accepted but it should be invalid", reject))
@@ -13,7 +13,9 @@ import (
 )
 
 const (
-	AWSProvider = "AWS" // AWS provider key
+	AWSProvider   = "AWS"   // AWS provider key
+	GCPProvider   = "GCP"   // GCP provider key
+	AzureProvider = "Azure" // Azure provider key
 )
 
 var (
@@ -27,7 +29,9 @@ type GroupProviderFunc func(context.Context, *ToGroups) ([]netip.Addr, error)
 // ToGroups structure to store all kinds of new integrations that needs a new
 // derivative policy.
 type ToGroups struct {
-	AWS *AWSGroup `json:"aws,omitempty"`
+	AWS   *AWSGroup   `json:"aws,omitempty"`
+	GCP   *GCPGroup   `json:"gcp,omitempty"`
+	Azure *AzureGroup `json:"azure,omitempty"`
 }
 
 // AWSGroup is an structure that can be used to whitelisting information from AWS integration
@@ -38,33 +42,64 @@ type AWSGroup struct {
 	Region              string            `json:"region,omitempty"`
 }
 
+// GCPGroup is a structure that can be used to whitelist instances from the GCP
+// integration. Instances are matched when all given labels are present.
+type GCPGroup struct {
+	Labels  map[string]string `json:"labels,omitempty"`
+	Zones   []string          `json:"zones,omitempty"`
+	Project string            `json:"project,omitempty"`
+}
+
+// AzureGroup is a structure that can be used to whitelist instances from the
+// Azure integration. Instances are matched when all given tags are present.
+type AzureGroup struct {
+	Labels         map[string]string `json:"labels,omitempty"`
+	ResourceGroups []string          `json:"resourceGroups,omitempty"`
+}
+
 // RegisterToGroupsProvider it will register a new callback that will be used
 // when a new ToGroups rule is added.
 func RegisterToGroupsProvider(providerName string, callback GroupProviderFunc) {
 	providers.Store(providerName, callback)
 }
 
+// providersInUse returns the keys of the providers that the rule contains
+// data for.
+func (group *ToGroups) providersInUse() []string {
+	result := []string{}
+	if group.AWS != nil {
+		result = append(result, AWSProvider)
+	}
+	if group.GCP != nil {
+		result = append(result, GCPProvider)
+	}
+	if group.Azure != nil {
+		result = append(result, AzureProvider)
+	}
+	return result
+}
+
 // GetCidrSet will return the CIDRRule for the rule using the callbacks that
 // are register in the platform.
 func (group *ToGroups) GetCidrSet(ctx context.Context) ([]CIDRRule, error) {
 	var addrs []netip.Addr
 	// Get per  provider CIDRSet
-	if group.AWS != nil {
-		callbackInterface, ok := providers.Load(AWSProvider)
+	for _, providerName := range group.providersInUse() {
+		callbackInterface, ok := providers.Load(providerName)
 		if !ok {
-			return nil, fmt.Errorf("Provider %s is not registered", AWSProvider)
+			return nil, fmt.Errorf("Provider %s is not registered", providerName)
 		}
 		callback, ok := callbackInterface.(GroupProviderFunc)
 		if !ok {
-			return nil, fmt.Errorf("Provider callback for %s is not a valid instance", AWSProvider)
+			return nil, fmt.Errorf("Provider callback for %s is not a valid instance", providerName)
 		}
-		awsAddrs, err := callback(ctx, group)
+		providerAddrs, err := callback(ctx, group)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"Cannot retrieve data from %s provider: %s",
-				AWSProvider, err)
+				providerName, err)
 		}
-		addrs = append(addrs, awsAddrs...)
+		addrs = append(addrs, providerAddrs...)
 	}
 
 	resultAddrs := ip.KeepUniqueAddrs(addrs)
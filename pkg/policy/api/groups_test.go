@@ -93,6 +93,27 @@ func (s *PolicyAPITestSuite) TestGetCIDRSetWithError(c *C) {
 
 }
 
+func (s *PolicyAPITestSuite) TestGetCIDRSetWithMultipleProviders(c *C) {
+	RegisterToGroupsProvider(AWSProvider, GetCallBackWithRule("192.168.1.1"))
+	RegisterToGroupsProvider(GCPProvider, GetCallBackWithRule("192.168.10.10"))
+	RegisterToGroupsProvider(AzureProvider, GetCallBackWithRule("192.168.10.3"))
+	defer providers.Delete(GCPProvider)
+	defer providers.Delete(AzureProvider)
+
+	group := ToGroups{
+		AWS:   &AWSGroup{Labels: map[string]string{"test": "a"}},
+		GCP:   &GCPGroup{Labels: map[string]string{"test": "a"}},
+		Azure: &AzureGroup{Labels: map[string]string{"test": "a"}},
+	}
+	expectedCidrRule := []CIDRRule{
+		{Cidr: "192.168.1.1/32", ExceptCIDRs: []CIDR{}, Generated: true},
+		{Cidr: "192.168.10.3/32", ExceptCIDRs: []CIDR{}, Generated: true},
+		{Cidr: "192.168.10.10/32", ExceptCIDRs: []CIDR{}, Generated: true}}
+	cidr, err := group.GetCidrSet(context.TODO())
+	c.Assert(cidr, checker.DeepEquals, expectedCidrRule)
+	c.Assert(err, IsNil)
+}
+
 func (s *PolicyAPITestSuite) TestWithoutProviderRegister(c *C) {
 	providers.Delete(AWSProvider)
 	group := GetToGroupsRule()
@@ -6,6 +6,7 @@ package api
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 // MismatchAction specifies what to do when there is no header match
@@ -52,6 +53,50 @@ type HeaderMatch struct {
 	Value string `json:"value,omitempty"`
 }
 
+// HTTPRetryPolicy configures upstream retries performed by the proxy for
+// HTTP requests. As retries are configured on the Envoy routes of the
+// whole proxy listener, the settings apply to all traffic redirected to
+// the listener serving the rule, not only to requests matching the rule.
+type HTTPRetryPolicy struct {
+	// RetryOn is a comma-separated list of Envoy retry-on conditions under
+	// which a retry takes place, e.g. "5xx" or "gateway-error,reset".
+	//
+	// If omitted or empty, requests are retried on "5xx".
+	//
+	// +kubebuilder:validation:Optional
+	RetryOn string `json:"retryOn,omitempty"`
+
+	// NumRetries is the number of retries performed for a failed request.
+	//
+	// If omitted or zero, the agent-wide default applies.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	NumRetries uint32 `json:"numRetries,omitempty"`
+
+	// PerTryTimeout is the timeout per retry attempt, in seconds.
+	//
+	// If omitted or zero, the agent-wide default applies.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	PerTryTimeout uint32 `json:"perTryTimeout,omitempty"`
+}
+
+// retryOnConditions are the Envoy retry-on conditions accepted in
+// HTTPRetryPolicy.RetryOn.
+var retryOnConditions = map[string]struct{}{
+	"5xx":                    {},
+	"gateway-error":          {},
+	"reset":                  {},
+	"connect-failure":        {},
+	"envoy-ratelimited":      {},
+	"retriable-4xx":          {},
+	"refused-stream":         {},
+	"retriable-status-codes": {},
+	"retriable-headers":      {},
+}
+
 // PortRuleHTTP is a list of HTTP protocol constraints. All fields are
 // optional, if all fields are empty or missing, the rule does not have any
 // effect.
@@ -101,6 +146,14 @@ type PortRuleHTTP struct {
 	//
 	// +kubebuilder:validation:Optional
 	HeaderMatches []*HeaderMatch `json:"headerMatches,omitempty"`
+
+	// Retry configures upstream retries performed by the proxy. As retries
+	// are applied on the Envoy routes of the whole proxy listener, the most
+	// permissive retry settings among the rules redirected to a listener
+	// are used.
+	//
+	// +kubebuilder:validation:Optional
+	Retry *HTTPRetryPolicy `json:"retry,omitempty"`
 }
 
 // Sanitize sanitizes HTTP rules. It ensures that the path and method fields
@@ -140,5 +193,13 @@ func (h *PortRuleHTTP) Sanitize() error {
 		}
 	}
 
+	if h.Retry != nil && h.Retry.RetryOn != "" {
+		for _, condition := range strings.Split(h.Retry.RetryOn, ",") {
+			if _, ok := retryOnConditions[strings.TrimSpace(condition)]; !ok {
+				return fmt.Errorf("Invalid retry-on condition: %s", condition)
+			}
+		}
+	}
+
 	return nil
 }
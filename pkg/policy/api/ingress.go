@@ -72,6 +72,18 @@ type IngressCommonRule struct {
 	// +kubebuilder:validation:Optional
 	FromEntities EntitySlice `json:"fromEntities,omitempty"`
 
+	// FromNodes is a list of nodes identified by an EndpointSelector which
+	// are allowed to communicate with the endpoint subject to the rule.
+	// The selectors are matched against the labels of the node, prefixed
+	// with the "node" source. This requires the agent to run with the
+	// "enable-node-selector-labels" flag set.
+	//
+	// Example:
+	// Any node carrying the label "pool=gpu" can reach the endpoint.
+	//
+	// +kubebuilder:validation:Optional
+	FromNodes []EndpointSelector `json:"fromNodes,omitempty"`
+
 	// TODO: Move this to the policy package
 	// (https://github.com/cilium/cilium/issues/8353)
 	aggregatedSelectors EndpointSelectorSlice `json:"-"`
@@ -89,9 +101,9 @@ type IngressCommonRule struct {
 //     the effects of any Requires field in any rule will apply to all other
 //     rules as well.
 //
-//   - FromEndpoints, FromCIDR, FromCIDRSet and FromEntities are mutually
-//     exclusive. Only one of these members may be present within an individual
-//     rule.
+//   - FromEndpoints, FromCIDR, FromCIDRSet, FromEntities and FromNodes are
+//     mutually exclusive. Only one of these members may be present within an
+//     individual rule.
 type IngressRule struct {
 	IngressCommonRule `json:",inline"`
 
@@ -130,9 +142,9 @@ type IngressRule struct {
 //     the effects of any Requires field in any rule will apply to all other
 //     rules as well.
 //
-//   - FromEndpoints, FromCIDR, FromCIDRSet and FromEntities are mutually
-//     exclusive. Only one of these members may be present within an individual
-//     rule.
+//   - FromEndpoints, FromCIDR, FromCIDRSet, FromEntities and FromNodes are
+//     mutually exclusive. Only one of these members may be present within an
+//     individual rule.
 type IngressDenyRule struct {
 	IngressCommonRule `json:",inline"`
 
@@ -185,7 +197,7 @@ func (i *IngressCommonRule) GetSourceEndpointSelectorsWithRequirements(requireme
 	if i.aggregatedSelectors == nil {
 		i.SetAggregatedSelectors()
 	}
-	res := make(EndpointSelectorSlice, 0, len(i.FromEndpoints)+len(i.aggregatedSelectors))
+	res := make(EndpointSelectorSlice, 0, len(i.FromEndpoints)+len(i.FromNodes)+len(i.aggregatedSelectors))
 	if len(requirements) > 0 && len(i.FromEndpoints) > 0 {
 		for idx := range i.FromEndpoints {
 			sel := *i.FromEndpoints[idx].DeepCopy()
@@ -200,6 +212,10 @@ func (i *IngressCommonRule) GetSourceEndpointSelectorsWithRequirements(requireme
 		res = append(res, i.FromEndpoints...)
 	}
 
+	// FromRequires only constrains endpoint peers, node selectors are
+	// added without folding the requirements in.
+	res = append(res, i.FromNodes...)
+
 	return append(res, i.aggregatedSelectors...)
 }
 
@@ -76,6 +76,16 @@ type Rule struct {
 	// +kubebuilder:validation:Optional
 	Labels labels.LabelArray `json:"labels,omitempty"`
 
+	// AuditMode, if true, puts this rule into audit mode: traffic matching
+	// the rule is not enforced but instead always allowed, with the policy
+	// verdict events flagging what the verdict would have been. This allows
+	// validating individual rules before enforcing them, without enabling
+	// the daemon-wide policy audit mode.
+	// If omitted or empty, the rule is enforced.
+	//
+	// +kubebuilder:validation:Optional
+	AuditMode bool `json:"auditMode,omitempty"`
+
 	// Description is a free form string, it can be used by the creator of
 	// the rule to store human readable explanation of the purpose of this
 	// rule. Rules cannot be identified by comment.
@@ -93,6 +103,7 @@ func (r *Rule) MarshalJSON() ([]byte, error) {
 		Egress      []EgressRule      `json:"egress,omitempty"`
 		EgressDeny  []EgressDenyRule  `json:"egressDeny,omitempty"`
 		Labels      labels.LabelArray `json:"labels,omitempty"`
+		AuditMode   bool              `json:"auditMode,omitempty"`
 		Description string            `json:"description,omitempty"`
 	}
 
@@ -103,6 +114,7 @@ func (r *Rule) MarshalJSON() ([]byte, error) {
 		Egress:      r.Egress,
 		EgressDeny:  r.EgressDeny,
 		Labels:      r.Labels,
+		AuditMode:   r.AuditMode,
 		Description: r.Description,
 	}
 
@@ -11,6 +11,8 @@ import (
 	"strings"
 
 	"github.com/cilium/cilium/pkg/iana"
+	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/option"
 )
 
@@ -62,12 +64,35 @@ func (r Rule) Sanitize() error {
 			return err
 		}
 		if hostPolicy {
-			if len(countL7Rules(r.Egress[i].ToPorts)) > 0 {
-				return fmt.Errorf("host policies do not support L7 rules yet")
+			// DNS rules are the exception: they are needed to redirect
+			// the node's DNS traffic to the proxy, which ToFQDN rules in
+			// host policies rely on.
+			for j := range r.Egress[i].ToPorts {
+				if parser := r.Egress[i].ToPorts[j].l7Parser(); parser != "" && parser != "dns" {
+					return fmt.Errorf("host policies do not support L7 rules yet")
+				}
 			}
 		}
 	}
 
+	// A port can only be redirected to a single L7 parser per direction.
+	// Rules requiring conflicting parsers for the same port cannot be
+	// enforced as written, reject them instead of applying them partially.
+	ingressPorts := []PortRule{}
+	for i := range r.Ingress {
+		ingressPorts = append(ingressPorts, r.Ingress[i].ToPorts...)
+	}
+	if err := checkL7PortConflicts(ingressPorts); err != nil {
+		return err
+	}
+	egressPorts := []PortRule{}
+	for i := range r.Egress {
+		egressPorts = append(egressPorts, r.Egress[i].ToPorts...)
+	}
+	if err := checkL7PortConflicts(egressPorts); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -83,12 +108,74 @@ func countL7Rules(ports []PortRule) map[string]int {
 	return result
 }
 
+// l7Parser returns the name of the L7 parser the port rule's L7 rules
+// require, or the empty string if the rule is L3/L4 only.
+func (pr *PortRule) l7Parser() string {
+	switch {
+	case pr.Rules.IsEmpty():
+		return ""
+	case len(pr.Rules.HTTP) > 0:
+		return "http"
+	case len(pr.Rules.Kafka) > 0:
+		return "kafka"
+	case len(pr.Rules.DNS) > 0:
+		return "dns"
+	case pr.Rules.L7Proto != "":
+		return pr.Rules.L7Proto
+	}
+	return ""
+}
+
+// checkL7PortConflicts returns an error if two of the given port rules
+// require different L7 parsers for the same port and protocol.
+func checkL7PortConflicts(ports []PortRule) error {
+	parsers := map[PortProtocol]string{}
+	for i := range ports {
+		parser := ports[i].l7Parser()
+		if parser == "" {
+			continue
+		}
+		for _, pp := range ports[i].Ports {
+			if existing, ok := parsers[pp]; ok && existing != parser {
+				return fmt.Errorf("conflicting L7 parsers %q and %q for port %s/%s", existing, parser, pp.Port, pp.Protocol)
+			}
+			parsers[pp] = parser
+		}
+	}
+	return nil
+}
+
+// sanitizeNodeSelectors validates the given FromNodes/ToNodes selectors and
+// restricts them to only ever select node identities. Node selectors require
+// the agent to ingest node labels into the ipcache, which is guarded by the
+// enable-node-selector-labels flag.
+func sanitizeNodeSelectors(nodeSelectors []EndpointSelector) error {
+	if len(nodeSelectors) > 0 && !option.Config.EnableNodeSelectorLabels {
+		return fmt.Errorf("FromNodes/ToNodes rules can only be applied when the %q flag is set", option.EnableNodeSelectorLabels)
+	}
+
+	for n := range nodeSelectors {
+		if err := nodeSelectors[n].sanitize(); err != nil {
+			return err
+		}
+		// Restrict the match to node identities, so that endpoints
+		// carrying the same labels as a node cannot be selected by a
+		// node selector.
+		nodeSelectors[n].AddMatchExpression(
+			labels.LabelSourceReservedKeyPrefix+labels.IDNameRemoteNode,
+			slim_metav1.LabelSelectorOpExists, []string{})
+	}
+
+	return nil
+}
+
 func (i *IngressRule) sanitize() error {
 	l3Members := map[string]int{
 		"FromEndpoints": len(i.FromEndpoints),
 		"FromCIDR":      len(i.FromCIDR),
 		"FromCIDRSet":   len(i.FromCIDRSet),
 		"FromEntities":  len(i.FromEntities),
+		"FromNodes":     len(i.FromNodes),
 	}
 	l7Members := countL7Rules(i.ToPorts)
 	l7IngressSupport := map[string]bool{
@@ -134,6 +221,10 @@ func (i *IngressRule) sanitize() error {
 		}
 	}
 
+	if err := sanitizeNodeSelectors(i.FromNodes); err != nil {
+		return err
+	}
+
 	for n := range i.ToPorts {
 		if err := i.ToPorts[n].sanitize(true); err != nil {
 			return err
@@ -155,6 +246,12 @@ func (i *IngressRule) sanitize() error {
 		prefixLengths[prefixLength] = exists{}
 	}
 
+	fromCIDRSet, err := i.FromCIDRSet.ExpandCIDRGroupRefs()
+	if err != nil {
+		return err
+	}
+	i.FromCIDRSet = fromCIDRSet
+
 	for n := range i.FromCIDRSet {
 		prefixLength, err := i.FromCIDRSet[n].sanitize()
 		if err != nil {
@@ -181,6 +278,7 @@ func (e *EgressRule) sanitize() error {
 		"ToCIDRSet":   len(e.ToCIDRSet),
 		"ToEndpoints": len(e.ToEndpoints),
 		"ToEntities":  len(e.ToEntities),
+		"ToNodes":     len(e.ToNodes),
 		"ToServices":  len(e.ToServices),
 		"ToFQDNs":     len(e.ToFQDNs),
 		"ToGroups":    len(e.ToGroups),
@@ -190,6 +288,7 @@ func (e *EgressRule) sanitize() error {
 		"ToCIDRSet":   true,
 		"ToEndpoints": true,
 		"ToEntities":  true,
+		"ToNodes":     true,
 		"ToServices":  true,
 		"ToFQDNs":     true,
 		"ToGroups":    true,
@@ -204,6 +303,11 @@ func (e *EgressRule) sanitize() error {
 	for m1 := range l3Members {
 		for m2 := range l3Members {
 			if m2 != m1 && l3Members[m1] > 0 && l3Members[m2] > 0 {
+				// ToCIDRSet and ToFQDNs may be combined as both
+				// aggregate into the set of destination selectors.
+				if (m1 == "ToCIDRSet" && m2 == "ToFQDNs") || (m1 == "ToFQDNs" && m2 == "ToCIDRSet") {
+					continue
+				}
 				return fmt.Errorf("Combining %s and %s is not supported yet", m1, m2)
 			}
 		}
@@ -243,6 +347,10 @@ func (e *EgressRule) sanitize() error {
 		}
 	}
 
+	if err := sanitizeNodeSelectors(e.ToNodes); err != nil {
+		return err
+	}
+
 	for i := range e.ToPorts {
 		if err := e.ToPorts[i].sanitize(false); err != nil {
 			return err
@@ -263,6 +371,12 @@ func (e *EgressRule) sanitize() error {
 		}
 		prefixLengths[prefixLength] = exists{}
 	}
+	toCIDRSet, err := e.ToCIDRSet.ExpandCIDRGroupRefs()
+	if err != nil {
+		return err
+	}
+	e.ToCIDRSet = toCIDRSet
+
 	for i := range e.ToCIDRSet {
 		prefixLength, err := e.ToCIDRSet[i].sanitize()
 		if err != nil {
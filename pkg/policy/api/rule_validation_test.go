@@ -8,6 +8,7 @@ import (
 
 	. "gopkg.in/check.v1"
 
+	"github.com/cilium/cilium/pkg/checker"
 	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/option"
@@ -326,6 +327,30 @@ func (s *PolicyAPITestSuite) TestHTTPRuleRegexes(c *C) {
 	c.Assert(err, Not(IsNil))
 }
 
+func (s *PolicyAPITestSuite) TestHTTPRuleRetryOn(c *C) {
+	acceptedRetryOn := []string{
+		"",
+		"5xx",
+		"gateway-error,reset",
+		"5xx, connect-failure, retriable-status-codes",
+	}
+	for _, retryOn := range acceptedRetryOn {
+		rule := PortRuleHTTP{Retry: &HTTPRetryPolicy{RetryOn: retryOn}}
+		c.Assert(rule.Sanitize(), IsNil, Commentf("RetryOn %q was rejected", retryOn))
+	}
+
+	rejectedRetryOn := []string{
+		"5XX",
+		"always",
+		"5xx,,reset",
+		"gateway-error;reset",
+	}
+	for _, retryOn := range rejectedRetryOn {
+		rule := PortRuleHTTP{Retry: &HTTPRetryPolicy{RetryOn: retryOn}}
+		c.Assert(rule.Sanitize(), Not(IsNil), Commentf("RetryOn %q was accepted", retryOn))
+	}
+}
+
 // Test the validation of CIDR rule prefix definitions
 func (s *PolicyAPITestSuite) TestCIDRsanitize(c *C) {
 	// IPv4
@@ -484,7 +509,8 @@ func (s *PolicyAPITestSuite) TestL7Rules(c *C) {
 	c.Assert(err, Not(IsNil))
 }
 
-// This test ensures that host policies with L7 rules are rejected.
+// This test ensures that host policies with L7 rules other than egress DNS
+// are rejected.
 func (s *PolicyAPITestSuite) TestL7RulesWithNodeSelector(c *C) {
 	invalidL7RuleIngress := Rule{
 		NodeSelector: WildcardEndpointSelector,
@@ -510,6 +536,31 @@ func (s *PolicyAPITestSuite) TestL7RulesWithNodeSelector(c *C) {
 	c.Assert(err.Error(), Equals, "host policies do not support L7 rules yet")
 
 	invalidL7RuleEgress := Rule{
+		NodeSelector: WildcardEndpointSelector,
+		Egress: []EgressRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "80", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						HTTP: []PortRuleHTTP{
+							{Method: "PUT", Path: "/"},
+						},
+					},
+				}},
+			},
+		},
+	}
+	err = invalidL7RuleEgress.Sanitize()
+	c.Assert(err.Error(), Equals, "host policies do not support L7 rules yet")
+
+	// Egress DNS rules are allowed in host policies, so that the node's DNS
+	// traffic can be redirected to the proxy for ToFQDN rules.
+	validDNSRuleEgress := Rule{
 		NodeSelector: WildcardEndpointSelector,
 		Egress: []EgressRule{
 			{
@@ -529,8 +580,8 @@ func (s *PolicyAPITestSuite) TestL7RulesWithNodeSelector(c *C) {
 			},
 		},
 	}
-	err = invalidL7RuleEgress.Sanitize()
-	c.Assert(err.Error(), Equals, "host policies do not support L7 rules yet")
+	err = validDNSRuleEgress.Sanitize()
+	c.Assert(err, IsNil)
 
 	validL7RuleIngress := Rule{
 		NodeSelector: WildcardEndpointSelector,
@@ -665,6 +716,77 @@ func (s *PolicyAPITestSuite) TestNodeSelector(c *C) {
 	c.Assert(err.Error(), Equals, "rule must have one of EndpointSelector or NodeSelector")
 }
 
+func (s *PolicyAPITestSuite) TestFromNodesToNodesRules(c *C) {
+	nodeSel := NewESFromK8sLabelSelector(labels.LabelSourceNodeKeyPrefix,
+		&slim_metav1.LabelSelector{
+			MatchLabels: map[string]string{"pool": "gpu"},
+		})
+
+	ingressNodeRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromNodes: []EndpointSelector{nodeSel},
+				},
+			},
+		},
+	}
+	egressNodeRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Egress: []EgressRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToNodes: []EndpointSelector{nodeSel},
+				},
+			},
+		},
+	}
+
+	// Without the enable-node-selector-labels flag, node selectors must be
+	// rejected.
+	option.Config.EnableNodeSelectorLabels = false
+	errStr := "FromNodes/ToNodes rules can only be applied when the \"enable-node-selector-labels\" flag is set"
+	err := ingressNodeRule.Sanitize()
+	c.Assert(err, ErrorMatches, errStr)
+	err = egressNodeRule.Sanitize()
+	c.Assert(err, ErrorMatches, errStr)
+
+	option.Config.EnableNodeSelectorLabels = true
+	defer func() {
+		option.Config.EnableNodeSelectorLabels = false
+	}()
+
+	err = ingressNodeRule.Sanitize()
+	c.Assert(err, IsNil)
+	err = egressNodeRule.Sanitize()
+	c.Assert(err, IsNil)
+
+	// Sanitization restricts node selectors to node identities.
+	remoteNodeRequirement := slim_metav1.LabelSelectorRequirement{
+		Key:      "reserved.remote-node",
+		Operator: slim_metav1.LabelSelectorOpExists,
+		Values:   []string{},
+	}
+	c.Assert(ingressNodeRule.Ingress[0].FromNodes[0].MatchExpressions[0], checker.DeepEquals, remoteNodeRequirement)
+	c.Assert(egressNodeRule.Egress[0].ToNodes[0].MatchExpressions[0], checker.DeepEquals, remoteNodeRequirement)
+
+	// Node selectors are mutually exclusive with the other L3 rule types.
+	mixedRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+					FromNodes:     []EndpointSelector{nodeSel},
+				},
+			},
+		},
+	}
+	err = mixedRule.Sanitize()
+	c.Assert(err, ErrorMatches, "Combining .* and .* is not supported yet")
+}
+
 func (s *PolicyAPITestSuite) TestTooManyPortsRule(c *C) {
 
 	var portProtocols []PortProtocol
@@ -844,3 +966,127 @@ func (s *PolicyAPITestSuite) TestL7RuleDirectionalitySupport(c *C) {
 	c.Assert(err, Not(IsNil))
 
 }
+
+// This test ensures that rules requiring different L7 parsers for the same
+// port and protocol are rejected, as a port can only be redirected to a
+// single parser per direction.
+func (s *PolicyAPITestSuite) TestL7ParserConflictOnPort(c *C) {
+	conflictingParsersRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "9092", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						HTTP: []PortRuleHTTP{
+							{Method: "GET", Path: "/"},
+						},
+					},
+				}},
+			},
+			{
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "9092", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						Kafka: []kafka.PortRule{{
+							APIKey: "produce",
+						}},
+					},
+				}},
+			},
+		},
+	}
+
+	err := conflictingParsersRule.Sanitize()
+	c.Assert(err, Not(IsNil))
+
+	// The same parser on the same port in separate ingress rules is valid.
+	samePortSameParserRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "80", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						HTTP: []PortRuleHTTP{
+							{Method: "GET", Path: "/public"},
+						},
+					},
+				}},
+			},
+			{
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "80", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						HTTP: []PortRuleHTTP{
+							{Method: "GET", Path: "/private"},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	err = samePortSameParserRule.Sanitize()
+	c.Assert(err, IsNil)
+
+	// Different parsers on different ports are valid.
+	differentPortsRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "80", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						HTTP: []PortRuleHTTP{
+							{Method: "GET", Path: "/"},
+						},
+					},
+				}},
+			},
+			{
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "9092", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						Kafka: []kafka.PortRule{{
+							APIKey: "produce",
+						}},
+					},
+				}},
+			},
+		},
+	}
+
+	err = differentPortsRule.Sanitize()
+	c.Assert(err, IsNil)
+}
+
+// This test ensures that From/ToEndpoints entries without a label selector,
+// e.g. a null entry in the YAML list, are rejected.
+func (s *PolicyAPITestSuite) TestEmptyEndpointSelector(c *C) {
+	emptyFromEndpointsRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{{}},
+				},
+			},
+		},
+	}
+
+	err := emptyFromEndpointsRule.Sanitize()
+	c.Assert(err, Not(IsNil))
+}
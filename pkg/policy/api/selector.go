@@ -80,7 +80,10 @@ func (n *EndpointSelector) UnmarshalJSON(b []byte) error {
 		n.MatchExpressions = newMatchExpr
 	}
 	n.requirements = labelSelectorToRequirements(n.LabelSelector)
-	n.cachedLabelSelectorString = n.LabelSelector.String()
+	// Intern the selector string: the same selectors recur across the rules
+	// of large policy repositories, and unmarshaling is only done for
+	// user-authored policy, so cardinality is bounded.
+	n.cachedLabelSelectorString = labels.Intern(n.LabelSelector.String())
 	return nil
 }
 
@@ -340,6 +343,9 @@ func (n *EndpointSelector) ConvertToLabelSelectorRequirementSlice() []slim_metav
 
 // sanitize returns an error if the EndpointSelector's LabelSelector is invalid.
 func (n *EndpointSelector) sanitize() error {
+	if n.LabelSelector == nil {
+		return fmt.Errorf("invalid label selector: empty selector")
+	}
 	errList := validation.ValidateLabelSelector(n.LabelSelector, nil)
 	if len(errList) > 0 {
 		return fmt.Errorf("invalid label selector: %s", errList.ToAggregate().Error())
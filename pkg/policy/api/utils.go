@@ -83,7 +83,16 @@ func (h *PortRuleL7) Exists(rules L7Rules) bool {
 
 // Equal returns true if both rules are equal
 func (d *PortRuleDNS) Equal(o PortRuleDNS) bool {
-	return d != nil && d.MatchName == o.MatchName && d.MatchPattern == o.MatchPattern
+	if d == nil || d.MatchName != o.MatchName || d.MatchPattern != o.MatchPattern ||
+		len(d.AllowedCIDRs) != len(o.AllowedCIDRs) {
+		return false
+	}
+	for i, cidr := range d.AllowedCIDRs {
+		if cidr != o.AllowedCIDRs[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // Equal returns true if both L7 rules are equal
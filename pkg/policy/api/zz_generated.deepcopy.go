@@ -47,6 +47,34 @@ func (in *AWSGroup) DeepCopy() *AWSGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureGroup) DeepCopyInto(out *AzureGroup) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureGroup.
+func (in *AzureGroup) DeepCopy() *AzureGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CIDRRule) DeepCopyInto(out *CIDRRule) {
 	*out = *in
@@ -144,6 +172,13 @@ func (in *EgressCommonRule) DeepCopyInto(out *EgressCommonRule) {
 		*out = make(EntitySlice, len(*in))
 		copy(*out, *in)
 	}
+	if in.ToNodes != nil {
+		in, out := &in.ToNodes, &out.ToNodes
+		*out = make([]EndpointSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ToServices != nil {
 		in, out := &in.ToServices, &out.ToServices
 		*out = make([]Service, len(*in))
@@ -355,6 +390,50 @@ func (in FQDNSelectorSlice) DeepCopy() FQDNSelectorSlice {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPGroup) DeepCopyInto(out *GCPGroup) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPGroup.
+func (in *GCPGroup) DeepCopy() *GCPGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetryPolicy) DeepCopyInto(out *HTTPRetryPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetryPolicy.
+func (in *HTTPRetryPolicy) DeepCopy() *HTTPRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HeaderMatch) DeepCopyInto(out *HeaderMatch) {
 	*out = *in
@@ -469,6 +548,13 @@ func (in *IngressCommonRule) DeepCopyInto(out *IngressCommonRule) {
 		*out = make(EntitySlice, len(*in))
 		copy(*out, *in)
 	}
+	if in.FromNodes != nil {
+		in, out := &in.FromNodes, &out.FromNodes
+		*out = make([]EndpointSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.aggregatedSelectors != nil {
 		in, out := &in.aggregatedSelectors, &out.aggregatedSelectors
 		*out = make(EndpointSelectorSlice, len(*in))
@@ -602,7 +688,9 @@ func (in *L7Rules) DeepCopyInto(out *L7Rules) {
 	if in.DNS != nil {
 		in, out := &in.DNS, &out.DNS
 		*out = make([]PortRuleDNS, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.L7 != nil {
 		in, out := &in.L7, &out.L7
@@ -728,6 +816,11 @@ func (in *PortRule) DeepCopy() *PortRule {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortRuleDNS) DeepCopyInto(out *PortRuleDNS) {
 	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -760,6 +853,11 @@ func (in *PortRuleHTTP) DeepCopyInto(out *PortRuleHTTP) {
 			}
 		}
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(HTTPRetryPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -993,6 +1091,16 @@ func (in *ToGroups) DeepCopyInto(out *ToGroups) {
 		*out = new(AWSGroup)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureGroup)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
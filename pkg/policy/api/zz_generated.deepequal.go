@@ -77,6 +77,54 @@ func (in *AWSGroup) DeepEqual(other *AWSGroup) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *AzureGroup) DeepEqual(other *AzureGroup) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.Labels != nil) && (other.Labels != nil)) || ((in.Labels == nil) != (other.Labels == nil)) {
+		in, other := &in.Labels, &other.Labels
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	if ((in.ResourceGroups != nil) && (other.ResourceGroups != nil)) || ((in.ResourceGroups == nil) != (other.ResourceGroups == nil)) {
+		in, other := &in.ResourceGroups, &other.ResourceGroups
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *CIDRRule) DeepEqual(other *CIDRRule) bool {
@@ -223,6 +271,23 @@ func (in *EgressCommonRule) DeepEqual(other *EgressCommonRule) bool {
 		}
 	}
 
+	if ((in.ToNodes != nil) && (other.ToNodes != nil)) || ((in.ToNodes == nil) != (other.ToNodes == nil)) {
+		in, other := &in.ToNodes, &other.ToNodes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
 	if ((in.ToServices != nil) && (other.ToServices != nil)) || ((in.ToServices == nil) != (other.ToServices == nil)) {
 		in, other := &in.ToServices, &other.ToServices
 		if other == nil {
@@ -497,6 +562,78 @@ func (in *FQDNSelectorSlice) DeepEqual(other *FQDNSelectorSlice) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *GCPGroup) DeepEqual(other *GCPGroup) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.Labels != nil) && (other.Labels != nil)) || ((in.Labels == nil) != (other.Labels == nil)) {
+		in, other := &in.Labels, &other.Labels
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
+	if ((in.Zones != nil) && (other.Zones != nil)) || ((in.Zones == nil) != (other.Zones == nil)) {
+		in, other := &in.Zones, &other.Zones
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.Project != other.Project {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *HTTPRetryPolicy) DeepEqual(other *HTTPRetryPolicy) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.RetryOn != other.RetryOn {
+		return false
+	}
+	if in.NumRetries != other.NumRetries {
+		return false
+	}
+	if in.PerTryTimeout != other.PerTryTimeout {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *HeaderMatch) DeepEqual(other *HeaderMatch) bool {
@@ -651,6 +788,23 @@ func (in *IngressCommonRule) DeepEqual(other *IngressCommonRule) bool {
 		}
 	}
 
+	if ((in.FromNodes != nil) && (other.FromNodes != nil)) || ((in.FromNodes == nil) != (other.FromNodes == nil)) {
+		in, other := &in.FromNodes, &other.FromNodes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
 	if ((in.aggregatedSelectors != nil) && (other.aggregatedSelectors != nil)) || ((in.aggregatedSelectors == nil) != (other.aggregatedSelectors == nil)) {
 		in, other := &in.aggregatedSelectors, &other.aggregatedSelectors
 		if other == nil || !in.DeepEqual(other) {
@@ -982,6 +1136,23 @@ func (in *PortRuleDNS) DeepEqual(other *PortRuleDNS) bool {
 		return false
 	}
 
+	if ((in.AllowedCIDRs != nil) && (other.AllowedCIDRs != nil)) || ((in.AllowedCIDRs == nil) != (other.AllowedCIDRs == nil)) {
+		in, other := &in.AllowedCIDRs, &other.AllowedCIDRs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	return true
 }
 
@@ -1035,6 +1206,14 @@ func (in *PortRuleHTTP) DeepEqual(other *PortRuleHTTP) bool {
 		}
 	}
 
+	if (in.Retry == nil) != (other.Retry == nil) {
+		return false
+	} else if in.Retry != nil {
+		if !in.Retry.DeepEqual(other.Retry) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1182,6 +1361,10 @@ func (in *Rule) deepEqual(other *Rule) bool {
 		}
 	}
 
+	if in.AuditMode != other.AuditMode {
+		return false
+	}
+
 	if in.Description != other.Description {
 		return false
 	}
@@ -1325,5 +1508,21 @@ func (in *ToGroups) DeepEqual(other *ToGroups) bool {
 		}
 	}
 
+	if (in.GCP == nil) != (other.GCP == nil) {
+		return false
+	} else if in.GCP != nil {
+		if !in.GCP.DeepEqual(other.GCP) {
+			return false
+		}
+	}
+
+	if (in.Azure == nil) != (other.Azure == nil) {
+		return false
+	} else if in.Azure != nil {
+		if !in.Azure.DeepEqual(other.Azure) {
+			return false
+		}
+	}
+
 	return true
 }
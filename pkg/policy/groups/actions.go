@@ -220,6 +220,17 @@ func addDerivativePolicy(ctx context.Context, clientset client.Clientset, cnp *c
 	if derivativeErr != nil {
 		metrics.PolicyImportErrorsTotal.Inc()
 		scopedLog.WithError(derivativeErr).Error("Cannot create derivative rule. Installing deny-all rule.")
+		// Install the partially resolved derivative policy, if any: rules
+		// whose groups could not be resolved were replaced by a deny-all
+		// placeholder.
+		if clusterScoped && len(derivativeCCNP.Specs) > 0 {
+			_, err = updateOrCreateCCNP(clientset, derivativeCCNP)
+		} else if !clusterScoped && len(derivativeCNP.Specs) > 0 {
+			_, err = updateOrCreateCNP(clientset, derivativeCNP)
+		}
+		if err != nil {
+			scopedLog.WithError(err).Error("Cannot install deny-all derivative policy")
+		}
 		statusErr := updateDerivativeStatus(clientset, cnp, derivativePolicy.GetName(), derivativeErr, clusterScoped)
 		if statusErr != nil {
 			scopedLog.WithError(statusErr).Error("Cannot update status for derivative policy")
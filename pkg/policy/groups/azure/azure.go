@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-03-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+const (
+	subscriptionIDEnv = "AZURE_SUBSCRIPTION_ID"
+
+	// publicIPExpand makes the interfaces Get call return the public IP
+	// address values embedded in the IP configurations.
+	publicIPExpand = "ipConfigurations/publicIPAddress"
+)
+
+func init() {
+	api.RegisterToGroupsProvider(api.AzureProvider, GetIPsFromGroup)
+}
+
+// GetIPsFromGroup will return the list of the IPs for the given group filter
+func GetIPsFromGroup(ctx context.Context, group *api.ToGroups) ([]netip.Addr, error) {
+	result := []netip.Addr{}
+	if group.Azure == nil {
+		return result, fmt.Errorf("no azure data available")
+	}
+	return getInstanceIPsFromFilter(ctx, group.Azure)
+}
+
+// getInstanceIPsFromFilter returns the private and public IPs of all virtual
+// machines in the subscription that carry all the tags given in the filter,
+// optionally restricted to the given resource groups.
+func getInstanceIPsFromFilter(ctx context.Context, filter *api.AzureGroup) ([]netip.Addr, error) {
+	subscriptionID := os.Getenv(subscriptionIDEnv)
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("%s is not set", subscriptionIDEnv)
+	}
+
+	authorizer, err := auth.NewAuthorizerFromEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("cannot create azure authorizer: %w", err)
+	}
+
+	vmClient := compute.NewVirtualMachinesClient(subscriptionID)
+	vmClient.Authorizer = authorizer
+	ifClient := network.NewInterfacesClient(subscriptionID)
+	ifClient.Authorizer = authorizer
+
+	var vms []compute.VirtualMachine
+	if len(filter.ResourceGroups) > 0 {
+		for _, resourceGroup := range filter.ResourceGroups {
+			vmPage, err := vmClient.ListComplete(ctx, resourceGroup)
+			if err != nil {
+				return nil, fmt.Errorf("cannot retrieve azure information: %w", err)
+			}
+			for vmPage.NotDone() {
+				vms = append(vms, vmPage.Value())
+				if err := vmPage.NextWithContext(ctx); err != nil {
+					return nil, fmt.Errorf("cannot retrieve azure information: %w", err)
+				}
+			}
+		}
+	} else {
+		vmPage, err := vmClient.ListAllComplete(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("cannot retrieve azure information: %w", err)
+		}
+		for vmPage.NotDone() {
+			vms = append(vms, vmPage.Value())
+			if err := vmPage.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("cannot retrieve azure information: %w", err)
+			}
+		}
+	}
+
+	result := []netip.Addr{}
+	for _, vm := range vms {
+		if !tagsMatch(vm.Tags, filter.Labels) {
+			continue
+		}
+		addrs, err := extractVMIPs(ctx, ifClient, vm)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, addrs...)
+	}
+	return result, nil
+}
+
+// tagsMatch returns true when all the labels of the filter are present with
+// the same value in the given instance tags.
+func tagsMatch(tags map[string]*string, labels map[string]string) bool {
+	for labelKey, labelValue := range labels {
+		tagValue, ok := tags[labelKey]
+		if !ok || tagValue == nil || *tagValue != labelValue {
+			return false
+		}
+	}
+	return true
+}
+
+// extractVMIPs returns the private and public IPs of all network interfaces
+// attached to the given virtual machine.
+func extractVMIPs(ctx context.Context, ifClient network.InterfacesClient, vm compute.VirtualMachine) ([]netip.Addr, error) {
+	result := []netip.Addr{}
+	if vm.VirtualMachineProperties == nil || vm.NetworkProfile == nil ||
+		vm.NetworkProfile.NetworkInterfaces == nil {
+		return result, nil
+	}
+
+	for _, ifaceRef := range *vm.NetworkProfile.NetworkInterfaces {
+		resourceGroup, name, err := parseInterfaceID(ifaceRef.ID)
+		if err != nil {
+			return nil, err
+		}
+		iface, err := ifClient.Get(ctx, resourceGroup, name, publicIPExpand)
+		if err != nil {
+			return nil, fmt.Errorf("cannot retrieve azure interface %q: %w", name, err)
+		}
+		if iface.InterfacePropertiesFormat == nil || iface.IPConfigurations == nil {
+			continue
+		}
+		for _, ipConfig := range *iface.IPConfigurations {
+			if ipConfig.InterfaceIPConfigurationPropertiesFormat == nil {
+				continue
+			}
+			if ipConfig.PrivateIPAddress != nil {
+				if addr, err := netip.ParseAddr(*ipConfig.PrivateIPAddress); err == nil {
+					result = append(result, addr)
+				}
+			}
+			if ipConfig.PublicIPAddress != nil &&
+				ipConfig.PublicIPAddress.PublicIPAddressPropertiesFormat != nil &&
+				ipConfig.PublicIPAddress.IPAddress != nil {
+				if addr, err := netip.ParseAddr(*ipConfig.PublicIPAddress.IPAddress); err == nil {
+					result = append(result, addr)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// parseInterfaceID extracts the resource group and interface name from an
+// interface resource ID of the form
+// /subscriptions/<sub>/resourceGroups/<group>/providers/Microsoft.Network/networkInterfaces/<name>.
+func parseInterfaceID(id *string) (resourceGroup, name string, err error) {
+	if id == nil {
+		return "", "", fmt.Errorf("azure interface reference has no ID")
+	}
+	segments := strings.Split(*id, "/")
+	for i := 0; i < len(segments)-1; i++ {
+		switch strings.ToLower(segments[i]) {
+		case "resourcegroups":
+			resourceGroup = segments[i+1]
+		case "networkinterfaces":
+			name = segments[i+1]
+		}
+	}
+	if resourceGroup == "" || name == "" {
+		return "", "", fmt.Errorf("cannot parse azure interface ID %q", *id)
+	}
+	return resourceGroup, name, nil
+}
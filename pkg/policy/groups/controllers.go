@@ -5,26 +5,38 @@ package groups
 
 import (
 	"context"
+	"time"
 
 	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
 	"github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/rand"
 )
 
 const (
 	maxConcurrentUpdates = 4
+
+	// updateJitter bounds the random delay applied to each derivative
+	// policy update, to spread the provider API calls over time and avoid
+	// hitting cloud API rate limits when many policies are refreshed at
+	// once.
+	updateJitter = 30 * time.Second
 )
 
+var randGen = rand.NewSafeRand(time.Now().UnixNano())
+
 // UpdateCNPInformation  retrieves all the CNP that has currently a derivative
 // policy and creates the new derivatives policies with the latest information
 // from providers.  To avoid issues with rate-limiting this function will
 // execute the addDerivative function with a max number of concurrent calls,
-// defined on maxConcurrentUpdates.
+// defined on maxConcurrentUpdates, and delays each update by a random jitter
+// bounded by updateJitter.
 func UpdateCNPInformation(clientset client.Clientset) {
 	cnpToUpdate := groupsCNPCache.GetAllCNP()
 	sem := make(chan bool, maxConcurrentUpdates)
 	for _, cnp := range cnpToUpdate {
-		sem <- true
 		go func(cnp *cilium_v2.CiliumNetworkPolicy) {
+			time.Sleep(time.Duration(randGen.Int63n(int64(updateJitter))))
+			sem <- true
 			defer func() { <-sem }()
 			// We use the same cache for Clusterwide and Namespaced cilium policies
 			if cnp.ObjectMeta.Namespace == "" {
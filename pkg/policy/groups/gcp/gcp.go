@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"cloud.google.com/go/compute/metadata"
+
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+const (
+	computeEndpoint = "https://compute.googleapis.com/compute/v1"
+
+	// tokenMetadataPath is the metadata server path returning an access
+	// token for the default service account of the instance.
+	tokenMetadataPath = "instance/service-accounts/default/token"
+)
+
+func init() {
+	api.RegisterToGroupsProvider(api.GCPProvider, GetIPsFromGroup)
+}
+
+// GetIPsFromGroup will return the list of the IPs for the given group filter
+func GetIPsFromGroup(ctx context.Context, group *api.ToGroups) ([]netip.Addr, error) {
+	result := []netip.Addr{}
+	if group.GCP == nil {
+		return result, fmt.Errorf("no gcp data available")
+	}
+	return getInstanceIPsFromFilter(ctx, group.GCP)
+}
+
+// instancesResponse is the subset of the GCE aggregated instances list
+// response that is needed to extract instance IPs.
+type instancesResponse struct {
+	Items map[string]struct {
+		Instances []gceInstance `json:"instances"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+type gceInstance struct {
+	Zone              string `json:"zone"`
+	NetworkInterfaces []struct {
+		NetworkIP     string `json:"networkIP"`
+		AccessConfigs []struct {
+			NatIP string `json:"natIP"`
+		} `json:"accessConfigs"`
+	} `json:"networkInterfaces"`
+}
+
+// getInstanceIPsFromFilter returns the private and public IPs of all GCE
+// instances in the project that carry all the labels given in the filter,
+// optionally restricted to the given zones. The lookup uses the GCE metadata
+// server for credentials and thus only works when running on GCP.
+func getInstanceIPsFromFilter(ctx context.Context, filter *api.GCPGroup) ([]netip.Addr, error) {
+	project := filter.Project
+	if project == "" {
+		var err error
+		project, err = metadata.ProjectID()
+		if err != nil {
+			return nil, fmt.Errorf("cannot determine gcp project: %w", err)
+		}
+	}
+
+	token, err := getAccessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []netip.Addr{}
+	pageToken := ""
+	for {
+		response, err := listInstances(ctx, project, labelFilter(filter.Labels), pageToken, token)
+		if err != nil {
+			return nil, err
+		}
+		for _, scope := range response.Items {
+			for _, instance := range scope.Instances {
+				if !zoneMatches(instance.Zone, filter.Zones) {
+					continue
+				}
+				result = append(result, extractIPs(instance)...)
+			}
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+	return result, nil
+}
+
+// getAccessToken retrieves an access token for the default service account
+// from the GCE metadata server.
+func getAccessToken() (string, error) {
+	data, err := metadata.Get(tokenMetadataPath)
+	if err != nil {
+		return "", fmt.Errorf("cannot retrieve gcp credentials: %w", err)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal([]byte(data), &token); err != nil {
+		return "", fmt.Errorf("cannot parse gcp credentials: %w", err)
+	}
+	return token.AccessToken, nil
+}
+
+// labelFilter translates the group labels into a GCE list filter expression,
+// e.g. labels.env = "prod" AND labels.tier = "db".
+func labelFilter(labels map[string]string) string {
+	terms := make([]string, 0, len(labels))
+	for labelKey, labelValue := range labels {
+		terms = append(terms, fmt.Sprintf("labels.%s = %q", labelKey, labelValue))
+	}
+	return strings.Join(terms, " AND ")
+}
+
+func listInstances(ctx context.Context, project, filter, pageToken, token string) (*instancesResponse, error) {
+	listURL := fmt.Sprintf("%s/projects/%s/aggregated/instances", computeEndpoint, url.PathEscape(project))
+	query := url.Values{}
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	if pageToken != "" {
+		query.Set("pageToken", pageToken)
+	}
+	if len(query) > 0 {
+		listURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve gcp information: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot retrieve gcp information: %s", resp.Status)
+	}
+
+	response := &instancesResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("cannot parse gcp information: %w", err)
+	}
+	return response, nil
+}
+
+// zoneMatches returns true when no zones are given or the instance zone URL
+// ends in one of the given zone names.
+func zoneMatches(zoneURL string, zones []string) bool {
+	if len(zones) == 0 {
+		return true
+	}
+	for _, zone := range zones {
+		if strings.HasSuffix(zoneURL, "/"+zone) || zoneURL == zone {
+			return true
+		}
+	}
+	return false
+}
+
+func extractIPs(instance gceInstance) []netip.Addr {
+	result := []netip.Addr{}
+	for _, iface := range instance.NetworkInterfaces {
+		if addr, err := netip.ParseAddr(iface.NetworkIP); err == nil {
+			result = append(result, addr)
+		}
+		for _, accessConfig := range iface.AccessConfigs {
+			if addr, err := netip.ParseAddr(accessConfig.NatIP); err == nil {
+				result = append(result, addr)
+			}
+		}
+	}
+	return result
+}
@@ -6,6 +6,7 @@ package groups
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -123,6 +124,10 @@ func createAPIRules(ctx context.Context, rules api.Rules) (api.Rules, error) {
 		}
 	}
 
+	// All rules are resolved even if some of them fail, so that the
+	// resulting error carries the resolution status of each failing rule.
+	// Failing rules keep the deny-all placeholder.
+	var resolveErrs []string
 	for i, rule := range rules {
 		if !rule.RequiresDerivative() {
 			specRules[i] = rule
@@ -130,10 +135,14 @@ func createAPIRules(ctx context.Context, rules api.Rules) (api.Rules, error) {
 		}
 		newRule, err := rule.CreateDerivative(ctx)
 		if err != nil {
-			return specRules, err
+			resolveErrs = append(resolveErrs, fmt.Sprintf("rule #%d: %v", i+1, err))
+			continue
 		}
 		specRules[i] = newRule
 	}
+	if len(resolveErrs) > 0 {
+		return specRules, fmt.Errorf("unable to resolve ToGroups rules: %s", strings.Join(resolveErrs, "; "))
+	}
 	return specRules, nil
 }
 
@@ -275,6 +275,11 @@ type L4Filter struct {
 	L7Parser L7ParserType `json:"-"`
 	// Ingress is true if filter applies at ingress; false if it applies at egress.
 	Ingress bool `json:"-"`
+	// IsAudit is true if all rules this filter is derived from are in audit
+	// mode, in which case matching traffic is not enforced but only flagged
+	// in the policy verdict events. As soon as an enforced rule contributes
+	// to the filter, the whole filter is enforced.
+	IsAudit bool `json:"audit,omitempty"`
 	// The rule labels of this Filter
 	DerivedFromRules labels.LabelArrayList `json:"-"`
 
@@ -382,6 +387,7 @@ func (l4Filter *L4Filter) ToMapState(policyOwner PolicyOwner, direction trafficd
 		}
 
 		entry := NewMapStateEntry(cs, l4Filter.DerivedFromRules, currentRule.IsRedirect(), isDenyRule)
+		entry.IsAudit = l4Filter.IsAudit
 		if cs.IsWildcard() {
 			keyToAdd.Identity = 0
 			keysToAdd.DenyPreferredInsert(keyToAdd, entry)
@@ -570,6 +576,7 @@ func createL4Filter(policyCtx PolicyContext, peerEndpoints api.EndpointSelectorS
 		L7RulesPerSelector: make(L7DataMap),
 		DerivedFromRules:   labels.LabelArrayList{ruleLabels},
 		Ingress:            ingress,
+		IsAudit:            policyCtx.IsAudit(),
 	}
 
 	if peerEndpoints.SelectsAllEndpoints() {
@@ -1009,7 +1016,7 @@ func (l4 *L4Policy) AccumulateMapChanges(cs CachedSelector, adds, deletes []iden
 				continue
 			}
 		}
-		epPolicy.policyMapChanges.AccumulateMapChanges(cs, adds, deletes, port, proto, direction, redirect, isDeny, derivedFrom)
+		epPolicy.policyMapChanges.AccumulateMapChanges(cs, adds, deletes, port, proto, direction, redirect, isDeny, l4Filter.IsAudit, derivedFrom)
 	}
 }
 
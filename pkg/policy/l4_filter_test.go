@@ -51,7 +51,8 @@ var (
 )
 
 type testPolicyContextType struct {
-	isDeny bool
+	isDeny  bool
+	isAudit bool
 }
 
 func (p *testPolicyContextType) GetSelectorCache() *SelectorCache {
@@ -82,6 +83,16 @@ func (p *testPolicyContextType) IsDeny() bool {
 	return p.isDeny
 }
 
+func (p *testPolicyContextType) SetAudit(isAudit bool) bool {
+	oldAudit := p.isAudit
+	p.isAudit = isAudit
+	return oldAudit
+}
+
+func (p *testPolicyContextType) IsAudit() bool {
+	return p.isAudit
+}
+
 var (
 	testPolicyContext               = &testPolicyContextType{}
 	cachedRemoteNodeIdentitySetting bool
@@ -656,8 +667,10 @@ func (ds *PolicyTestSuite) TestMergeIdenticalAllowAllL3AndMismatchingParsers(c *
 	ctxToA.Logging = stdlog.New(buffer, "", 0)
 	c.Log(buffer)
 
+	// The parser conflict is already caught when sanitizing the rule at
+	// import, before the merge is ever attempted.
 	err = conflictingParsersIngressRule.Sanitize()
-	c.Assert(err, IsNil)
+	c.Assert(err, Not(IsNil))
 
 	state = traceState{}
 	res, err = conflictingParsersIngressRule.resolveIngressPolicy(testPolicyContext, &ctxToA, &state, L4PolicyMap{}, nil, nil)
@@ -1677,3 +1690,72 @@ func (ds *PolicyTestSuite) TestEntitiesL3(c *C) {
 	res.Detach(testSelectorCache)
 	expected.Detach(testSelectorCache)
 }
+
+// TestAuditModeRule checks that a rule marked with AuditMode resolves into an
+// L4Filter flagged as audit, and that merging in an enforcing rule for the
+// same port clears the flag again.
+func (ds *PolicyTestSuite) TestAuditModeRule(c *C) {
+	auditRule := &api.Rule{
+		EndpointSelector: endpointSelectorA,
+		AuditMode:        true,
+		Ingress: []api.IngressRule{
+			{
+				IngressCommonRule: api.IngressCommonRule{
+					FromEndpoints: []api.EndpointSelector{api.WildcardEndpointSelector},
+				},
+				ToPorts: []api.PortRule{{
+					Ports: []api.PortProtocol{
+						{Port: "80", Protocol: api.ProtoTCP},
+					},
+				}},
+			},
+		},
+	}
+
+	repo := parseAndAddRules(c, api.Rules{auditRule})
+
+	buffer := new(bytes.Buffer)
+	ctx := SearchContext{To: labelsA, Trace: TRACE_VERBOSE}
+	ctx.Logging = stdlog.New(buffer, "", 0)
+
+	l4IngressPolicy, err := repo.ResolveL4IngressPolicy(&ctx)
+	c.Assert(err, IsNil)
+	c.Log(buffer)
+
+	expected := L4PolicyMap{"80/TCP": &L4Filter{
+		Port:     80,
+		Protocol: api.ProtoTCP,
+		U8Proto:  6,
+		wildcard: wildcardCachedSelector,
+		L7Parser: ParserTypeNone,
+		L7RulesPerSelector: L7DataMap{
+			wildcardCachedSelector: nil,
+		},
+		Ingress:          true,
+		IsAudit:          true,
+		DerivedFromRules: labels.LabelArrayList{nil},
+	}}
+
+	c.Assert(l4IngressPolicy, checker.DeepEquals, expected)
+	expected.Detach(testSelectorCache)
+	l4IngressPolicy.Detach(repo.GetSelectorCache())
+
+	// An enforcing rule contributing to the same L4Filter takes precedence
+	// over the audit-mode rule.
+	enforcedRule := auditRule.DeepCopy()
+	enforcedRule.AuditMode = false
+	repo = parseAndAddRules(c, api.Rules{auditRule, enforcedRule})
+
+	buffer = new(bytes.Buffer)
+	ctx = SearchContext{To: labelsA, Trace: TRACE_VERBOSE}
+	ctx.Logging = stdlog.New(buffer, "", 0)
+
+	l4IngressPolicy, err = repo.ResolveL4IngressPolicy(&ctx)
+	c.Assert(err, IsNil)
+	c.Log(buffer)
+
+	filter, ok := l4IngressPolicy["80/TCP"]
+	c.Assert(ok, Equals, true)
+	c.Assert(filter.IsAudit, Equals, false)
+	l4IngressPolicy.Detach(repo.GetSelectorCache())
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/lock"
@@ -91,6 +92,10 @@ type MapStateEntry struct {
 	// IsDeny is true when the policy should be denied.
 	IsDeny bool
 
+	// IsAudit is true when the verdict for this entry should not be
+	// enforced but only flagged in the policy verdict events.
+	IsAudit bool
+
 	// DerivedFromRules tracks the policy rules this entry derives from
 	DerivedFromRules labels.LabelArrayList
 
@@ -181,13 +186,13 @@ func (e *MapStateEntry) IsRedirectEntry() bool {
 }
 
 // DatapathEqual returns true of two entries are equal in the datapath's PoV,
-// i.e., both Deny and ProxyPort are the same for both entries.
+// i.e., Deny, Audit and ProxyPort are the same for both entries.
 func (e *MapStateEntry) DatapathEqual(o *MapStateEntry) bool {
 	if e == nil || o == nil {
 		return e == o
 	}
 
-	return e.IsDeny == o.IsDeny && e.ProxyPort == o.ProxyPort
+	return e.IsDeny == o.IsDeny && e.IsAudit == o.IsAudit && e.ProxyPort == o.ProxyPort
 }
 
 // String returns a string representation of the MapStateEntry
@@ -310,6 +315,7 @@ func (keys MapState) denyPreferredInsertWithChanges(newKey Key, newEntry MapStat
 					newKeyCpy.DestPort = k.DestPort
 					newKeyCpy.Nexthdr = k.Nexthdr
 					l3l4DenyEntry := NewMapStateEntry(newKey, newEntry.DerivedFromRules, false, true)
+					l3l4DenyEntry.IsAudit = newEntry.IsAudit
 					keys.addKeyWithChanges(newKeyCpy, l3l4DenyEntry, adds, deletes)
 					// L3-only entries can be deleted incrementally so we need to track their
 					// effects on other entries so that those effects can be reverted when the
@@ -364,6 +370,7 @@ func (keys MapState) denyPreferredInsertWithChanges(newKey Key, newEntry MapStat
 					newKeyCpy := newKey
 					newKeyCpy.Identity = k.Identity
 					l3l4DenyEntry := NewMapStateEntry(k, v.DerivedFromRules, false, true)
+					l3l4DenyEntry.IsAudit = v.IsAudit
 					keys.addKeyWithChanges(newKeyCpy, l3l4DenyEntry, adds, deletes)
 					// Mark the new entry as a dependent of 'v'
 					v.AddDependent(newKeyCpy)
@@ -573,6 +580,7 @@ func (keys MapState) AddVisibilityKeys(e PolicyOwner, redirectPort uint16, visMe
 				//    key already exists.
 				if _, ok := keys[k2]; !ok {
 					v2 := NewMapStateEntry(k, v.DerivedFromRules, false, true)
+					v2.IsAudit = v.IsAudit
 					e.PolicyDebug(logrus.Fields{
 						logfields.BPFMapKey:   k2,
 						logfields.BPFMapValue: v2,
@@ -733,6 +741,39 @@ func (pms MapState) getIdentities(log *logrus.Logger, denied bool) (ingIdentitie
 	return ingIdentities, egIdentities
 }
 
+// GetStatisticsModel returns per-direction statistics of the entries stored
+// in the MapState as an API model. The policy revision is not known to the
+// MapState and is left for the caller to fill in.
+func (pms MapState) GetStatisticsModel() *models.PolicyMapStatistics {
+	stats := &models.PolicyMapStatistics{
+		Ingress: &models.PolicyMapDirectionStatistics{},
+		Egress:  &models.PolicyMapDirectionStatistics{},
+	}
+	for policyMapKey, policyMapValue := range pms {
+		var dirStats *models.PolicyMapDirectionStatistics
+		switch trafficdirection.TrafficDirection(policyMapKey.TrafficDirection) {
+		case trafficdirection.Ingress:
+			dirStats = stats.Ingress
+		case trafficdirection.Egress:
+			dirStats = stats.Egress
+		default:
+			continue
+		}
+		if policyMapValue.IsDeny {
+			dirStats.DenyEntries++
+		} else {
+			dirStats.AllowEntries++
+		}
+		if policyMapValue.IsRedirectEntry() {
+			dirStats.L7RedirectEntries++
+		}
+		if policyMapKey.Identity == identity.IdentityUnknown.Uint32() {
+			dirStats.WildcardEntries++
+		}
+	}
+	return stats
+}
+
 // MapChanges collects updates to the endpoint policy on the
 // granularity of individual mapstate key-value pairs for both adds
 // and deletes. 'mutex' must be held for any access.
@@ -754,7 +795,7 @@ type MapChange struct {
 // present in both 'adds' and 'deletes'.
 func (mc *MapChanges) AccumulateMapChanges(cs CachedSelector, adds, deletes []identity.NumericIdentity,
 	port uint16, proto uint8, direction trafficdirection.TrafficDirection,
-	redirect, isDeny bool, derivedFrom labels.LabelArrayList) {
+	redirect, isDeny, isAudit bool, derivedFrom labels.LabelArrayList) {
 	key := Key{
 		// The actual identity is set in the loops below
 		Identity: 0,
@@ -765,6 +806,7 @@ func (mc *MapChanges) AccumulateMapChanges(cs CachedSelector, adds, deletes []id
 	}
 
 	value := NewMapStateEntry(cs, derivedFrom, redirect, isDeny)
+	value.IsAudit = isAudit
 
 	if option.Config.Debug {
 		log.WithFields(logrus.Fields{
@@ -52,6 +52,34 @@ func (ds *PolicyTestSuite) TestPolicyKeyTrafficDirection(c *check.C) {
 	c.Assert(k.IsEgress(), check.Equals, true)
 }
 
+func (ds *PolicyTestSuite) TestMapState_GetStatisticsModel(c *check.C) {
+	keys := MapState{
+		Key{
+			Identity:         0,
+			TrafficDirection: trafficdirection.Ingress.Uint8(),
+		}: MapStateEntry{},
+		Key{
+			Identity:         42,
+			DestPort:         80,
+			Nexthdr:          6,
+			TrafficDirection: trafficdirection.Ingress.Uint8(),
+		}: MapStateEntry{ProxyPort: 4000},
+		Key{
+			Identity:         42,
+			TrafficDirection: trafficdirection.Egress.Uint8(),
+		}: MapStateEntry{IsDeny: true},
+	}
+	stats := keys.GetStatisticsModel()
+	c.Assert(stats.Ingress.AllowEntries, check.Equals, int64(2))
+	c.Assert(stats.Ingress.DenyEntries, check.Equals, int64(0))
+	c.Assert(stats.Ingress.L7RedirectEntries, check.Equals, int64(1))
+	c.Assert(stats.Ingress.WildcardEntries, check.Equals, int64(1))
+	c.Assert(stats.Egress.AllowEntries, check.Equals, int64(0))
+	c.Assert(stats.Egress.DenyEntries, check.Equals, int64(1))
+	c.Assert(stats.Egress.L7RedirectEntries, check.Equals, int64(0))
+	c.Assert(stats.Egress.WildcardEntries, check.Equals, int64(0))
+}
+
 func (ds *PolicyTestSuite) TestMapState_DenyPreferredInsert(c *check.C) {
 	type args struct {
 		key   Key
@@ -1131,7 +1159,7 @@ func (ds *PolicyTestSuite) TestMapState_AccumulateMapChangesDeny(c *check.C) {
 			if x.cs != nil {
 				cs = x.cs
 			}
-			policyMaps.AccumulateMapChanges(cs, adds, deletes, x.port, x.proto, dir, x.redirect, x.deny, nil)
+			policyMaps.AccumulateMapChanges(cs, adds, deletes, x.port, x.proto, dir, x.redirect, x.deny, false, nil)
 		}
 		adds, deletes := policyMaps.consumeMapChanges(policyMapState)
 		c.Assert(policyMapState, checker.DeepEquals, tt.state, check.Commentf(tt.name+" (MapState)"))
@@ -1368,7 +1396,7 @@ func (ds *PolicyTestSuite) TestMapState_AccumulateMapChanges(c *check.C) {
 			if x.cs != nil {
 				cs = x.cs
 			}
-			policyMaps.AccumulateMapChanges(cs, adds, deletes, x.port, x.proto, dir, x.redirect, x.deny, nil)
+			policyMaps.AccumulateMapChanges(cs, adds, deletes, x.port, x.proto, dir, x.redirect, x.deny, false, nil)
 		}
 		adds, deletes := policyMaps.consumeMapChanges(policyMapState)
 		c.Assert(policyMapState, checker.DeepEquals, tt.state, check.Commentf(tt.name+" (MapState)"))
@@ -1926,7 +1954,7 @@ func (ds *PolicyTestSuite) TestMapState_AccumulateMapChangesOnVisibilityKeys(c *
 			if x.cs != nil {
 				cs = x.cs
 			}
-			policyMaps.AccumulateMapChanges(cs, adds, deletes, x.port, x.proto, dir, x.redirect, x.deny, nil)
+			policyMaps.AccumulateMapChanges(cs, adds, deletes, x.port, x.proto, dir, x.redirect, x.deny, false, nil)
 		}
 		adds, deletes = policyMaps.consumeMapChanges(policyMapState)
 		// Visibilty redirects need to be re-applied after consumeMapChanges()
@@ -58,6 +58,15 @@ type PolicyContext interface {
 	// SetDeny sets the Deny field of the PolicyContext and returns the old
 	// value stored.
 	SetDeny(newValue bool) (oldValue bool)
+
+	// IsAudit returns true if the policy computation is done for a rule in
+	// audit mode, in which case matching traffic is not enforced but only
+	// flagged in the policy verdict events.
+	IsAudit() bool
+
+	// SetAudit sets the Audit field of the PolicyContext and returns the
+	// old value stored.
+	SetAudit(newValue bool) (oldValue bool)
 }
 
 type policyContext struct {
@@ -66,6 +75,9 @@ type policyContext struct {
 	// isDeny this field is set to true if the given policy computation should
 	// be done for the policy deny.
 	isDeny bool
+	// isAudit this field is set to true if the rule the given policy
+	// computation is done for is in audit mode.
+	isAudit bool
 }
 
 // GetSelectorCache() returns the selector cache used by the Repository
@@ -100,6 +112,20 @@ func (p *policyContext) SetDeny(deny bool) bool {
 	return oldDeny
 }
 
+// IsAudit returns true if the policy computation is done for a rule in audit
+// mode.
+func (p *policyContext) IsAudit() bool {
+	return p.isAudit
+}
+
+// SetAudit sets the Audit field of the PolicyContext and returns the old
+// value stored.
+func (p *policyContext) SetAudit(audit bool) bool {
+	oldAudit := p.isAudit
+	p.isAudit = audit
+	return oldAudit
+}
+
 // Repository is a list of policy rules which in combination form the security
 // policy. A policy repository can be
 type Repository struct {
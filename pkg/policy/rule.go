@@ -98,6 +98,12 @@ func (epd *PerSelectorPolicy) appendL7WildcardRule(ctx *SearchContext) *PerSelec
 }
 
 func mergePortProto(ctx *SearchContext, existingFilter, filterToMerge *L4Filter, selectorCache *SelectorCache) error {
+	// Audit mode only sticks if every rule contributing to this filter is in
+	// audit mode; any enforced rule makes the whole merged filter enforced.
+	if !filterToMerge.IsAudit {
+		existingFilter.IsAudit = false
+	}
+
 	// Merge the L7-related data from the filter to merge
 	// with the L7-related data already in the existing filter.
 	if filterToMerge.L7Parser != ParserTypeNone {
@@ -481,6 +487,11 @@ func (r *rule) resolveIngressPolicy(
 	state.selectRule(ctx, r)
 	found, foundDeny := 0, 0
 
+	oldAudit := policyCtx.SetAudit(r.AuditMode)
+	defer func() {
+		policyCtx.SetAudit(oldAudit)
+	}()
+
 	if len(r.Ingress) == 0 && len(r.IngressDeny) == 0 {
 		ctx.PolicyTrace("    No ingress rules\n")
 	}
@@ -702,6 +713,11 @@ func (r *rule) resolveEgressPolicy(
 	state.selectRule(ctx, r)
 	found, foundDeny := 0, 0
 
+	oldAudit := policyCtx.SetAudit(r.AuditMode)
+	defer func() {
+		policyCtx.SetAudit(oldAudit)
+	}()
+
 	if len(r.Egress) == 0 && len(r.EgressDeny) == 0 {
 		ctx.PolicyTrace("    No egress rules\n")
 	}
@@ -248,6 +248,26 @@ func (sc *SelectorCache) GetModel() models.SelectorCache {
 	return selCacheMdl
 }
 
+// FQDNSelectorIdentityReferences returns, for each identity referenced by an
+// FQDN selector in the cache, the number of selectors holding a reference to
+// it. Each selector holds at most one reference per identity, see
+// transferIdentityReferencesToSelector.
+func (sc *SelectorCache) FQDNSelectorIdentityReferences() map[identity.NumericIdentity]int {
+	sc.mutex.RLock()
+	defer sc.mutex.RUnlock()
+
+	references := make(map[identity.NumericIdentity]int)
+	for _, idSel := range sc.selectors {
+		if f, ok := idSel.(*fqdnSelector); ok {
+			for id := range f.cachedSelections {
+				references[id]++
+			}
+		}
+	}
+
+	return references
+}
+
 func (sc *SelectorCache) handleUserNotifications() {
 	for {
 		sc.userMutex.Lock()
@@ -18,6 +18,26 @@ var (
 	annotationRegex       = regexp.MustCompile(fmt.Sprintf(`^((%s)(,(%s))*)$`, singleAnnotationRegex, singleAnnotationRegex))
 )
 
+// DNSVisibilityAnnotation is the proxy visibility annotation value applied
+// when DNS visibility is enabled for a pod. It redirects egress DNS traffic
+// to the DNS proxy for observation only.
+const DNSVisibilityAnnotation = "<Egress/53/UDP/DNS>,<Egress/53/TCP/DNS>"
+
+// MergeVisibilityAnnotations combines the proxy visibility annotation with
+// the DNS visibility annotation into a single annotation string in the
+// format accepted by NewVisibilityPolicy. 'dnsVisibility' is the raw value
+// of the DNS visibility annotation and enables DNS visibility when set to
+// "true".
+func MergeVisibilityAnnotations(proxyVisibility, dnsVisibility string) string {
+	if !strings.EqualFold(dnsVisibility, "true") {
+		return proxyVisibility
+	}
+	if proxyVisibility == "" {
+		return DNSVisibilityAnnotation
+	}
+	return proxyVisibility + "," + DNSVisibilityAnnotation
+}
+
 func validateL7ProtocolWithDirection(dir string, proto L7ParserType) error {
 	switch proto {
 	case ParserTypeHTTP:
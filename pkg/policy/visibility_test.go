@@ -32,6 +32,27 @@ func (ds *PolicyTestSuite) TestGenerateL7RulesByParser(c *C) {
 	c.Assert(l7Rules[0], checker.DeepEquals, &PerSelectorPolicy{L7Rules: api.L7Rules{DNS: []api.PortRuleDNS{{MatchPattern: "*"}}}})
 }
 
+func (ds *PolicyTestSuite) TestMergeVisibilityAnnotations(c *C) {
+	// DNS visibility disabled; the proxy visibility annotation is passed
+	// through unchanged.
+	c.Assert(MergeVisibilityAnnotations("", ""), Equals, "")
+	c.Assert(MergeVisibilityAnnotations("<Ingress/80/TCP/HTTP>", ""), Equals, "<Ingress/80/TCP/HTTP>")
+	c.Assert(MergeVisibilityAnnotations("<Ingress/80/TCP/HTTP>", "false"), Equals, "<Ingress/80/TCP/HTTP>")
+
+	// DNS visibility enabled.
+	c.Assert(MergeVisibilityAnnotations("", "true"), Equals, DNSVisibilityAnnotation)
+	c.Assert(MergeVisibilityAnnotations("", "True"), Equals, DNSVisibilityAnnotation)
+	c.Assert(MergeVisibilityAnnotations("<Ingress/80/TCP/HTTP>", "true"), Equals,
+		"<Ingress/80/TCP/HTTP>,"+DNSVisibilityAnnotation)
+
+	// The merged annotation parses into a visibility policy.
+	nvp, err := NewVisibilityPolicy(MergeVisibilityAnnotations("<Ingress/80/TCP/HTTP>", "true"))
+	c.Assert(err, IsNil)
+	c.Assert(len(nvp.Egress), Equals, 2)
+	c.Assert(nvp.Egress["53/UDP"].Parser, Equals, ParserTypeDNS)
+	c.Assert(nvp.Egress["53/TCP"].Parser, Equals, ParserTypeDNS)
+}
+
 func (ds *PolicyTestSuite) TestVisibilityPolicyCreation(c *C) {
 
 	anno := "<Ingress/80/TCP/HTTP>"
@@ -291,6 +291,24 @@ type LogRecordDNS struct {
 	// https://www.iana.org/assignments/dns-parameters/dns-parameters.xhtml#dns-parameters-4
 	// Use github.com/miekg/dns.TypeToString map to retrieve string representation
 	AnswerTypes []uint16 `json:"AnswerTypes,omitempty"`
+
+	// UpstreamLatencyNs is the time the proxy spent waiting for the
+	// upstream resolver to answer, in nanoseconds.
+	// These latency fields are filled only for DNS responses proxied by
+	// this agent.
+	UpstreamLatencyNs int64 `json:"UpstreamLatencyNs,omitempty"`
+
+	// PolicyCheckLatencyNs is the time spent checking whether the DNS
+	// request was allowed by policy, in nanoseconds.
+	PolicyCheckLatencyNs int64 `json:"PolicyCheckLatencyNs,omitempty"`
+
+	// IdentityAllocationLatencyNs is the time spent allocating security
+	// identities for the IPs in the response, in nanoseconds.
+	IdentityAllocationLatencyNs int64 `json:"IdentityAllocationLatencyNs,omitempty"`
+
+	// IPCachePlumbingLatencyNs is the time spent plumbing the IPs in the
+	// response into the ipcache, in nanoseconds.
+	IPCachePlumbingLatencyNs int64 `json:"IPCachePlumbingLatencyNs,omitempty"`
 }
 
 // LogRecordL7 contains the generic L7 portion of a log record
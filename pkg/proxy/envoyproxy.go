@@ -6,6 +6,7 @@ package proxy
 import (
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 
 	"github.com/cilium/cilium/pkg/completion"
@@ -13,6 +14,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy"
+	"github.com/cilium/cilium/pkg/policy/api"
 	"github.com/cilium/cilium/pkg/revert"
 )
 
@@ -23,6 +25,62 @@ var envoyProxy *envoy.Envoy
 type envoyRedirect struct {
 	listenerName string
 	xdsServer    *envoy.XDSServer
+	redirect     *Redirect
+}
+
+// retryPolicyFromRules returns the HTTP retry policy configured on the HTTP
+// rules, or nil if no rule configures one. As the retry policy is applied to
+// the Envoy routes of the whole listener rather than per rule, the most
+// permissive settings among the rules are merged: the retry-on conditions are
+// unioned, the largest number of retries and the smallest per-try timeout
+// win.
+func retryPolicyFromRules(rules policy.L7DataMap) *api.HTTPRetryPolicy {
+	var merged *api.HTTPRetryPolicy
+	for _, l7Rules := range rules {
+		if l7Rules == nil {
+			continue
+		}
+		for i := range l7Rules.HTTP {
+			retry := l7Rules.HTTP[i].Retry
+			if retry == nil {
+				continue
+			}
+			if merged == nil {
+				merged = retry.DeepCopy()
+				continue
+			}
+			merged.RetryOn = unionRetryOn(merged.RetryOn, retry.RetryOn)
+			if retry.NumRetries > merged.NumRetries {
+				merged.NumRetries = retry.NumRetries
+			}
+			if retry.PerTryTimeout != 0 && (merged.PerTryTimeout == 0 || retry.PerTryTimeout < merged.PerTryTimeout) {
+				merged.PerTryTimeout = retry.PerTryTimeout
+			}
+		}
+	}
+	return merged
+}
+
+// unionRetryOn merges two comma-separated lists of Envoy retry-on conditions,
+// dropping duplicates. An empty list stands for the default conditions and
+// makes the union empty as well, so that the default is not narrowed.
+func unionRetryOn(a, b string) string {
+	if a == "" || b == "" {
+		return ""
+	}
+	conditions := strings.Split(a, ",")
+	seen := make(map[string]struct{}, len(conditions))
+	for _, condition := range conditions {
+		seen[strings.TrimSpace(condition)] = struct{}{}
+	}
+	for _, condition := range strings.Split(b, ",") {
+		condition = strings.TrimSpace(condition)
+		if _, dup := seen[condition]; !dup {
+			seen[condition] = struct{}{}
+			conditions = append(conditions, condition)
+		}
+	}
+	return strings.Join(conditions, ",")
 }
 
 var envoyOnce sync.Once
@@ -51,6 +109,7 @@ func createEnvoyRedirect(r *Redirect, stateDir string, xdsServer *envoy.XDSServe
 		redir := &envoyRedirect{
 			listenerName: net.JoinHostPort(r.name, fmt.Sprintf("%d", l.proxyPort)),
 			xdsServer:    xdsServer,
+			redirect:     r,
 		}
 		// Only use original source address for egress
 		if l.ingress {
@@ -59,15 +118,23 @@ func createEnvoyRedirect(r *Redirect, stateDir string, xdsServer *envoy.XDSServe
 		xdsServer.AddListener(redir.listenerName, policy.L7ParserType(l.proxyType), l.proxyPort, l.ingress,
 			mayUseOriginalSourceAddr, wg)
 
+		if policy.L7ParserType(l.proxyType) == policy.ParserTypeHTTP {
+			xdsServer.SetListenerHTTPRetryPolicy(redir.listenerName, retryPolicyFromRules(r.rules), wg)
+		}
+
 		return redir, nil
 	}
 
 	return nil, fmt.Errorf("%s: Envoy proxy process failed to start, cannot add redirect", r.name)
 }
 
-// UpdateRules is a no-op for envoy, as redirect data is synchronized via the
-// xDS cache.
+// UpdateRules propagates the HTTP retry policy configured on the rules to the
+// listener. The rules themselves are synchronized via the xDS cache. Caller
+// holds the redirect's mutex per the RedirectImplementation contract.
 func (k *envoyRedirect) UpdateRules(wg *completion.WaitGroup) (revert.RevertFunc, error) {
+	if policy.L7ParserType(k.redirect.listener.proxyType) == policy.ParserTypeHTTP {
+		k.xdsServer.SetListenerHTTPRetryPolicy(k.listenerName, retryPolicyFromRules(k.redirect.rules), wg)
+	}
 	return func() error { return nil }, nil
 }
 
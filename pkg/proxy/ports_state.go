@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package proxy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// proxyPortsFile is the file in the state directory where proxy port
+// allocations are persisted across restarts.
+const proxyPortsFile = "proxy_ports_state.json"
+
+func (p *Proxy) proxyPortsPath() string {
+	return filepath.Join(p.stateDir, proxyPortsFile)
+}
+
+// storeProxyPorts persists the proxy ports currently configured to the state
+// directory so that a restarting agent can allocate the same ports again,
+// keeping long-lived datapath redirects stable across restarts.
+// Must be called with proxyPortsMutex held!
+func (p *Proxy) storeProxyPorts() {
+	ports := make(map[string]uint16, len(proxyPorts))
+	for name, pp := range proxyPorts {
+		if pp.configured && pp.proxyPort != 0 {
+			ports[name] = pp.proxyPort
+		}
+	}
+
+	data, err := json.Marshal(ports)
+	if err != nil {
+		log.WithError(err).Warning("Unable to encode proxy ports for persistence")
+		return
+	}
+
+	// Write to a temporary file and rename it into place to keep the state
+	// file consistent if the agent exits mid-write.
+	path := p.proxyPortsPath()
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		log.WithError(err).Warning("Unable to persist proxy ports")
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		log.WithError(err).Warning("Unable to persist proxy ports")
+	}
+}
+
+// restoreProxyPorts loads the proxy ports persisted by a previous run from the
+// state directory and uses each of them as the preferred allocation for the
+// corresponding listener. Allocation falls back to another port from the proxy
+// port range if a restored port is no longer available.
+func (p *Proxy) restoreProxyPorts() {
+	data, err := os.ReadFile(p.proxyPortsPath())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).Warning("Unable to read persisted proxy ports")
+		}
+		return
+	}
+
+	var ports map[string]uint16
+	if err := json.Unmarshal(data, &ports); err != nil {
+		log.WithError(err).Warning("Unable to decode persisted proxy ports")
+		return
+	}
+
+	proxyPortsMutex.Lock()
+	defer proxyPortsMutex.Unlock()
+	for name, port := range ports {
+		pp := proxyPorts[name]
+		if pp == nil || pp.configured || port == 0 {
+			continue
+		}
+		log.WithField(fieldProxyRedirectID, name).Debugf("Restoring proxy port %d", port)
+		pp.proxyPort = port
+	}
+}
@@ -154,7 +154,7 @@ func StartProxySupport(minPort uint16, maxPort uint16, stateDir string,
 
 	envoy.StartAccessLogServer(stateDir, xdsServer)
 
-	return &Proxy{
+	p := &Proxy{
 		XDSServer:                   xdsServer,
 		stateDir:                    stateDir,
 		rangeMin:                    minPort,
@@ -164,6 +164,12 @@ func StartProxySupport(minPort uint16, maxPort uint16, stateDir string,
 		ipcache:                     ipcache,
 		defaultEndpointInfoRegistry: eir,
 	}
+
+	// Restore proxy ports from the previous run so that the same ports are
+	// preferred when allocating ports for the same listeners again.
+	p.restoreProxyPorts()
+
+	return p
 }
 
 // Overload XDSServer.UpsertEnvoyResources to start Envoy on demand
@@ -301,6 +307,7 @@ func (p *Proxy) ackProxyPort(ctx context.Context, name string, pp *ProxyPort) er
 			return fmt.Errorf("cannot install proxy rules for %s: %w", name, err)
 		}
 		pp.rulesPort = pp.proxyPort
+		p.storeProxyPorts()
 	}
 	pp.nRedirects++
 	return nil
@@ -325,6 +332,7 @@ func (p *Proxy) releaseProxyPort(name string) error {
 		pp.proxyPort = 0
 		pp.configured = false
 		pp.nRedirects = 0
+		p.storeProxyPorts()
 
 		// Leave the datapath rules behind on the hope that they get reused later.
 		// This becomes possible when we are able to keep the proxy listeners
@@ -442,6 +450,7 @@ func (p *Proxy) SetProxyPort(name string, proxyType ProxyType, port uint16, ingr
 	pp.proxyPort = port
 	pp.isStatic = true // prevents release of the proxy port
 	pp.reservePort()   // marks 'port' as reserved, 'pp' as configured
+	p.storeProxyPorts()
 	return nil
 }
 
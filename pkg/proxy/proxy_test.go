@@ -5,6 +5,8 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"testing"
 
 	testipcache "github.com/cilium/cilium/pkg/testutils/ipcache"
@@ -166,3 +168,38 @@ func (s *ProxySuite) TestPortAllocator(c *C) {
 	c.Assert(pp.nRedirects, Equals, 0)
 	c.Assert(pp.rulesPort, Equals, port3)
 }
+
+func (s *ProxySuite) TestProxyPortsPersistence(c *C) {
+	mockDatapathUpdater := &MockDatapathUpdater{}
+
+	stateDir := c.MkDir()
+	p := StartProxySupport(10000, 20000, stateDir, nil, nil, mockDatapathUpdater, nil,
+		testipcache.NewMockIPCache())
+
+	// Allocate and ack a port so that it is persisted to the state directory
+	port, err := p.AllocateProxyPort("listener2", true)
+	c.Assert(err, IsNil)
+	c.Assert(port, Not(Equals), uint16(0))
+	err = p.AckProxyPort(context.TODO(), "listener2")
+	c.Assert(err, IsNil)
+
+	ports := map[string]uint16{}
+	data, err := os.ReadFile(p.proxyPortsPath())
+	c.Assert(err, IsNil)
+	err = json.Unmarshal(data, &ports)
+	c.Assert(err, IsNil)
+	c.Assert(ports["listener2"], Equals, port)
+
+	// Simulate a restarting agent by clearing the allocation and restoring
+	// from the state file. The persisted port becomes the preferred
+	// allocation for the listener.
+	pp := proxyPorts["listener2"]
+	pp.configured = false
+	pp.proxyPort = 0
+	pp.rulesPort = 0
+	pp.nRedirects = 0
+	allocatedPorts[port] = false
+
+	p.restoreProxyPorts()
+	c.Assert(pp.proxyPort, Equals, port)
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package qos programs DSCP marking policies for egress traffic of selected
+// identities into the datapath QoS policy map.
+//
+// Policies are configured per agent through the qos-dscp-policy flag;
+// changing them requires an agent restart, and there is no CRD through
+// which the policies could be maintained cluster-wide.
+package qos
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/qosmap"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "qos")
+
+// maxDSCP is the highest valid DSCP value (6 bits).
+const maxDSCP = 63
+
+// Policy marks egress traffic of the given identity, destination port and
+// protocol with the given DSCP value. A zero port and protocol match all
+// egress traffic of the identity.
+type Policy struct {
+	Identity identity.NumericIdentity
+	DestPort uint16
+	Protocol u8proto.U8proto
+	DSCP     uint8
+}
+
+// String returns the string representation of the policy.
+func (p Policy) String() string {
+	if p.DestPort == 0 {
+		return fmt.Sprintf("%d=%d", p.Identity, p.DSCP)
+	}
+	return fmt.Sprintf("%d:%d/%s=%d", p.Identity, p.DestPort, p.Protocol, p.DSCP)
+}
+
+// ParsePolicySpec parses a QoS policy specification of the form
+// "<identity>[:<port>/<proto>]=<dscp>", e.g. "1000:53/udp=46" or "1000=10".
+func ParsePolicySpec(spec string) (Policy, error) {
+	sel, dscpStr, ok := strings.Cut(spec, "=")
+	if !ok {
+		return Policy{}, fmt.Errorf("invalid QoS policy spec %q, expected <identity>[:<port>/<proto>]=<dscp>", spec)
+	}
+
+	dscp, err := strconv.ParseUint(dscpStr, 10, 8)
+	if err != nil || dscp > maxDSCP {
+		return Policy{}, fmt.Errorf("invalid DSCP value %q, must be in range [0, %d]", dscpStr, maxDSCP)
+	}
+
+	p := Policy{DSCP: uint8(dscp)}
+
+	identStr, portProto, hasPort := strings.Cut(sel, ":")
+	ident, err := strconv.ParseUint(identStr, 10, 32)
+	if err != nil {
+		return Policy{}, fmt.Errorf("invalid identity %q: %w", identStr, err)
+	}
+	p.Identity = identity.NumericIdentity(ident)
+
+	if hasPort {
+		portStr, protoStr, hasProto := strings.Cut(portProto, "/")
+		if !hasProto {
+			return Policy{}, fmt.Errorf("invalid port selector %q, expected <port>/<proto>", portProto)
+		}
+		port, err := strconv.ParseUint(portStr, 10, 16)
+		if err != nil || port == 0 {
+			return Policy{}, fmt.Errorf("invalid port %q", portStr)
+		}
+		proto, err := u8proto.ParseProtocol(protoStr)
+		if err != nil {
+			return Policy{}, err
+		}
+		p.DestPort = uint16(port)
+		p.Protocol = proto
+	}
+
+	return p, nil
+}
+
+// Manager programs QoS policies into the datapath QoS policy map.
+type Manager struct {
+	lock.Mutex
+
+	policies map[string]Policy
+}
+
+// NewManager returns a new QoS policy manager.
+func NewManager() *Manager {
+	return &Manager{
+		policies: map[string]Policy{},
+	}
+}
+
+// AddPolicy programs the given policy into the QoS policy map.
+func (m *Manager) AddPolicy(p Policy) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := qosmap.QoSPolicyMap.Update(uint32(p.Identity), p.DestPort, p.Protocol, p.DSCP); err != nil {
+		return fmt.Errorf("unable to program QoS policy %s: %w", p, err)
+	}
+
+	m.policies[p.String()] = p
+	log.WithField("policy", p.String()).Info("Programmed QoS DSCP policy")
+	return nil
+}
+
+// DeletePolicy removes the given policy from the QoS policy map.
+func (m *Manager) DeletePolicy(p Policy) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := qosmap.QoSPolicyMap.Delete(uint32(p.Identity), p.DestPort, p.Protocol); err != nil {
+		return fmt.Errorf("unable to remove QoS policy %s: %w", p, err)
+	}
+
+	delete(m.policies, p.String())
+	return nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package qos
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type QoSSuite struct{}
+
+var _ = Suite(&QoSSuite{})
+
+func (s *QoSSuite) TestParsePolicySpec(c *C) {
+	p, err := ParsePolicySpec("1000:53/udp=46")
+	c.Assert(err, IsNil)
+	c.Assert(p.Identity, Equals, identity.NumericIdentity(1000))
+	c.Assert(p.DestPort, Equals, uint16(53))
+	c.Assert(p.Protocol, Equals, u8proto.UDP)
+	c.Assert(p.DSCP, Equals, uint8(46))
+
+	// Identity-wide policy without a port selector
+	p, err = ParsePolicySpec("2000=10")
+	c.Assert(err, IsNil)
+	c.Assert(p.Identity, Equals, identity.NumericIdentity(2000))
+	c.Assert(p.DestPort, Equals, uint16(0))
+	c.Assert(p.DSCP, Equals, uint8(10))
+
+	// DSCP is limited to 6 bits
+	_, err = ParsePolicySpec("1000=64")
+	c.Assert(err, NotNil)
+
+	// Port selector requires a protocol
+	_, err = ParsePolicySpec("1000:53=46")
+	c.Assert(err, NotNil)
+
+	_, err = ParsePolicySpec("1000:0/udp=46")
+	c.Assert(err, NotNil)
+
+	_, err = ParsePolicySpec("not-a-policy")
+	c.Assert(err, NotNil)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"sync/atomic"
 
@@ -33,6 +34,14 @@ type ServiceName struct {
 type Service struct {
 	Service        ServiceName `json:"service"`
 	LocalEndpoints int         `json:"localEndpoints"`
+
+	// LocalEndpointsByZone is the number of local endpoints per topology
+	// zone, for backends whose zone is known.
+	LocalEndpointsByZone map[string]int `json:"localEndpointsByZone,omitempty"`
+
+	// Path is the HTTP path the health check is served on. All paths are
+	// served when empty.
+	Path string `json:"-"`
 }
 
 // NewService creates a new service
@@ -101,7 +110,7 @@ func (s *ServiceHealthServer) removeHTTPListener(port uint16) {
 // 'port' is zero, the listener for the added service is stopped.
 // Access to this method is not synchronized. It is the caller's responsibility
 // to ensure this method is called in a thread-safe manner.
-func (s *ServiceHealthServer) UpsertService(svcID lb.ID, ns, name string, localEndpoints int, port uint16) {
+func (s *ServiceHealthServer) UpsertService(svcID lb.ID, ns, name string, localEndpoints int, localEndpointsByZone map[string]int, port uint16, path string) {
 	oldPort, foundSvc := s.portByServiceID[svcID]
 	if foundSvc && oldPort != port {
 		// HealthCheckNodePort has changed, we treat this as a DeleteService
@@ -123,6 +132,8 @@ func (s *ServiceHealthServer) UpsertService(svcID lb.ID, ns, name string, localE
 	// a single listener per port.
 
 	svc := NewService(ns, name, localEndpoints)
+	svc.LocalEndpointsByZone = localEndpointsByZone
+	svc.Path = path
 	if !foundSvc {
 		// We only bump the reference count if this is a service ID we have
 		// not seen before
@@ -178,7 +189,7 @@ func (h *httpHealthHTTPServerFactory) newHTTPHealthServer(port uint16, svc *Serv
 			logfields.ServiceHealthCheckNodePort: port,
 		}).Debug("Starting new service health server")
 
-		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		if err := srv.listenAndServe(); !errors.Is(err, http.ErrServerClosed) {
 			svc := srv.loadService()
 			if errors.Is(err, unix.EADDRINUSE) {
 				log.WithError(err).WithFields(logrus.Fields{
@@ -198,6 +209,21 @@ func (h *httpHealthHTTPServerFactory) newHTTPHealthServer(port uint16, svc *Serv
 	return srv
 }
 
+// listenAndServe starts the health server. If PROXY protocol support is
+// enabled, the listener transparently strips the PROXY protocol header from
+// incoming connections before they are served.
+func (h *httpHealthServer) listenAndServe() error {
+	if !option.Config.EnableHealthCheckProxyProtocol {
+		return h.Server.ListenAndServe()
+	}
+
+	ln, err := net.Listen("tcp", h.Addr)
+	if err != nil {
+		return err
+	}
+	return h.Server.Serve(&proxyProtocolListener{Listener: ln})
+}
+
 func (h *httpHealthServer) loadService() *Service {
 	return h.service.Load().(*Service)
 }
@@ -213,6 +239,10 @@ func (h *httpHealthServer) shutdown() {
 func (h *httpHealthServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Use headers and JSON output compatible with kube-proxy
 	svc := h.loadService()
+	if svc.Path != "" && r.URL.Path != svc.Path {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
 	if svc.LocalEndpoints == 0 {
 		w.WriteHeader(http.StatusServiceUnavailable)
 	} else {
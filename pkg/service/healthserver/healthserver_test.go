@@ -4,6 +4,8 @@
 package healthserver
 
 import (
+	"bufio"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -24,42 +26,42 @@ func (s *ServiceHealthServerSuite) TestServiceHealthServer_UpsertService(c *C) {
 	h := WithHealthHTTPServerFactory(f)
 
 	// Insert svc1
-	h.UpsertService(1, "default", "svc1", 1, 32000)
+	h.UpsertService(1, "default", "svc1", 1, nil, 32000, "")
 	c.Assert(f.ServiceByPort(32000).Service.Namespace, Equals, "default")
 	c.Assert(f.ServiceByPort(32000).Service.Name, Equals, "svc1")
 	c.Assert(f.ServiceByPort(32000).LocalEndpoints, Equals, 1)
 
 	// Disable svc1 port
-	h.UpsertService(1, "default", "svc1", 1, 0)
+	h.UpsertService(1, "default", "svc1", 1, nil, 0, "")
 	c.Assert(f.ServiceByPort(32000), IsNil)
 
 	// Re-enable svc1 port
-	h.UpsertService(1, "default", "svc1", 1, 32000)
+	h.UpsertService(1, "default", "svc1", 1, nil, 32000, "")
 	c.Assert(f.ServiceByPort(32000).Service.Namespace, Equals, "default")
 	c.Assert(f.ServiceByPort(32000).Service.Name, Equals, "svc1")
 	c.Assert(f.ServiceByPort(32000).LocalEndpoints, Equals, 1)
 
 	// Change svc1 port
-	h.UpsertService(1, "default", "svc1", 2, 32001)
+	h.UpsertService(1, "default", "svc1", 2, nil, 32001, "")
 	c.Assert(f.ServiceByPort(32000), IsNil)
 	c.Assert(f.ServiceByPort(32001).Service.Namespace, Equals, "default")
 	c.Assert(f.ServiceByPort(32001).Service.Name, Equals, "svc1")
 	c.Assert(f.ServiceByPort(32001).LocalEndpoints, Equals, 2)
 
 	// Update svc1 count
-	h.UpsertService(1, "default", "svc1", 3, 32001)
+	h.UpsertService(1, "default", "svc1", 3, nil, 32001, "")
 	c.Assert(f.ServiceByPort(32001).Service.Namespace, Equals, "default")
 	c.Assert(f.ServiceByPort(32001).Service.Name, Equals, "svc1")
 	c.Assert(f.ServiceByPort(32001).LocalEndpoints, Equals, 3)
 
 	// Add svc1 clone (uses same port, must be ref-counted)
-	h.UpsertService(100, "default", "svc1", 3, 32001)
+	h.UpsertService(100, "default", "svc1", 3, nil, 32001, "")
 	c.Assert(f.ServiceByPort(32001).Service.Namespace, Equals, "default")
 	c.Assert(f.ServiceByPort(32001).Service.Name, Equals, "svc1")
 	c.Assert(f.ServiceByPort(32001).LocalEndpoints, Equals, 3)
 
 	// Insert svc2
-	h.UpsertService(2, "default", "svc2", 0, 32002)
+	h.UpsertService(2, "default", "svc2", 0, nil, 32002, "")
 	c.Assert(f.ServiceByPort(32002).Service.Namespace, Equals, "default")
 	c.Assert(f.ServiceByPort(32002).Service.Name, Equals, "svc2")
 	c.Assert(f.ServiceByPort(32002).LocalEndpoints, Equals, 0)
@@ -99,3 +101,50 @@ func (s *ServiceHealthServerSuite) Test_httpHealthServer_ServeHTTP(c *C) {
 	c.Assert(resp.StatusCode, Equals, http.StatusServiceUnavailable)
 	resp.Body.Close()
 }
+
+func (s *ServiceHealthServerSuite) Test_httpHealthServer_ServeHTTP_Path(c *C) {
+	h := &httpHealthServer{}
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	svc := NewService("default", "svc", 1)
+	svc.Path = "/healthz"
+	h.updateService(svc)
+
+	// Requests to the configured path must be served
+	resp, err := http.Get(ts.URL + "/healthz")
+	c.Assert(err, IsNil)
+	c.Assert(resp.StatusCode, Equals, http.StatusOK)
+	resp.Body.Close()
+
+	// Requests to any other path must be answered with HTTP 404
+	resp, err = http.Get(ts.URL + "/other")
+	c.Assert(err, IsNil)
+	c.Assert(resp.StatusCode, Equals, http.StatusNotFound)
+	resp.Body.Close()
+}
+
+func (s *ServiceHealthServerSuite) Test_proxyProtocolConn_Read(c *C) {
+	v2Header := append(append([]byte{}, proxyV2Signature...),
+		0x21, 0x11, // PROXY, TCP over IPv4
+		0x00, 0x0C, // 12 bytes of address information
+		127, 0, 0, 1, 127, 0, 0, 1, 0x1F, 0x90, 0x1F, 0x90)
+
+	for _, tc := range [][]byte{
+		[]byte("GET /healthz HTTP/1.0\r\n\r\n"),
+		[]byte("PROXY TCP4 127.0.0.1 127.0.0.1 8080 8080\r\nGET /healthz HTTP/1.0\r\n\r\n"),
+		append(v2Header, []byte("GET /healthz HTTP/1.0\r\n\r\n")...),
+	} {
+		server, client := net.Pipe()
+		go func(payload []byte) {
+			client.Write(payload)
+			client.Close()
+		}(tc)
+
+		conn := &proxyProtocolConn{Conn: server, br: bufio.NewReader(server)}
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		c.Assert(err, IsNil)
+		c.Assert(line, Equals, "GET /healthz HTTP/1.0\r\n")
+		server.Close()
+	}
+}
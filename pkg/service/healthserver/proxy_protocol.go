@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package healthserver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+var proxyV2Signature = []byte{0x0D, 0x0A, 0x0D, 0x0A, 0x00, 0x0D, 0x0A, 0x51, 0x55, 0x49, 0x54, 0x0A}
+
+// proxyProtocolListener wraps a net.Listener and transparently consumes a
+// PROXY protocol (version 1 or 2) header at the start of each accepted
+// connection. This allows the health check server to be used behind load
+// balancers which prepend the PROXY protocol header to health check probes.
+type proxyProtocolListener struct {
+	net.Listener
+}
+
+func (l *proxyProtocolListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &proxyProtocolConn{
+		Conn: conn,
+		br:   bufio.NewReader(conn),
+	}, nil
+}
+
+// proxyProtocolConn strips the PROXY protocol header from the connection upon
+// the first Read. Connections without a PROXY protocol header are served
+// as-is, such that both direct probes and proxied probes are accepted.
+type proxyProtocolConn struct {
+	net.Conn
+	br            *bufio.Reader
+	headerDropped bool
+}
+
+func (c *proxyProtocolConn) Read(b []byte) (int, error) {
+	if !c.headerDropped {
+		if err := c.dropProxyHeader(); err != nil {
+			return 0, err
+		}
+		c.headerDropped = true
+	}
+	return c.br.Read(b)
+}
+
+func (c *proxyProtocolConn) dropProxyHeader() error {
+	sig, err := c.br.Peek(len(proxyV2Signature))
+	if err == nil && bytes.Equal(sig, proxyV2Signature) {
+		return c.dropProxyV2Header()
+	}
+
+	v1, err := c.br.Peek(6)
+	if err == nil && bytes.Equal(v1, []byte("PROXY ")) {
+		return c.dropProxyV1Header()
+	}
+
+	// Not a PROXY protocol header, serve the connection as-is
+	return nil
+}
+
+func (c *proxyProtocolConn) dropProxyV1Header() error {
+	// Version 1 header is a single human-readable line terminated by CRLF,
+	// at most 107 bytes long
+	for i := 0; i < 107; i++ {
+		b, err := c.br.ReadByte()
+		if err != nil {
+			return err
+		}
+		if b == '\n' {
+			return nil
+		}
+	}
+	return fmt.Errorf("PROXY protocol v1 header exceeds maximum length")
+}
+
+func (c *proxyProtocolConn) dropProxyV2Header() error {
+	// Version 2 header consists of the 12 byte signature, 2 bytes for
+	// version/command and family/protocol, a 2 byte address length, followed
+	// by that many bytes of address information
+	hdr := make([]byte, len(proxyV2Signature)+4)
+	if _, err := io.ReadFull(c.br, hdr); err != nil {
+		return err
+	}
+	addrLen := int(binary.BigEndian.Uint16(hdr[len(hdr)-2:]))
+	if _, err := c.br.Discard(addrLen); err != nil {
+		return err
+	}
+	return nil
+}
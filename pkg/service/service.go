@@ -69,7 +69,7 @@ func (e *ErrLocalRedirectServiceExists) Is(target error) bool {
 
 // healthServer is used to manage HealtCheckNodePort listeners
 type healthServer interface {
-	UpsertService(svcID lb.ID, svcNS, svcName string, localEndpoints int, port uint16)
+	UpsertService(svcID lb.ID, svcNS, svcName string, localEndpoints int, localEndpointsByZone map[string]int, port uint16, path string)
 	DeleteService(svcID lb.ID)
 }
 
@@ -95,11 +95,15 @@ type svcInfo struct {
 	sessionAffinity           bool
 	sessionAffinityTimeoutSec uint32
 	svcHealthCheckNodePort    uint16
+	svcHealthCheckPath        string
 	svcName                   lb.ServiceName
 	loadBalancerSourceRanges  []*cidr.CIDR
 	l7LBProxyPort             uint16   // Non-zero for egress L7 LB services
 	l7LBFrontendPorts         []string // Non-zero for L7 LB frontend service ports
 	LoopbackHostport          bool
+	NoHostNSAccess            bool
+	PreserveClientIdentity    bool
+	svcLoadBalancerAlgorithm  lb.SVCLoadBalancingAlgorithm
 
 	restoredFromDatapath bool
 }
@@ -114,16 +118,20 @@ func (svc *svcInfo) deepCopyToLBSVC() *lb.SVC {
 		backends[i] = backend.DeepCopy()
 	}
 	return &lb.SVC{
-		Frontend:            *svc.frontend.DeepCopy(),
-		Backends:            backends,
-		Type:                svc.svcType,
-		TrafficPolicy:       svc.svcTrafficPolicy,
-		NatPolicy:           svc.svcNatPolicy,
-		HealthCheckNodePort: svc.svcHealthCheckNodePort,
-		Name:                svc.svcName,
-		L7LBProxyPort:       svc.l7LBProxyPort,
-		L7LBFrontendPorts:   svc.l7LBFrontendPorts,
-		LoopbackHostport:    svc.LoopbackHostport,
+		Frontend:               *svc.frontend.DeepCopy(),
+		Backends:               backends,
+		Type:                   svc.svcType,
+		TrafficPolicy:          svc.svcTrafficPolicy,
+		NatPolicy:              svc.svcNatPolicy,
+		HealthCheckNodePort:    svc.svcHealthCheckNodePort,
+		HealthCheckPath:        svc.svcHealthCheckPath,
+		Name:                   svc.svcName,
+		L7LBProxyPort:          svc.l7LBProxyPort,
+		L7LBFrontendPorts:      svc.l7LBFrontendPorts,
+		LoopbackHostport:       svc.LoopbackHostport,
+		NoHostNSAccess:         svc.NoHostNSAccess,
+		PreserveClientIdentity: svc.PreserveClientIdentity,
+		LoadBalancerAlgorithm:  svc.svcLoadBalancerAlgorithm,
 	}
 }
 
@@ -148,6 +156,12 @@ func (svc *svcInfo) useMaglev() bool {
 	if option.Config.NodePortAlg != option.NodePortAlgMaglev {
 		return false
 	}
+	// Services annotated to use random backend selection opt out of the
+	// Maglev LUT. The datapath falls back to random selection for services
+	// without a LUT.
+	if svc.svcLoadBalancerAlgorithm == lb.SVCLoadBalancingAlgorithmRandom {
+		return false
+	}
 	// Provision the Maglev LUT for ClusterIP only if ExternalClusterIP is
 	// enabled because ClusterIP can also be accessed from outside with this
 	// setting. We don't do it unconditionally to avoid increasing memory
@@ -653,8 +667,19 @@ func (s *Service) upsertService(params *lb.SVC) (bool, lb.ID, error) {
 		if onlyLocalBackends && filterBackends {
 			_, activeBackends, _ := segregateBackends(backendsCopy)
 
+			var activeBackendsByZone map[string]int
+			for _, b := range activeBackends {
+				if b.Zone == "" {
+					continue
+				}
+				if activeBackendsByZone == nil {
+					activeBackendsByZone = map[string]int{}
+				}
+				activeBackendsByZone[b.Zone]++
+			}
+
 			s.healthServer.UpsertService(lb.ID(svc.frontend.ID), svc.svcName.Namespace, svc.svcName.Name,
-				len(activeBackends), svc.svcHealthCheckNodePort)
+				len(activeBackends), activeBackendsByZone, svc.svcHealthCheckNodePort, svc.svcHealthCheckPath)
 		} else if svc.svcHealthCheckNodePort == 0 {
 			// Remove the health check server in case this service used to have
 			// externalTrafficPolicy=Local with HealthCheckNodePort in the previous
@@ -793,6 +818,8 @@ func (s *Service) UpdateBackendsState(backends []*lb.Backend) error {
 						UseMaglev:                 info.useMaglev(),
 						Name:                      info.svcName,
 						LoopbackHostport:          info.LoopbackHostport,
+						NoHostNSAccess:            info.NoHostNSAccess,
+						PreserveClientIdentity:    info.PreserveClientIdentity,
 					}
 				}
 				p.PreferredBackends, p.ActiveBackends, p.NonActiveBackends = segregateBackends(info.backends)
@@ -1069,10 +1096,14 @@ func (s *Service) createSVCInfoIfNotExist(p *lb.SVC) (*svcInfo, bool, bool,
 			svcTrafficPolicy:         p.TrafficPolicy,
 			svcNatPolicy:             p.NatPolicy,
 			svcHealthCheckNodePort:   p.HealthCheckNodePort,
+			svcHealthCheckPath:       p.HealthCheckPath,
 			loadBalancerSourceRanges: p.LoadBalancerSourceRanges,
 			l7LBProxyPort:            p.L7LBProxyPort,
 			l7LBFrontendPorts:        p.L7LBFrontendPorts,
 			LoopbackHostport:         p.LoopbackHostport,
+			NoHostNSAccess:           p.NoHostNSAccess,
+			PreserveClientIdentity:   p.PreserveClientIdentity,
+			svcLoadBalancerAlgorithm: p.LoadBalancerAlgorithm,
 		}
 		s.svcByID[p.Frontend.ID] = svc
 		s.svcByHash[hash] = svc
@@ -1098,6 +1129,7 @@ func (s *Service) createSVCInfoIfNotExist(p *lb.SVC) (*svcInfo, bool, bool,
 		svc.svcTrafficPolicy = p.TrafficPolicy
 		svc.svcNatPolicy = p.NatPolicy
 		svc.svcHealthCheckNodePort = p.HealthCheckNodePort
+		svc.svcHealthCheckPath = p.HealthCheckPath
 		svc.sessionAffinity = p.SessionAffinity
 		svc.sessionAffinityTimeoutSec = p.SessionAffinityTimeoutSec
 		svc.loadBalancerSourceRanges = p.LoadBalancerSourceRanges
@@ -1118,6 +1150,8 @@ func (s *Service) createSVCInfoIfNotExist(p *lb.SVC) (*svcInfo, bool, bool,
 		// Update L7 load balancer proxy port
 		svc.l7LBProxyPort = p.L7LBProxyPort
 		svc.l7LBFrontendPorts = p.L7LBFrontendPorts
+
+		svc.svcLoadBalancerAlgorithm = p.LoadBalancerAlgorithm
 	}
 
 	return svc, !found, prevSessionAffinity, prevLoadBalancerSourceRanges, nil
@@ -1274,6 +1308,8 @@ func (s *Service) upsertServiceIntoLBMaps(svc *svcInfo, onlyLocalBackends bool,
 		L7LBProxyPort:             svc.l7LBProxyPort,
 		Name:                      svc.svcName,
 		LoopbackHostport:          svc.LoopbackHostport,
+		NoHostNSAccess:            svc.NoHostNSAccess,
+		PreserveClientIdentity:    svc.PreserveClientIdentity,
 	}
 	if err := s.lbmap.UpsertService(p); err != nil {
 		return err
@@ -1368,14 +1404,16 @@ func (s *Service) restoreServicesLocked() error {
 		}
 
 		newSVC := &svcInfo{
-			hash:             svc.Frontend.Hash(),
-			frontend:         svc.Frontend,
-			backends:         svc.Backends,
-			backendByHash:    map[string]*lb.Backend{},
-			svcType:          svc.Type,
-			svcTrafficPolicy: svc.TrafficPolicy,
-			svcNatPolicy:     svc.NatPolicy,
-			LoopbackHostport: svc.LoopbackHostport,
+			hash:                   svc.Frontend.Hash(),
+			frontend:               svc.Frontend,
+			backends:               svc.Backends,
+			backendByHash:          map[string]*lb.Backend{},
+			svcType:                svc.Type,
+			svcTrafficPolicy:       svc.TrafficPolicy,
+			svcNatPolicy:           svc.NatPolicy,
+			LoopbackHostport:       svc.LoopbackHostport,
+			NoHostNSAccess:         svc.NoHostNSAccess,
+			PreserveClientIdentity: svc.PreserveClientIdentity,
 
 			sessionAffinity:           svc.SessionAffinity,
 			sessionAffinityTimeoutSec: svc.SessionAffinityTimeoutSec,
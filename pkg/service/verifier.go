@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	lb "github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// Resource label values used for the lbmap inconsistencies metric.
+const (
+	lbmapResourceService  = "service"
+	lbmapResourceBackend  = "backend"
+	lbmapResourceAffinity = "affinity"
+	lbmapResourceRevNat   = "revnat"
+)
+
+// VerifyLBMaps cross-checks the BPF LB maps against the desired service
+// state and returns the number of inconsistencies found: service frontends
+// missing from the service maps, orphan and missing backends, dangling
+// session affinity matches and dangling reverse NAT entries. Such entries
+// are left behind when a service update is only partially applied, e.g.
+// because one of several map updates failed. With repair set, missing
+// entries are re-created and orphaned entries are removed. Every
+// inconsistency is reported via the lbmap inconsistencies metric, labeled by
+// resource type and whether it was repaired.
+func (s *Service) VerifyLBMaps(repair bool) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	found := 0
+	record := func(resource string, repaired bool) {
+		found++
+		action := "detected"
+		if repaired {
+			action = "repaired"
+		}
+		metrics.LBMapInconsistencies.WithLabelValues(resource, action).Inc()
+	}
+
+	if err := s.verifyBackendsLocked(repair, record); err != nil {
+		return found, err
+	}
+	if err := s.verifyServicesLocked(repair, record); err != nil {
+		return found, err
+	}
+	if err := s.verifyAffinityMatchesLocked(repair, record); err != nil {
+		return found, err
+	}
+	if err := s.verifyRevNatLocked(repair, record); err != nil {
+		return found, err
+	}
+
+	return found, nil
+}
+
+// verifyBackendsLocked reports backends present in the BPF maps without a
+// corresponding desired backend and desired backends missing from the maps.
+func (s *Service) verifyBackendsLocked(repair bool, record func(string, bool)) error {
+	dumped, err := s.lbmap.DumpBackendMaps()
+	if err != nil {
+		return fmt.Errorf("unable to dump backend maps: %w", err)
+	}
+
+	desired := make(map[lb.BackendID]*lb.Backend, len(s.backendByHash))
+	for _, b := range s.backendByHash {
+		desired[b.ID] = b
+	}
+
+	inMaps := make(map[lb.BackendID]struct{}, len(dumped))
+	for _, b := range dumped {
+		inMaps[b.ID] = struct{}{}
+		if _, ok := desired[b.ID]; ok {
+			continue
+		}
+		repaired := false
+		if repair {
+			if err := s.lbmap.DeleteBackendByID(b.ID); err != nil {
+				log.WithError(err).WithField(logfields.BackendID, b.ID).
+					Warning("Unable to remove orphan backend")
+			} else {
+				repaired = true
+			}
+		}
+		log.WithFields(logrus.Fields{
+			logfields.BackendID: b.ID,
+			logfields.L3n4Addr:  b.L3n4Addr,
+			"repaired":          repaired,
+		}).Warning("Orphan backend in the BPF maps")
+		record(lbmapResourceBackend, repaired)
+	}
+
+	for id, b := range desired {
+		if _, ok := inMaps[id]; ok {
+			continue
+		}
+		repaired := false
+		if repair {
+			if err := s.lbmap.AddBackend(b, b.L3n4Addr.IsIPv6()); err != nil {
+				log.WithError(err).WithField(logfields.BackendID, id).
+					Warning("Unable to restore missing backend")
+			} else {
+				repaired = true
+			}
+		}
+		log.WithFields(logrus.Fields{
+			logfields.BackendID: id,
+			logfields.L3n4Addr:  b.L3n4Addr,
+			"repaired":          repaired,
+		}).Warning("Backend missing from the BPF maps")
+		record(lbmapResourceBackend, repaired)
+	}
+
+	return nil
+}
+
+// verifyServicesLocked reports desired service frontends missing from the
+// BPF service maps.
+func (s *Service) verifyServicesLocked(repair bool, record func(string, bool)) error {
+	dumped, errs := s.lbmap.DumpServiceMaps()
+	for _, err := range errs {
+		log.WithError(err).Warning("Error dumping service maps during verification")
+	}
+
+	inMaps := make(map[string]struct{}, len(dumped))
+	for _, svc := range dumped {
+		inMaps[svc.Frontend.Hash()] = struct{}{}
+	}
+
+	for hash, svc := range s.svcByHash {
+		if _, ok := inMaps[hash]; ok {
+			continue
+		}
+		scopedLog := log.WithFields(logrus.Fields{
+			logfields.ServiceID: svc.frontend.ID,
+			logfields.L3n4Addr:  svc.frontend.L3n4Addr,
+		})
+		repaired := false
+		if repair {
+			// Backends missing from the maps have already been restored
+			// by verifyBackendsLocked, so no new backends are passed in.
+			onlyLocalBackends, _ := svc.requireNodeLocalBackends(svc.frontend)
+			if err := s.upsertServiceIntoLBMaps(svc, onlyLocalBackends,
+				len(svc.backends), nil, nil, svc.sessionAffinity,
+				svc.loadBalancerSourceRanges, nil, scopedLog); err != nil {
+				scopedLog.WithError(err).Warning("Unable to restore missing service")
+			} else {
+				repaired = true
+			}
+		}
+		scopedLog.WithField("repaired", repaired).
+			Warning("Service frontend missing from the BPF maps")
+		record(lbmapResourceService, repaired)
+	}
+
+	return nil
+}
+
+// verifyAffinityMatchesLocked reports session affinity matches without a
+// corresponding service or backend.
+func (s *Service) verifyAffinityMatchesLocked(repair bool, record func(string, bool)) error {
+	if !option.Config.EnableSessionAffinity {
+		return nil
+	}
+
+	matches, err := s.lbmap.DumpAffinityMatches()
+	if err != nil {
+		return fmt.Errorf("unable to dump affinity match map: %w", err)
+	}
+
+	desired := make(map[lb.ID]map[lb.BackendID]struct{}, len(s.svcByID))
+	for id, svc := range s.svcByID {
+		if !svc.sessionAffinity {
+			continue
+		}
+		desired[id] = make(map[lb.BackendID]struct{}, len(svc.backends))
+		for _, backend := range svc.backends {
+			desired[id][backend.ID] = struct{}{}
+		}
+	}
+
+	for svcID, backendIDs := range matches {
+		for bID := range backendIDs {
+			if _, ok := desired[lb.ID(svcID)][lb.BackendID(bID)]; ok {
+				continue
+			}
+			repaired := false
+			if repair {
+				if err := s.lbmap.DeleteAffinityMatch(uint16(svcID), lb.BackendID(bID)); err != nil {
+					log.WithError(err).WithFields(logrus.Fields{
+						logfields.ServiceID: svcID,
+						logfields.BackendID: bID,
+					}).Warning("Unable to remove dangling affinity match")
+				} else {
+					repaired = true
+				}
+			}
+			log.WithFields(logrus.Fields{
+				logfields.ServiceID: svcID,
+				logfields.BackendID: bID,
+				"repaired":          repaired,
+			}).Warning("Dangling session affinity match in the BPF maps")
+			record(lbmapResourceAffinity, repaired)
+		}
+	}
+
+	return nil
+}
+
+// verifyRevNatLocked reports reverse NAT entries without a corresponding
+// service.
+func (s *Service) verifyRevNatLocked(repair bool, record func(string, bool)) error {
+	families := []bool{}
+	if option.Config.EnableIPv4 {
+		families = append(families, false)
+	}
+	if option.Config.EnableIPv6 {
+		families = append(families, true)
+	}
+
+	for _, ipv6 := range families {
+		ids, err := s.lbmap.DumpRevNatMaps(ipv6)
+		if err != nil {
+			return fmt.Errorf("unable to dump reverse NAT maps: %w", err)
+		}
+		for _, id := range ids {
+			if _, ok := s.svcByID[lb.ID(id)]; ok {
+				continue
+			}
+			repaired := false
+			if repair {
+				if err := s.lbmap.DeleteRevNat(id, ipv6); err != nil {
+					log.WithError(err).WithField(logfields.ServiceID, id).
+						Warning("Unable to remove dangling reverse NAT entry")
+				} else {
+					repaired = true
+				}
+			}
+			log.WithFields(logrus.Fields{
+				logfields.ServiceID: id,
+				"repaired":          repaired,
+			}).Warning("Dangling reverse NAT entry in the BPF maps")
+			record(lbmapResourceRevNat, repaired)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package service
+
+import (
+	. "gopkg.in/check.v1"
+
+	cmtypes "github.com/cilium/cilium/pkg/clustermesh/types"
+	lb "github.com/cilium/cilium/pkg/loadbalancer"
+)
+
+func (m *ManagerTestSuite) TestVerifyLBMaps(c *C) {
+	p := &lb.SVC{
+		Frontend:      frontend1,
+		Backends:      backends1,
+		Type:          lb.SVCTypeClusterIP,
+		TrafficPolicy: lb.SVCTrafficPolicyCluster,
+		Name:          lb.ServiceName{Name: "svc1", Namespace: "ns1"},
+	}
+	_, id1, err := m.svc.UpsertService(p)
+	c.Assert(err, IsNil)
+
+	// A consistent state yields no findings.
+	found, err := m.svc.VerifyLBMaps(false)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, 0)
+
+	// An orphan backend is detected, and removed in repair mode.
+	orphan := lb.NewBackend(999, lb.TCP, cmtypes.MustParseAddrCluster("10.0.0.99"), 8080)
+	m.lbmap.BackendByID[orphan.ID] = orphan
+	found, err = m.svc.VerifyLBMaps(false)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, 1)
+	_, exists := m.lbmap.BackendByID[orphan.ID]
+	c.Assert(exists, Equals, true)
+	found, err = m.svc.VerifyLBMaps(true)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, 1)
+	_, exists = m.lbmap.BackendByID[orphan.ID]
+	c.Assert(exists, Equals, false)
+
+	// A dangling affinity match is detected and removed in repair mode.
+	err = m.lbmap.AddAffinityMatch(uint16(id1), 999)
+	c.Assert(err, IsNil)
+	found, err = m.svc.VerifyLBMaps(true)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, 1)
+	c.Assert(len(m.lbmap.AffinityMatch), Equals, 0)
+
+	// A service frontend missing from the maps is detected and restored in
+	// repair mode.
+	delete(m.lbmap.ServiceByID, uint16(id1))
+	found, err = m.svc.VerifyLBMaps(true)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, 1)
+	restored, exists := m.lbmap.ServiceByID[uint16(id1)]
+	c.Assert(exists, Equals, true)
+	c.Assert(len(restored.Backends), Equals, 2)
+
+	// The repaired state verifies cleanly again.
+	found, err = m.svc.VerifyLBMaps(false)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, 0)
+}
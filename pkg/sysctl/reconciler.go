@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package sysctl
+
+import (
+	"sort"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+)
+
+var (
+	settingsMU lock.RWMutex
+
+	// registeredSettings holds the sysctl settings declared as part of the
+	// kernel configuration required by Cilium, keyed by parameter name.
+	registeredSettings = map[string]Setting{}
+
+	// driftedSettings are the parameter names found reverted by the last
+	// reconciler run, for reporting via Drifted.
+	driftedSettings []string
+)
+
+// RegisterSettings declares settings as part of the kernel configuration
+// required by Cilium and applies them. Registered settings are re-applied by
+// ReapplyRevertedSettings when something else on the node reverts them, e.g.
+// another agent managing the same parameters.
+func RegisterSettings(sysSettings []Setting) error {
+	settingsMU.Lock()
+	for _, s := range sysSettings {
+		registeredSettings[s.Name] = s
+	}
+	settingsMU.Unlock()
+
+	return ApplySettings(sysSettings)
+}
+
+// ReapplyRevertedSettings re-reads all registered parameters and re-applies
+// those whose value no longer matches the registered one. The reverted
+// parameter names are recorded for reporting via Drifted.
+func ReapplyRevertedSettings() error {
+	settingsMU.Lock()
+	defer settingsMU.Unlock()
+
+	var reverted []Setting
+	for _, s := range registeredSettings {
+		val, err := Read(s.Name)
+		if err != nil {
+			// Parameters with IgnoreErr may not exist on this kernel.
+			if !s.IgnoreErr {
+				log.WithError(err).WithField(logfields.SysParamName, s.Name).
+					Warning("Failed to read sysctl while checking for reverted settings")
+			}
+			continue
+		}
+		if val != s.Val {
+			reverted = append(reverted, s)
+		}
+	}
+
+	driftedSettings = make([]string, 0, len(reverted))
+	for _, s := range reverted {
+		driftedSettings = append(driftedSettings, s.Name)
+	}
+	sort.Strings(driftedSettings)
+
+	if len(reverted) == 0 {
+		return nil
+	}
+
+	log.WithField("sysctls", driftedSettings).Warning("Sysctl settings were reverted externally, re-applying")
+	return ApplySettings(reverted)
+}
+
+// Drifted returns the parameter names found reverted by the last
+// ReapplyRevertedSettings run. The settings have been re-applied, but
+// repeated drift indicates that something else on the node is managing the
+// same parameters.
+func Drifted() []string {
+	settingsMU.RLock()
+	defer settingsMU.RUnlock()
+	return driftedSettings
+}
@@ -33,6 +33,10 @@ func (m *MockIPCache) LookupByIP(IP string) (ipcache.Identity, bool) {
 	return ipcache.Identity{}, false
 }
 
+func (m *MockIPCache) LookupByIdentity(id identity.NumericIdentity) []string {
+	return nil
+}
+
 func (m *MockIPCache) Upsert(ip string, hostIP net.IP, hostKey uint8, k8sMeta *ipcache.K8sMetadata, newIdentity ipcache.Identity) (namedPortsChanged bool, err error) {
 	return false, nil
 }
@@ -211,6 +211,22 @@ func (m *LBMockMap) DumpAffinityMatches() (datapathTypes.BackendIDByServiceIDSet
 	return m.AffinityMatch, nil
 }
 
+func (m *LBMockMap) DumpRevNatMaps(ipv6 bool) ([]uint16, error) {
+	m.Lock()
+	defer m.Unlock()
+	ids := []uint16{}
+	for id, svc := range m.ServiceByID {
+		if svc.Frontend.IsIPv6() == ipv6 {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (m *LBMockMap) DeleteRevNat(id uint16, ipv6 bool) error {
+	return nil
+}
+
 func (m *LBMockMap) UpdateSourceRanges(revNATID uint16, prevRanges []*cidr.CIDR,
 	ranges []*cidr.CIDR, ipv6 bool) error {
 	m.Lock()
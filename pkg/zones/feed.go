@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package zones
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/controller"
+)
+
+const (
+	// defaultFeedRefreshInterval is the interval at which a feed is
+	// re-fetched when no interval is given in the feed specification.
+	defaultFeedRefreshInterval = 1 * time.Hour
+
+	// feedFetchTimeout bounds a single fetch of a feed.
+	feedFetchTimeout = 30 * time.Second
+)
+
+// FeedConfig describes a published IP range feed backing a zone. The feed is
+// expected to serve a plain text list of CIDRs, one per line. Empty lines and
+// lines starting with '#' are ignored.
+type FeedConfig struct {
+	// Zone is the name of the zone refreshed from the feed. The zone must
+	// already be configured.
+	Zone string
+
+	// URL is the location of the feed
+	URL string
+
+	// Interval is the refresh interval
+	Interval time.Duration
+}
+
+// ParseFeedSpec parses a feed specification of the form
+// <zone>=<url>[@<interval>], e.g. "corp=https://example.com/ranges.txt@1h".
+func ParseFeedSpec(spec string) (FeedConfig, error) {
+	zone, value, ok := strings.Cut(spec, "=")
+	if !ok || zone == "" || value == "" {
+		return FeedConfig{}, fmt.Errorf("invalid feed specification %q, expected <zone>=<url>[@<interval>]", spec)
+	}
+
+	cfg := FeedConfig{
+		Zone:     zone,
+		URL:      value,
+		Interval: defaultFeedRefreshInterval,
+	}
+
+	if url, interval, ok := cutLast(value, "@"); ok {
+		d, err := time.ParseDuration(interval)
+		if err == nil {
+			cfg.URL = url
+			cfg.Interval = d
+		}
+	}
+
+	if !strings.HasPrefix(cfg.URL, "http://") && !strings.HasPrefix(cfg.URL, "https://") {
+		return FeedConfig{}, fmt.Errorf("invalid feed URL %q for zone %s", cfg.URL, zone)
+	}
+
+	return cfg, nil
+}
+
+// cutLast is like strings.Cut but splits around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// feedControllerName returns the name of the controller refreshing the given
+// zone from its feed.
+func feedControllerName(zone string) string {
+	return fmt.Sprintf("zone-feed-refresh (%s)", zone)
+}
+
+// RunFeedController starts a controller which periodically fetches the feed
+// and updates the zone's CIDR set from its contents. The zone's identity is
+// left untouched; only the set of prefixes mapped to it changes.
+func (m *Manager) RunFeedController(cfg FeedConfig) {
+	m.controllers.UpdateController(feedControllerName(cfg.Zone),
+		controller.ControllerParams{
+			RunInterval: cfg.Interval,
+			DoFunc: func(ctx context.Context) error {
+				return m.refreshZoneFromFeed(ctx, cfg)
+			},
+		})
+}
+
+// refreshZoneFromFeed fetches the feed once and applies the resulting CIDR
+// set to the zone.
+func (m *Manager) refreshZoneFromFeed(ctx context.Context, cfg FeedConfig) error {
+	zone, ok := m.GetZone(cfg.Zone)
+	if !ok {
+		return fmt.Errorf("zone %s is not configured", cfg.Zone)
+	}
+
+	cidrs, err := fetchFeed(ctx, cfg.URL)
+	if err != nil {
+		return fmt.Errorf("unable to fetch feed for zone %s: %w", cfg.Zone, err)
+	}
+	if len(cidrs) == 0 {
+		// An empty feed is more likely a truncated or broken download
+		// than an intentional removal of all ranges. Keep the current
+		// set rather than unprogramming the whole zone.
+		log.WithField("zone", cfg.Zone).Warning("Ignoring empty IP range feed")
+		return nil
+	}
+
+	return m.UpsertZone(&Zone{
+		Name:  zone.Name,
+		ID:    zone.ID,
+		CIDRs: cidrs,
+	})
+}
+
+// fetchFeed downloads and parses the feed at the given URL.
+func fetchFeed(ctx context.Context, url string) ([]*net.IPNet, error) {
+	ctx, cancel := context.WithTimeout(ctx, feedFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %s", resp.Status)
+	}
+
+	return parseFeed(resp.Body)
+}
+
+// parseFeed parses a plain text list of CIDRs, one per line. Empty lines and
+// lines starting with '#' are ignored.
+func parseFeed(r io.Reader) ([]*net.IPNet, error) {
+	var cidrs []*net.IPNet
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q in feed: %w", line, err)
+		}
+		cidrs = append(cidrs, ipnet)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return cidrs, nil
+}
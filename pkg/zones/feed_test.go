@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package zones
+
+import (
+	"strings"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *ZonesSuite) TestParseFeedSpec(c *C) {
+	cfg, err := ParseFeedSpec("corp=https://example.com/ranges.txt@30m")
+	c.Assert(err, IsNil)
+	c.Assert(cfg.Zone, Equals, "corp")
+	c.Assert(cfg.URL, Equals, "https://example.com/ranges.txt")
+	c.Assert(cfg.Interval, Equals, 30*time.Minute)
+
+	// Default interval when none is given
+	cfg, err = ParseFeedSpec("corp=https://example.com/ranges.txt")
+	c.Assert(err, IsNil)
+	c.Assert(cfg.Interval, Equals, defaultFeedRefreshInterval)
+
+	// Missing URL
+	_, err = ParseFeedSpec("corp=")
+	c.Assert(err, NotNil)
+
+	// Unsupported scheme
+	_, err = ParseFeedSpec("corp=ftp://example.com/ranges.txt")
+	c.Assert(err, NotNil)
+}
+
+func (s *ZonesSuite) TestParseFeed(c *C) {
+	cidrs, err := parseFeed(strings.NewReader("# comment\n10.0.0.0/8\n\n172.16.0.0/12\n"))
+	c.Assert(err, IsNil)
+	c.Assert(cidrs, HasLen, 2)
+
+	_, err = parseFeed(strings.NewReader("not-a-cidr\n"))
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package zones implements operator-defined zone identities. A zone maps a
+// potentially large set of external CIDRs (e.g. corporate ranges or cloud
+// provider ranges) to a single stable, user-reserved identity which can be
+// used as a peer in policy, avoiding per-CIDR identity allocation.
+//
+// Zones are configured per agent through the cidr-zone and cidr-zone-feed
+// flags. The CIDRs of a zone can change at runtime when refreshed from a
+// configured IP range feed, but zones themselves cannot be added or removed
+// without restarting the agent, and there is no CRD through which zones
+// could be maintained cluster-wide.
+package zones
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/ipcache"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/source"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "zones")
+
+// zoneLabelPrefix is prepended to the zone name to form the reserved label
+// under which the zone identity is known, e.g. reserved:zone-corp.
+const zoneLabelPrefix = "zone-"
+
+// Zone maps a set of CIDRs to a stable user-reserved identity.
+type Zone struct {
+	// Name is the zone name, unique across all configured zones
+	Name string
+
+	// ID is the user-reserved numeric identity assigned to the zone
+	ID identity.NumericIdentity
+
+	// CIDRs is the set of prefixes mapped to the zone identity
+	CIDRs []*net.IPNet
+}
+
+// Label returns the reserved label under which the zone identity is known.
+func (z *Zone) Label() string {
+	return zoneLabelPrefix + z.Name
+}
+
+// ParseZoneSpec parses a zone specification of the form
+// <name>=<numeric identity>:<cidr>[;<cidr>...], e.g.
+// "corp=130:10.0.0.0/8;172.16.0.0/12".
+func ParseZoneSpec(spec string) (*Zone, error) {
+	name, value, ok := strings.Cut(spec, "=")
+	if !ok || name == "" {
+		return nil, fmt.Errorf("invalid zone specification %q, expected <name>=<identity>:<cidr>[;<cidr>...]", spec)
+	}
+
+	idStr, cidrList, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid zone specification %q, missing CIDR list", spec)
+	}
+
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid numeric identity %q for zone %s: %w", idStr, name, err)
+	}
+	numID := identity.NumericIdentity(id)
+	if !identity.IsUserReservedIdentity(numID) {
+		return nil, fmt.Errorf("zone %s identity %d is outside of the user-reserved range [%d,%d)",
+			name, id, identity.UserReservedNumericIdentity, identity.MinimalNumericIdentity)
+	}
+
+	var cidrs []*net.IPNet
+	for _, c := range strings.Split(cidrList, ";") {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q for zone %s: %w", c, name, err)
+		}
+		cidrs = append(cidrs, ipnet)
+	}
+	if len(cidrs) == 0 {
+		return nil, fmt.Errorf("zone %s does not specify any CIDRs", name)
+	}
+
+	return &Zone{
+		Name:  name,
+		ID:    numID,
+		CIDRs: cidrs,
+	}, nil
+}
+
+// Manager maintains the set of configured zones and keeps the ipcache in
+// sync with their CIDRs.
+type Manager struct {
+	lock.Mutex
+
+	ipcache *ipcache.IPCache
+
+	// zones stores the currently applied zones indexed by zone name
+	zones map[string]*Zone
+
+	// controllers runs the feed refresh controllers
+	controllers *controller.Manager
+}
+
+// NewManager returns a new zone manager which programs zone CIDRs into the
+// given ipcache.
+func NewManager(ipc *ipcache.IPCache) *Manager {
+	return &Manager{
+		ipcache:     ipc,
+		zones:       make(map[string]*Zone),
+		controllers: controller.NewManager(),
+	}
+}
+
+// UpsertZone registers the zone's reserved identity and programs its CIDRs
+// into the ipcache. CIDRs no longer part of the zone are removed. The zone
+// may be updated at runtime, e.g. when refreshed from an IP range feed.
+func (m *Manager) UpsertZone(zone *Zone) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if existing, ok := m.zones[zone.Name]; ok && existing.ID != zone.ID {
+		return fmt.Errorf("zone %s is already configured with identity %d", zone.Name, existing.ID)
+	}
+
+	if err := identity.AddUserDefinedNumericIdentity(zone.ID, zone.Label()); err != nil {
+		return fmt.Errorf("unable to reserve identity %d for zone %s: %w", zone.ID, zone.Name, err)
+	}
+
+	desired := make(map[string]struct{}, len(zone.CIDRs))
+	for _, cidr := range zone.CIDRs {
+		desired[cidr.String()] = struct{}{}
+	}
+
+	// Remove CIDRs which are no longer part of the zone before adding new
+	// ones, so that shrinking a zone does not leave stale mappings behind.
+	if existing, ok := m.zones[zone.Name]; ok {
+		for _, cidr := range existing.CIDRs {
+			if _, ok := desired[cidr.String()]; !ok {
+				m.ipcache.Delete(cidr.String(), source.Generated)
+			}
+		}
+	}
+
+	for prefix := range desired {
+		if _, err := m.ipcache.Upsert(prefix, nil, 0, nil, ipcache.Identity{
+			ID:     zone.ID,
+			Source: source.Generated,
+		}); err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				logfields.CIDR:     prefix,
+				logfields.Identity: zone.ID,
+			}).Warning("Unable to program zone CIDR into ipcache")
+		}
+	}
+
+	m.zones[zone.Name] = zone
+	log.WithFields(logrus.Fields{
+		"zone":             zone.Name,
+		logfields.Identity: zone.ID,
+		"numCIDRs":         len(zone.CIDRs),
+	}).Info("Zone identity programmed")
+
+	return nil
+}
+
+// DeleteZone removes the zone's CIDRs from the ipcache and releases its
+// reserved identity.
+func (m *Manager) DeleteZone(name string) {
+	m.Lock()
+	defer m.Unlock()
+
+	zone, ok := m.zones[name]
+	if !ok {
+		return
+	}
+
+	m.controllers.RemoveController(feedControllerName(name))
+	for _, cidr := range zone.CIDRs {
+		m.ipcache.Delete(cidr.String(), source.Generated)
+	}
+	identity.DelReservedNumericIdentity(zone.ID)
+	delete(m.zones, name)
+}
+
+// GetZone returns a copy of the zone with the given name, if configured.
+func (m *Manager) GetZone(name string) (Zone, bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	zone, ok := m.zones[name]
+	if !ok {
+		return Zone{}, false
+	}
+	return *zone, true
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package zones
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/identity"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type ZonesSuite struct{}
+
+var _ = Suite(&ZonesSuite{})
+
+func (s *ZonesSuite) TestParseZoneSpec(c *C) {
+	zone, err := ParseZoneSpec("corp=130:10.0.0.0/8;172.16.0.0/12")
+	c.Assert(err, IsNil)
+	c.Assert(zone.Name, Equals, "corp")
+	c.Assert(zone.ID, Equals, identity.NumericIdentity(130))
+	c.Assert(zone.CIDRs, HasLen, 2)
+	c.Assert(zone.Label(), Equals, "zone-corp")
+
+	// Identity outside of the user-reserved range
+	_, err = ParseZoneSpec("corp=1000:10.0.0.0/8")
+	c.Assert(err, NotNil)
+
+	// Missing CIDR list
+	_, err = ParseZoneSpec("corp=130")
+	c.Assert(err, NotNil)
+
+	// No CIDRs given
+	_, err = ParseZoneSpec("corp=130:")
+	c.Assert(err, NotNil)
+
+	// Invalid CIDR
+	_, err = ParseZoneSpec("corp=130:10.0.0.0/40")
+	c.Assert(err, NotNil)
+
+	// Missing name
+	_, err = ParseZoneSpec("=130:10.0.0.0/8")
+	c.Assert(err, NotNil)
+}
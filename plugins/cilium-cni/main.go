@@ -480,6 +480,7 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 		Addressing:            &models.AddressPair{},
 		K8sPodName:            string(cniArgs.K8S_POD_NAME),
 		K8sNamespace:          string(cniArgs.K8S_POD_NAMESPACE),
+		NetworkNamespace:      args.Netns,
 		DatapathConfiguration: &models.EndpointDatapathConfiguration{},
 	}
 